@@ -0,0 +1,337 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// metaArguments are the arguments every resource and data source block accepts.
+var metaArguments = map[string]bool{
+	"count":      true,
+	"for_each":   true,
+	"provider":   true,
+	"depends_on": true,
+}
+
+// metaBlocks are the nested blocks every resource and data source block accepts, besides timeouts.
+var metaBlocks = map[string]bool{
+	"lifecycle":   true,
+	"provisioner": true,
+	"connection":  true,
+}
+
+// checkDocs validates the examples of all the docs of the services under filePath, and returns the
+// diagnostics found. The docs of the resources and data sources not registered in provider are
+// skipped, as they are never published.
+func checkDocs(provider *schema.Provider, filePath string) (diags hcl.Diagnostics) {
+	files, err := filepath.Glob(filepath.Join(filePath, "services", "*", "*.md"))
+	if err != nil {
+		message("[FAIL!]list docs failed: %s", err)
+		os.Exit(1)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		if !registered(provider, filepath.Base(file)) {
+			continue
+		}
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			message("[FAIL!]read file %s failed: %s", file, err)
+			os.Exit(1)
+		}
+		name, _ := filepath.Rel(filePath, file)
+		diags = append(diags, checkExamples(provider, name, string(raw))...)
+	}
+	return
+}
+
+// registered reports whether the resource or the data source documented by the doc named base is
+// registered in provider. The docs not following the naming of the generated ones are kept.
+func registered(provider *schema.Provider, base string) bool {
+	name := strings.TrimSuffix(base, ".md")
+	if n := strings.TrimPrefix(name, "resource_tc_"); n != name {
+		_, ok := provider.ResourcesMap[cloudPrefix+n]
+		return ok
+	}
+	if n := strings.TrimPrefix(name, "data_source_tc_"); n != name {
+		_, ok := provider.DataSourcesMap[cloudPrefix+n]
+		return ok
+	}
+	return true
+}
+
+// checkExamples parses the fenced hcl blocks of the doc, and validates the tencentcloud resource and
+// data source blocks of them against the schemas of provider. The diagnostics are at the lines of
+// the doc.
+func checkExamples(provider *schema.Provider, filename, doc string) (diags hcl.Diagnostics) {
+	lines := strings.Split(doc, "\n")
+	for i := 0; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "```hcl" {
+			continue
+		}
+		start := i + 1
+		for i++; i < len(lines) && strings.TrimSpace(lines[i]) != "```"; i++ {
+		}
+		src := strings.Join(lines[start:i], "\n")
+
+		file, parseDiags := hclsyntax.ParseConfig([]byte(src), filename, hcl.Pos{Line: start + 1, Column: 1})
+		diags = append(diags, parseDiags...)
+		if parseDiags.HasErrors() {
+			continue
+		}
+		diags = append(diags, checkConfig(provider, file.Body.(*hclsyntax.Body))...)
+	}
+	return
+}
+
+func checkConfig(provider *schema.Provider, body *hclsyntax.Body) (diags hcl.Diagnostics) {
+	for _, block := range body.Blocks {
+		var resources map[string]*schema.Resource
+		switch block.Type {
+		case "resource":
+			resources = provider.ResourcesMap
+		case "data":
+			resources = provider.DataSourcesMap
+		default:
+			continue
+		}
+		if len(block.Labels) == 0 || !strings.HasPrefix(block.Labels[0], cloudPrefix) {
+			continue
+		}
+
+		name := block.Labels[0]
+		r, ok := resources[name]
+		if !ok {
+			diags = append(diags, errorAt(block.LabelRanges[0], "Unknown type", "%s %q is not supported by the provider.", block.Type, name))
+			continue
+		}
+		diags = append(diags, checkBody(r, block.Body, block.Body, name)...)
+	}
+	return
+}
+
+// checkBody validates the arguments and the nested blocks of body against r. root is the body of the
+// resource, which the paths of ConflictsWith are relative to.
+func checkBody(r *schema.Resource, body, root *hclsyntax.Body, path string) (diags hcl.Diagnostics) {
+	top := body == root
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte
+	})
+	for _, attr := range attrs {
+		key := attr.Name
+		s, ok := r.Schema[key]
+		if !ok {
+			if top && metaArguments[key] {
+				continue
+			}
+			diags = append(diags, errorAt(attr.NameRange, "Unsupported argument", "An argument named %q is not expected in %s.", key, path))
+			continue
+		}
+		if !s.Required && !s.Optional {
+			diags = append(diags, errorAt(attr.NameRange, "Read-only argument", "%s.%s is computed and cannot be set.", path, key))
+			continue
+		}
+		if isBlock(s) {
+			diags = append(diags, errorAt(attr.NameRange, "Unsupported argument", "%s.%s is a block, which is written as `%s { ... }`.", path, key, key))
+			continue
+		}
+		diags = append(diags, checkValue(s, attr, path)...)
+	}
+
+	for _, block := range body.Blocks {
+		name, content := block.Type, block.Body
+		if name == "dynamic" {
+			if len(block.Labels) == 0 {
+				continue
+			}
+			name = block.Labels[0]
+			content = nil
+			for _, b := range block.Body.Blocks {
+				if b.Type == "content" {
+					content = b.Body
+				}
+			}
+		}
+
+		s, ok := r.Schema[name]
+		if !ok {
+			if top && (metaBlocks[name] || name == "timeouts" && r.Timeouts != nil) {
+				continue
+			}
+			diags = append(diags, errorAt(block.TypeRange, "Unsupported block type", "Blocks of type %q are not expected in %s.", name, path))
+			continue
+		}
+		if !s.Required && !s.Optional {
+			diags = append(diags, errorAt(block.TypeRange, "Read-only argument", "%s.%s is computed and cannot be set.", path, name))
+			continue
+		}
+		if !isBlock(s) {
+			diags = append(diags, errorAt(block.TypeRange, "Unsupported block type", "%s.%s is an argument, which is written as `%s = ...`.", path, name, name))
+			continue
+		}
+		if content != nil {
+			diags = append(diags, checkBody(s.Elem.(*schema.Resource), content, root, path+"."+name)...)
+		}
+	}
+
+	keys := make([]string, 0, len(r.Schema))
+	for key := range r.Schema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := r.Schema[key]
+		subject := argumentRange(body, key)
+		if subject == nil {
+			if s.Required {
+				diags = append(diags, errorAt(body.SrcRange, "Missing required argument", "The argument %q is required in %s, but no definition was found.", key, path))
+			}
+			continue
+		}
+		for _, conflict := range s.ConflictsWith {
+			// the arguments conflicting with each other are reported once.
+			if other, ok := r.Schema[conflict]; ok && top && conflict < key && contains(other.ConflictsWith, key) {
+				continue
+			}
+			if hasPath(root, strings.Split(conflict, ".")) {
+				diags = append(diags, errorAt(*subject, "Conflicting arguments", "%s.%s conflicts with %s.", path, key, conflict))
+			}
+		}
+	}
+	return
+}
+
+// checkValue checks the type of the value of attr, when it is a literal.
+func checkValue(s *schema.Schema, attr *hclsyntax.Attribute, path string) hcl.Diagnostics {
+	ty := impliedType(s)
+	if ty == cty.NilType || len(attr.Expr.Variables()) > 0 {
+		return nil
+	}
+	v, valueDiags := attr.Expr.Value(nil)
+	// a value of functions or templates is not known to the docs.
+	if valueDiags.HasErrors() || !v.IsWhollyKnown() || v.IsNull() {
+		return nil
+	}
+	if _, err := convert.Convert(v, ty); err != nil {
+		return hcl.Diagnostics{errorAt(attr.Expr.Range(), "Incorrect attribute value type", "Inappropriate value for %s.%s: %s.", path, attr.Name, err)}
+	}
+	return nil
+}
+
+// impliedType returns the type of the values of s, or cty.NilType if s is a block.
+func impliedType(s *schema.Schema) cty.Type {
+	switch s.Type {
+	case schema.TypeBool:
+		return cty.Bool
+	case schema.TypeInt, schema.TypeFloat:
+		return cty.Number
+	case schema.TypeString:
+		return cty.String
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		elem := cty.String
+		switch e := s.Elem.(type) {
+		case *schema.Schema:
+			elem = impliedType(e)
+		case *schema.Resource:
+			return cty.NilType
+		}
+		if elem == cty.NilType {
+			return cty.NilType
+		}
+		switch s.Type {
+		case schema.TypeList:
+			return cty.List(elem)
+		case schema.TypeSet:
+			return cty.Set(elem)
+		}
+		return cty.Map(elem)
+	}
+	return cty.NilType
+}
+
+func isBlock(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+	_, ok := s.Elem.(*schema.Resource)
+	return ok && s.ConfigMode != schema.SchemaConfigModeAttr
+}
+
+// argumentRange returns the range of the argument or the block of key in body, or nil if not set.
+func argumentRange(body *hclsyntax.Body, key string) *hcl.Range {
+	if attr, ok := body.Attributes[key]; ok {
+		return &attr.NameRange
+	}
+	for _, block := range body.Blocks {
+		if block.Type == key || block.Type == "dynamic" && len(block.Labels) > 0 && block.Labels[0] == key {
+			return &block.TypeRange
+		}
+	}
+	return nil
+}
+
+// hasPath reports whether body sets the argument of path, such as `rule.0.name`.
+func hasPath(body *hclsyntax.Body, path []string) bool {
+	if len(path) == 1 {
+		return argumentRange(body, path[0]) != nil
+	}
+	index, err := strconv.Atoi(path[1])
+	if err != nil {
+		return false
+	}
+	for _, block := range body.Blocks {
+		if block.Type != path[0] {
+			continue
+		}
+		if index == 0 {
+			return hasPath(block.Body, path[2:])
+		}
+		index--
+	}
+	return false
+}
+
+func errorAt(subject hcl.Range, summary, detail string, args ...interface{}) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  summary,
+		Detail:   fmt.Sprintf(detail, args...),
+		Subject:  &subject,
+	}
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDiagnostic formats diag as `file:line: summary; detail`.
+func formatDiagnostic(diag *hcl.Diagnostic) string {
+	s := diag.Summary
+	if diag.Detail != "" {
+		s += "; " + diag.Detail
+	}
+	if diag.Subject == nil {
+		return s
+	}
+	return fmt.Sprintf("%s:%d: %s", diag.Subject.Filename, diag.Subject.Start.Line, s)
+}
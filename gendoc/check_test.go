@@ -0,0 +1,165 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var checkProvider = &schema.Provider{
+	ResourcesMap: map[string]*schema.Resource{
+		"tencentcloud_instance": {
+			Schema: map[string]*schema.Schema{
+				"image_id":      {Type: schema.TypeString, Required: true},
+				"instance_type": {Type: schema.TypeString, Optional: true},
+				"disk_size":     {Type: schema.TypeInt, Optional: true},
+				"running_flag":  {Type: schema.TypeBool, Optional: true},
+				"password":      {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"key_ids"}},
+				"key_ids":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, ConflictsWith: []string{"password"}},
+				"tags":          {Type: schema.TypeMap, Optional: true},
+				"data_disks": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_disk_type": {Type: schema.TypeString, Required: true},
+							"data_disk_size": {Type: schema.TypeInt, Optional: true},
+						},
+					},
+				},
+				"public_ip": {Type: schema.TypeString, Computed: true},
+			},
+		},
+	},
+	DataSourcesMap: map[string]*schema.Resource{
+		"tencentcloud_images": {
+			Schema: map[string]*schema.Schema{
+				"image_type": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+	},
+}
+
+func TestCheckExamples(t *testing.T) {
+	doc := `Provides a CVM instance resource.
+
+Example Usage
+
+'hcl
+data "tencentcloud_images" "images" {
+  image_type = ["PUBLIC_IMAGE"]
+  depends_on = [tencentcloud_vpc.vpc]
+}
+
+resource "tencentcloud_instance" "foo" {
+  image_id      = data.tencentcloud_images.images.images.0.image_id
+  instance_type = 2
+  disk_size     = "50"
+  running_flag  = true
+  key_ids       = ["skey-1"]
+  tags = {
+    createdBy = "terraform"
+  }
+
+  dynamic "data_disks" {
+    for_each = [1, 2]
+    content {
+      data_disk_type = "CLOUD_PREMIUM"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+'
+
+Import
+
+'
+$ terraform import tencentcloud_instance.foo ins-1
+'
+`
+	diags := checkExamples(checkProvider, "instance.md", strings.Replace(doc, "'", "```", -1))
+	for _, diag := range diags {
+		t.Errorf("unexpected diagnostic: %s", formatDiagnostic(diag))
+	}
+
+	doc = `Example Usage
+
+'hcl
+resource "tencentcloud_instance" "foo" {
+  instance_typ = "S5.MEDIUM2"
+  disk_size    = "fifty"
+  running_flag = "yes"
+  password     = "Pass123!"
+  key_ids      = "skey-1"
+  public_ip    = "1.1.1.1"
+
+  tags {
+    createdBy = "terraform"
+  }
+
+  data_disks {
+    data_disk_size = 50
+  }
+}
+
+data "tencentcloud_images" "images" {
+  image_type = [["PUBLIC_IMAGE"]]
+}
+
+resource "tencentcloud_vpn" "foo" {
+}
+'
+
+'hcl
+resource "tencentcloud_instance" "foo" {
+  image_id = "img-1
+}
+'
+`
+	expected := []string{
+		`instance.md:5: Unsupported argument; An argument named "instance_typ" is not expected in tencentcloud_instance.`,
+		`instance.md:6: Incorrect attribute value type; Inappropriate value for tencentcloud_instance.disk_size: a number is required.`,
+		`instance.md:7: Incorrect attribute value type; Inappropriate value for tencentcloud_instance.running_flag: a bool is required.`,
+		`instance.md:9: Incorrect attribute value type; Inappropriate value for tencentcloud_instance.key_ids: set of string required.`,
+		`instance.md:10: Read-only argument; tencentcloud_instance.public_ip is computed and cannot be set.`,
+		`instance.md:12: Unsupported block type; tencentcloud_instance.tags is an argument, which is written as ` + "`tags = ...`.",
+		`instance.md:16: Missing required argument; The argument "data_disk_type" is required in tencentcloud_instance.data_disks, but no definition was found.`,
+		`instance.md:4: Missing required argument; The argument "image_id" is required in tencentcloud_instance, but no definition was found.`,
+		`instance.md:9: Conflicting arguments; tencentcloud_instance.key_ids conflicts with password.`,
+		`instance.md:22: Incorrect attribute value type; Inappropriate value for tencentcloud_images.image_type: element 0: string required.`,
+		`instance.md:25: Unknown type; resource "tencentcloud_vpn" is not supported by the provider.`,
+		`instance.md:31: Invalid multi-line string; Quoted strings may not be split over multiple lines. To produce a multi-line string, either use the \n escape to represent a newline character or use the "heredoc" multi-line template syntax.`,
+	}
+	diags = checkExamples(checkProvider, "instance.md", strings.Replace(doc, "'", "```", -1))
+	var actual []string
+	for _, diag := range diags {
+		actual = append(actual, formatDiagnostic(diag))
+	}
+	if len(actual) < len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d:\n%s", len(expected), len(actual), strings.Join(actual, "\n"))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("diagnostic %d:\nexpected %s\ngot      %s", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	cases := map[string]bool{
+		"resource_tc_instance.md":  true,
+		"resource_tc_vpn.md":       false,
+		"data_source_tc_images.md": true,
+		"data_source_tc_vpns.md":   false,
+		"service_tencentcloud.md":  true,
+	}
+	for base, expected := range cases {
+		if actual := registered(checkProvider, base); actual != expected {
+			t.Errorf("registered(%q): expected %t, got %t", base, expected, actual)
+		}
+	}
+}
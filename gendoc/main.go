@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -33,12 +34,31 @@ var (
 	productNameRegexp = regexp.MustCompile(`^.*\((.*)\)$`)
 )
 
+var check = flag.Bool("check", false, "validate the examples of the docs against the schemas instead of generating the docs")
+
 func main() {
+	flag.Parse()
+
 	provider := cloud.Provider()
 	vProvider := runtime.FuncForPC(reflect.ValueOf(cloud.Provider).Pointer())
 
 	filename, _ := vProvider.FileLine(0)
 	filePath := filepath.Dir(filename)
+
+	if *check {
+		message("checking examples of doc from: %s\n", filePath)
+		diags := checkDocs(provider, filePath)
+		for _, diag := range diags {
+			message("[FAIL!]%s", formatDiagnostic(diag))
+		}
+		if diags.HasErrors() {
+			message("[FAIL!]%d problems found in the examples", len(diags))
+			os.Exit(1)
+		}
+		message("[SUCC.]all examples are valid")
+		return
+	}
+
 	message("generating doc from: %s\n", filePath)
 
 	// document for Index
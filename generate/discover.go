@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	svccbs "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cbs"
+	svcclb "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/clb"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
+)
+
+// discovered is a cloud resource found in the account, identified by its import id.
+type discovered struct {
+	id   string
+	name string
+}
+
+// lister lists the import ids of the resources of a type in a region.
+type lister func(ctx context.Context, client *connectivity.TencentCloudClient) ([]discovered, error)
+
+// listers are the listers of the supported resource types. Listing the resources of a type takes the
+// Describe API of its product, which the schema of the resource does not tell. The importable types
+// without a lister are recorded in testdata/undiscovered.txt.
+var listers = map[string]lister{
+	"tencentcloud_vpc": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svcvpc.NewVpcService(client)
+		err = retry(func() error {
+			infos, e := service.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+			result = make([]discovered, 0, len(infos))
+			for _, info := range infos {
+				result = append(result, discovered{id: info.VpcId(), name: info.Name()})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_route_table": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svcvpc.NewVpcService(client)
+		err = retry(func() error {
+			infos, e := service.DescribeRouteTables(ctx, "", "", "", nil, nil, "")
+			result = make([]discovered, 0, len(infos))
+			for _, info := range infos {
+				// the default route table of a VPC comes with the VPC.
+				if info.IsDefault() {
+					continue
+				}
+				result = append(result, discovered{id: info.RouteTableId(), name: info.Name()})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_subnet": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svcvpc.NewVpcService(client)
+		err = retry(func() error {
+			infos, e := service.DescribeSubnets(ctx, "", "", "", "", nil, nil, nil, "", "", "")
+			result = make([]discovered, 0, len(infos))
+			for _, info := range infos {
+				result = append(result, discovered{id: info.SubnetId(), name: info.Name()})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_security_group": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svcvpc.NewVpcService(client)
+		err = retry(func() error {
+			sgs, e := service.DescribeSecurityGroups(ctx, nil, nil, nil, nil)
+			result = make([]discovered, 0, len(sgs))
+			for _, sg := range sgs {
+				result = append(result, discovered{id: str(sg.SecurityGroupId), name: str(sg.SecurityGroupName)})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_cbs_storage": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svccbs.NewCbsService(client)
+		err = retry(func() error {
+			disks, e := service.DescribeDisksByFilter(ctx, map[string]interface{}{})
+			result = make([]discovered, 0, len(disks))
+			for _, disk := range disks {
+				// system disks are managed by their instances.
+				if str(disk.DiskUsage) == "SYSTEM_DISK" {
+					continue
+				}
+				result = append(result, discovered{id: str(disk.DiskId), name: str(disk.DiskName)})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_instance": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svccvm.NewCvmService(client)
+		err = retry(func() error {
+			instances, e := service.DescribeInstanceByFilter(ctx, nil, map[string]string{})
+			result = make([]discovered, 0, len(instances))
+			for _, instance := range instances {
+				result = append(result, discovered{id: str(instance.InstanceId), name: str(instance.InstanceName)})
+			}
+			return e
+		})
+		return
+	},
+	"tencentcloud_clb_instance": func(ctx context.Context, client *connectivity.TencentCloudClient) (result []discovered, err error) {
+		service := svcclb.NewClbService(client)
+		err = retry(func() error {
+			clbs, e := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
+			result = make([]discovered, 0, len(clbs))
+			for _, clb := range clbs {
+				result = append(result, discovered{id: str(clb.LoadBalancerId), name: str(clb.LoadBalancerName)})
+			}
+			return e
+		})
+		return
+	},
+}
+
+// discoverer enumerates the resources of a type in a region.
+type discoverer struct {
+	product      string
+	resourceType string
+	list         lister
+}
+
+// discoverers returns the discoverers of the importable resources with a lister, sorted by type.
+func discoverers(resources map[string]*schema.Resource) []discoverer {
+	types := make([]string, 0, len(listers))
+	for resourceType := range listers {
+		if r, ok := resources[resourceType]; ok && r.Importer != nil {
+			types = append(types, resourceType)
+		}
+	}
+	sort.Strings(types)
+
+	result := make([]discoverer, 0, len(types))
+	for _, resourceType := range types {
+		result = append(result, discoverer{
+			product:      productOf(resources[resourceType]),
+			resourceType: resourceType,
+			list:         listers[resourceType],
+		})
+	}
+	return result
+}
+
+// productOf returns the product of a resource, which is the service package of its read function,
+// such as `vpc` of `tencentcloud/services/vpc`.
+func productOf(r *schema.Resource) string {
+	var read interface{} = r.Read
+	if r.ReadContext != nil {
+		read = r.ReadContext
+	} else if r.ReadWithoutTimeout != nil {
+		read = r.ReadWithoutTimeout
+	}
+	name := runtime.FuncForPC(reflect.ValueOf(read).Pointer()).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	return name[:strings.Index(name, ".")]
+}
+
+// taggedIds returns the ids of the resources with all the tags, the last segment of their six-segment
+// names, so that the resources without them are skipped before being read.
+func taggedIds(ctx context.Context, client *connectivity.TencentCloudClient, tags map[string]string) (map[string]bool, error) {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	request := tag.NewGetResourcesRequest()
+	for _, key := range keys {
+		key, value := key, tags[key]
+		request.TagFilters = append(request.TagFilters, &tag.TagFilter{TagKey: &key, TagValue: []*string{&value}})
+	}
+	maxResults := uint64(200)
+	request.MaxResults = &maxResults
+
+	ids := make(map[string]bool)
+	for {
+		var response *tag.GetResourcesResponse
+		err := retry(func() (e error) {
+			response, e = client.UseTagClient().GetResources(request)
+			return
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mapping := range response.Response.ResourceTagMappingList {
+			name := str(mapping.Resource)
+			ids[name[strings.LastIndex(name, "/")+1:]] = true
+		}
+		if str(response.Response.PaginationToken) == "" {
+			return ids, nil
+		}
+		request.PaginationToken = response.Response.PaginationToken
+	}
+}
+
+func retry(f func() error) error {
+	return resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		if err := f(); err != nil {
+			return tccommon.RetryError(err)
+		}
+		return nil
+	})
+}
+
+func str(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
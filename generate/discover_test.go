@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+// TestDiscoverers checks that every importable resource type either has a lister or is recorded in
+// testdata/undiscovered.txt, so that a new importable resource is not left out of the generator
+// silently, and that the record only shrinks as listers are added.
+func TestDiscoverers(t *testing.T) {
+	resources := tencentcloud.Provider().ResourcesMap
+	for resourceType := range listers {
+		res, ok := resources[resourceType]
+		if !ok {
+			t.Errorf("%s has a lister but is not a resource of the provider", resourceType)
+			continue
+		}
+		if res.Importer == nil {
+			t.Errorf("%s has a lister but cannot be imported", resourceType)
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "undiscovered.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	undiscovered := make(map[string]bool)
+	var listed []string
+	for _, line := range strings.Split(string(b), "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		undiscovered[line] = true
+		listed = append(listed, line)
+	}
+	assert.True(t, sort.StringsAreSorted(listed), "testdata/undiscovered.txt is not sorted")
+
+	for resourceType, res := range resources {
+		if res.Importer == nil {
+			continue
+		}
+		_, hasLister := listers[resourceType]
+		if !hasLister && !undiscovered[resourceType] {
+			t.Errorf("%s can be imported but has no lister, add one in discover.go or record it in testdata/undiscovered.txt", resourceType)
+		}
+	}
+	for _, resourceType := range listed {
+		if _, ok := listers[resourceType]; ok {
+			t.Errorf("%s has a lister, remove it from testdata/undiscovered.txt", resourceType)
+		}
+		if res, ok := resources[resourceType]; !ok || res.Importer == nil {
+			t.Errorf("%s is not an importable resource, remove it from testdata/undiscovered.txt", resourceType)
+		}
+	}
+}
+
+func TestProductOf(t *testing.T) {
+	resources := tencentcloud.Provider().ResourcesMap
+	assert.Equal(t, "vpc", productOf(resources["tencentcloud_vpc"]))
+	assert.Equal(t, "cbs", productOf(resources["tencentcloud_cbs_storage"]))
+	assert.Equal(t, "cvm", productOf(resources["tencentcloud_instance"]))
+	assert.Equal(t, "clb", productOf(resources["tencentcloud_clb_instance"]))
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generated is a resource read from the cloud, to be written as HCL.
+type generated struct {
+	resourceType string
+	name         string
+	id           string
+	resource     *schema.Resource
+	data         *schema.ResourceData
+}
+
+func (g *generated) address() string {
+	return g.resourceType + "." + g.name
+}
+
+// references maps the ids of the generated resources to the expressions referring to them.
+type references map[string]hcl.Traversal
+
+func (g *generated) reference() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: g.resourceType},
+		hcl.TraverseAttr{Name: g.name},
+		hcl.TraverseAttr{Name: "id"},
+	}
+}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName returns a unique local name made of name, or of id when name has no valid characters.
+func resourceName(name, id string, used map[string]bool) string {
+	local := strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if local == "" {
+		local = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if local == "" || !hclsyntax.ValidIdentifier(local) || (local[0] >= '0' && local[0] <= '9') {
+		local = "r_" + local
+	}
+
+	unique := local
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", local, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+// renderResource appends the resource block of g to body, and returns the sensitive arguments
+// which cannot be read back and have to be filled in by hand.
+func renderResource(body *hclwrite.Body, g *generated, refs references) []string {
+	block := body.AppendNewBlock("resource", []string{g.resourceType, g.name})
+	r := &renderer{refs: refs, self: g.id}
+	r.body(block.Body(), g.resource.Schema, func(key string) interface{} { return g.data.Get(key) }, "")
+	return r.sensitive
+}
+
+// renderImport appends the import block of g to body.
+func renderImport(body *hclwrite.Body, g *generated) {
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: g.resourceType},
+		hcl.TraverseAttr{Name: g.name},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(g.id))
+}
+
+type renderer struct {
+	refs      references
+	self      string
+	sensitive []string
+}
+
+func (r *renderer) body(body *hclwrite.Body, schemas map[string]*schema.Schema, get func(key string) interface{}, prefix string) {
+	keys := make([]string, 0, len(schemas))
+	for key := range schemas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	written := make(map[string]bool)
+	conflicted := func(s *schema.Schema) bool {
+		for _, other := range append(append([]string{}, s.ConflictsWith...), s.ExactlyOneOf...) {
+			if written[other] {
+				return true
+			}
+		}
+		return false
+	}
+
+	blocks := make([]string, 0)
+	for _, key := range keys {
+		s := schemas[key]
+		if key == "id" || (s.Computed && !s.Optional) || s.Deprecated != "" {
+			continue
+		}
+		if isBlock(s) {
+			blocks = append(blocks, key)
+			continue
+		}
+
+		value := get(key)
+		if !s.Required && isDefault(s, value) {
+			continue
+		}
+		if s.Sensitive {
+			r.sensitive = append(r.sensitive, prefix+key)
+			continue
+		}
+		if prefix == "" && conflicted(s) {
+			continue
+		}
+		if tokens := r.tokens(s, value); tokens != nil {
+			body.SetAttributeRaw(key, tokens)
+			written[key] = true
+		}
+	}
+
+	for _, key := range blocks {
+		s := schemas[key]
+		if prefix == "" && conflicted(s) {
+			continue
+		}
+		elem := s.Elem.(*schema.Resource)
+		for _, item := range items(get(key)) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			block := body.AppendNewBlock(key, nil)
+			r.body(block.Body(), elem.Schema, func(k string) interface{} { return m[k] }, prefix+key+".")
+			written[key] = true
+		}
+	}
+}
+
+// tokens returns the expression of value, a reference when it is the id of another generated resource.
+func (r *renderer) tokens(s *schema.Schema, value interface{}) hclwrite.Tokens {
+	switch s.Type {
+	case schema.TypeString:
+		v, _ := value.(string)
+		if ref, ok := r.refs[v]; ok && v != r.self {
+			return hclwrite.TokensForTraversal(ref)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case schema.TypeInt:
+		v, _ := value.(int)
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case schema.TypeFloat:
+		v, _ := value.(float64)
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case schema.TypeBool:
+		v, _ := value.(bool)
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		attrs := make(map[string]cty.Value, len(m))
+		for k, v := range m {
+			attrs[k] = cty.StringVal(fmt.Sprint(v))
+		}
+		return hclwrite.TokensForValue(cty.ObjectVal(attrs))
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			return nil
+		}
+		tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}}
+		for i, item := range items(value) {
+			if i > 0 {
+				tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")})
+			}
+			tokens = append(tokens, r.tokens(elem, item)...)
+		}
+		return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	}
+	return nil
+}
+
+func isBlock(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+	_, ok := s.Elem.(*schema.Resource)
+	return ok
+}
+
+func items(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// isDefault reports whether value can be left out, as it is the default or the zero value.
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return len(items(value)) == 0
+}
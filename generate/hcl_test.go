@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceName(t *testing.T) {
+	used := make(map[string]bool)
+	assert.Equal(t, "web_vpc", resourceName("Web-VPC", "vpc-1", used))
+	assert.Equal(t, "web_vpc_2", resourceName("web vpc", "vpc-2", used))
+	assert.Equal(t, "vpc_3", resourceName("默认", "vpc-3", used))
+	assert.Equal(t, "r_1st", resourceName("1st", "vpc-4", used))
+}
+
+func TestTagFilters(t *testing.T) {
+	tags := tagFilters{}
+	assert.NoError(t, tags.Set("env=prod"))
+	assert.NoError(t, tags.Set("team=a=b"))
+	assert.Error(t, tags.Set("env"))
+	assert.Equal(t, "env=prod,team=a=b", tags.String())
+}
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":   {Type: schema.TypeString, Required: true},
+			"vpc_id": {Type: schema.TypeString, Required: true},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cos_object":  {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"zip_file"}},
+			"zip_file":    {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"cos_object"}},
+			"enabled":     {Type: schema.TypeBool, Optional: true, Default: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"create_time": {Type: schema.TypeString, Computed: true},
+			"tags":        {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port":      {Type: schema.TypeInt, Required: true},
+						"subnet_id": {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderResource(t *testing.T) {
+	res := testResource()
+	d := res.Data(nil)
+	d.SetId("ins-1")
+	_ = d.Set("name", "web")
+	_ = d.Set("vpc_id", "vpc-1")
+	_ = d.Set("security_groups", []interface{}{"sg-1", "sg-external"})
+	_ = d.Set("cos_object", "code.zip")
+	_ = d.Set("zip_file", "/tmp/code.zip")
+	_ = d.Set("enabled", false)
+	_ = d.Set("password", "secret")
+	_ = d.Set("create_time", "2024-01-01 00:00:00")
+	_ = d.Set("tags", map[string]interface{}{"env": "prod"})
+	_ = d.Set("rule", []interface{}{map[string]interface{}{"port": 80, "subnet_id": "subnet-1"}})
+
+	vpc := &generated{resourceType: "tencentcloud_vpc", name: "main", id: "vpc-1"}
+	subnet := &generated{resourceType: "tencentcloud_subnet", name: "app", id: "subnet-1"}
+	sg := &generated{resourceType: "tencentcloud_security_group", name: "web", id: "sg-1"}
+	refs := references{vpc.id: vpc.reference(), subnet.id: subnet.reference(), sg.id: sg.reference()}
+
+	g := &generated{resourceType: "tencentcloud_instance", name: "web", id: "ins-1", resource: res, data: d}
+	file := hclwrite.NewEmptyFile()
+	sensitive := renderResource(file.Body(), g, refs)
+	file.Body().AppendNewline()
+	renderImport(file.Body(), g)
+
+	assert.Equal(t, []string{"password"}, sensitive)
+	assert.Equal(t, `resource "tencentcloud_instance" "web" {
+  cos_object      = "code.zip"
+  enabled         = false
+  name            = "web"
+  security_groups = [tencentcloud_security_group.web.id, "sg-external"]
+  tags = {
+    env = "prod"
+  }
+  vpc_id = tencentcloud_vpc.main.id
+  rule {
+    port      = 80
+    subnet_id = tencentcloud_subnet.app.id
+  }
+}
+
+import {
+  to = tencentcloud_instance.web
+  id = "ins-1"
+}
+`, string(hclwrite.Format(file.Bytes())))
+}
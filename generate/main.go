@@ -0,0 +1,308 @@
+// Command generate brings the resources of an existing account under Terraform.
+//
+// It enumerates the resources of the importable types with a lister in discover.go in each region,
+// reads them with the provider itself, and writes the HCL of the resources along with the `import` blocks of them,
+// with the ids of other generated resources replaced by references. Credentials are taken from
+// the same environment variables as the provider, such as TENCENTCLOUD_SECRET_ID.
+//
+//	go run ./generate -region ap-guangzhou -product vpc,cvm -tag env=prod -output ./imported
+//
+// With -migrate, it moves the resources in a state replaced by newer ones, such as
+// tencentcloud_ccn_attachment by tencentcloud_ccn_attachment_v2, instead.
+//
+//	terraform show -json > state.json && go run ./generate -migrate state.json -output .
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+// tagFilters collects the repeated `-tag key=value` flags.
+type tagFilters map[string]string
+
+func (t tagFilters) String() string {
+	pairs := make([]string, 0, len(t))
+	for k, v := range t {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (t tagFilters) Set(value string) error {
+	kv := strings.SplitN(value, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("tag filter %q must be in the format of key=value", value)
+	}
+	t[kv[0]] = kv[1]
+	return nil
+}
+
+// filter selects the resources to generate.
+type filter struct {
+	products  map[string]bool
+	tags      tagFilters
+	nameRegex *regexp.Regexp
+	// taggedIds are the ids of the resources with the tags, nil without tag filters.
+	taggedIds map[string]bool
+}
+
+func (f *filter) product(product string) bool {
+	return len(f.products) == 0 || f.products[product]
+}
+
+func (f *filter) name(name string) bool {
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+// mayBeTagged reports whether the resource of id may have the tags, checked before reading it.
+func (f *filter) mayBeTagged(id string) bool {
+	return f.taggedIds == nil || f.taggedIds[id]
+}
+
+// tagged reports whether the resource has all the tags, resources without tags never match.
+func (f *filter) tagged(res *schema.Resource, d *schema.ResourceData) bool {
+	if len(f.tags) == 0 {
+		return true
+	}
+	if s, ok := res.Schema["tags"]; !ok || s.Type != schema.TypeMap {
+		return false
+	}
+	tags, _ := d.Get("tags").(map[string]interface{})
+	for k, v := range f.tags {
+		if value, ok := tags[k]; !ok || value.(string) != v {
+			return false
+		}
+	}
+	return true
+}
+
+func main() {
+	var (
+		regions   = flag.String("region", os.Getenv("TENCENTCLOUD_REGION"), "comma separated regions to generate, such as ap-guangzhou,ap-shanghai")
+		products  = flag.String("product", "", "comma separated products to generate, all supported products by default")
+		nameRegex = flag.String("name-regex", "", "only generate the resources whose names match the regular expression")
+		output    = flag.String("output", "generated", "directory to write the configurations to, one sub directory per region")
+		state     = flag.String("migrate", "", "the output of `terraform show -json`, whose resources replaced by newer ones are moved to them")
+		tags      = tagFilters{}
+	)
+	flag.Var(tags, "tag", "only generate the resources with the tag, in the format of key=value, can be repeated")
+	flag.Parse()
+
+	if *state != "" {
+		if err := migrate(*state, *output); err != nil {
+			log.Fatalf("migrate failed: %v", err)
+		}
+		return
+	}
+
+	if *regions == "" {
+		log.Fatal("-region is required")
+	}
+
+	f := &filter{products: make(map[string]bool), tags: tags}
+	discs := discoverers(tencentcloud.Provider().ResourcesMap)
+	supported := make(map[string]bool)
+	for _, d := range discs {
+		supported[d.product] = true
+	}
+	for _, product := range strings.Split(*products, ",") {
+		if product = strings.TrimSpace(product); product == "" {
+			continue
+		}
+		if !supported[product] {
+			log.Fatalf("product %s is not supported, supported products: %s", product, strings.Join(supportedProducts(discs), ","))
+		}
+		f.products[product] = true
+	}
+	if *nameRegex != "" {
+		regex, err := regexp.Compile(*nameRegex)
+		if err != nil {
+			log.Fatalf("invalid -name-regex: %v", err)
+		}
+		f.nameRegex = regex
+	}
+
+	for _, region := range strings.Split(*regions, ",") {
+		region = strings.TrimSpace(region)
+		if region == "" {
+			continue
+		}
+		if err := generate(region, f, filepath.Join(*output, region)); err != nil {
+			log.Fatalf("generate region %s failed: %v", region, err)
+		}
+	}
+}
+
+func supportedProducts(discs []discoverer) []string {
+	products := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, d := range discs {
+		if !seen[d.product] {
+			seen[d.product] = true
+			products = append(products, d.product)
+		}
+	}
+	return products
+}
+
+func generate(region string, f *filter, dir string) error {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	provider := tencentcloud.Provider()
+	diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{"region": region}))
+	if diags.HasError() {
+		return fmt.Errorf("configure provider failed: %v", diags[0].Summary)
+	}
+	meta := provider.Meta()
+	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+
+	if len(f.tags) > 0 {
+		ids, err := taggedIds(ctx, client, f.tags)
+		if err != nil {
+			return fmt.Errorf("list tagged resources failed: %v", err)
+		}
+		f.taggedIds = ids
+	}
+
+	var (
+		resources = make([]*generated, 0)
+		products  = make([]string, 0)
+		byProduct = make(map[string][]*generated)
+		used      = make(map[string]bool)
+	)
+	for _, disc := range discoverers(provider.ResourcesMap) {
+		if !f.product(disc.product) {
+			continue
+		}
+		res := provider.ResourcesMap[disc.resourceType]
+
+		found, err := disc.list(ctx, client)
+		if err != nil {
+			return fmt.Errorf("list %s failed: %v", disc.resourceType, err)
+		}
+		for _, item := range found {
+			if !f.name(item.name) || !f.mayBeTagged(item.id) {
+				continue
+			}
+			d, err := read(ctx, res, item.id, meta)
+			if err != nil {
+				return fmt.Errorf("read %s %s failed: %v", disc.resourceType, item.id, err)
+			}
+			if d == nil || !f.tagged(res, d) {
+				continue
+			}
+
+			g := &generated{
+				resourceType: disc.resourceType,
+				id:           item.id,
+				name:         resourceName(item.name, item.id, used),
+				resource:     res,
+				data:         d,
+			}
+			resources = append(resources, g)
+			if _, ok := byProduct[disc.product]; !ok {
+				products = append(products, disc.product)
+			}
+			byProduct[disc.product] = append(byProduct[disc.product], g)
+		}
+	}
+
+	refs := make(references, len(resources))
+	for _, g := range resources {
+		refs[g.id] = g.reference()
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	providerFile := hclwrite.NewEmptyFile()
+	providerFile.Body().AppendNewBlock("provider", []string{"tencentcloud"}).Body().SetAttributeValue("region", cty.StringVal(region))
+	if err := writeFile(filepath.Join(dir, "provider.tf"), providerFile); err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		file := hclwrite.NewEmptyFile()
+		for i, g := range byProduct[product] {
+			if i > 0 {
+				file.Body().AppendNewline()
+			}
+			for _, key := range renderResource(file.Body(), g, refs) {
+				log.Printf("[WARN] %s: sensitive argument %s cannot be read back, fill it in by hand", g.address(), key)
+			}
+		}
+		if err := writeFile(filepath.Join(dir, product+".tf"), file); err != nil {
+			return err
+		}
+	}
+
+	imports := hclwrite.NewEmptyFile()
+	for i, g := range resources {
+		if i > 0 {
+			imports.Body().AppendNewline()
+		}
+		renderImport(imports.Body(), g)
+	}
+	if err := writeFile(filepath.Join(dir, "imports.tf"), imports); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] %d resources of region %s are generated to %s", len(resources), region, dir)
+	return nil
+}
+
+// read imports the resource of id and reads it, it returns nil when the resource is not found.
+func read(ctx context.Context, res *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	state := &terraform.InstanceState{ID: id, Attributes: map[string]string{"id": id}}
+
+	if res.Importer != nil {
+		var (
+			imported []*schema.ResourceData
+			err      error
+		)
+		switch {
+		case res.Importer.StateContext != nil:
+			imported, err = res.Importer.StateContext(ctx, res.Data(state), meta)
+		case res.Importer.State != nil:
+			imported, err = res.Importer.State(res.Data(state), meta)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) > 0 {
+			state = imported[0].State()
+		}
+	}
+
+	newState, diags := res.RefreshWithoutUpgrade(ctx, state, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+	if newState == nil || newState.ID == "" {
+		return nil, nil
+	}
+	return res.Data(newState), nil
+}
+
+func writeFile(path string, file *hclwrite.File) error {
+	return os.WriteFile(path, hclwrite.Format(file.Bytes()), 0644)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+// stateJSON is the part of the output of `terraform show -json` read by migrate.
+type stateJSON struct {
+	Values struct {
+		RootModule moduleJSON `json:"root_module"`
+	} `json:"values"`
+}
+
+type moduleJSON struct {
+	Address      string         `json:"address"`
+	Resources    []resourceJSON `json:"resources"`
+	ChildModules []moduleJSON   `json:"child_modules"`
+}
+
+type resourceJSON struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Index   interface{}            `json:"index"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+// moved is an instance imported to the resource which replaces the one of its state.
+type moved struct {
+	resourceType string
+	name         string
+	id           string
+}
+
+// migrate reads the state file written by `terraform show -json`, and writes `moved.tf` to dir,
+// which removes the resources replaced by others from the state, and imports them to the new ones.
+func migrate(stateFile, dir string) error {
+	raw, err := os.ReadFile(stateFile)
+	if err != nil {
+		return err
+	}
+	var state stateJSON
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return fmt.Errorf("read state %s failed, it should be the output of `terraform show -json`: %v", stateFile, err)
+	}
+
+	removed, imports, err := planMoves(state.Values.RootModule, tencentcloud.StateMoves())
+	if err != nil {
+		return err
+	}
+	for _, child := range state.Values.RootModule.ChildModules {
+		warnModule(child)
+	}
+
+	file := hclwrite.NewEmptyFile()
+	for i, from := range removed {
+		if i > 0 {
+			file.Body().AppendNewline()
+		}
+		block := file.Body().AppendNewBlock("removed", nil)
+		block.Body().SetAttributeTraversal("from", hcl.Traversal{
+			hcl.TraverseRoot{Name: from[0]},
+			hcl.TraverseAttr{Name: from[1]},
+		})
+		block.Body().AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+	}
+	for _, m := range imports {
+		file.Body().AppendNewline()
+		block := file.Body().AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: m.resourceType},
+			hcl.TraverseAttr{Name: m.name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(m.id))
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, "moved.tf")
+	if err := writeFile(path, file); err != nil {
+		return err
+	}
+	log.Printf("[INFO] %d resources are moved to %d new ones in %s", len(removed), len(imports), path)
+	return nil
+}
+
+// planMoves returns the resources of module to remove, as pairs of type and name, and the
+// instances to import.
+func planMoves(module moduleJSON, moves []tencentcloud.StateMove) ([][2]string, []moved, error) {
+	byFrom := make(map[string]tencentcloud.StateMove, len(moves))
+	for _, m := range moves {
+		byFrom[m.From] = m
+	}
+
+	used := make(map[string]map[string]bool)
+	for _, r := range module.Resources {
+		if used[r.Type] == nil {
+			used[r.Type] = make(map[string]bool)
+		}
+		used[r.Type][r.Name] = true
+	}
+
+	var (
+		removed = make([][2]string, 0)
+		imports = make([]moved, 0)
+		seen    = make(map[[2]string]bool)
+	)
+	for _, r := range module.Resources {
+		move, ok := byFrom[r.Type]
+		if !ok || r.Mode != "managed" {
+			continue
+		}
+
+		ids, err := move.ImportIds(r.Values)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s cannot be moved to %s: %v", r.Address, move.To, err)
+		}
+		if from := [2]string{r.Type, r.Name}; !seen[from] {
+			seen[from] = true
+			removed = append(removed, from)
+		}
+		if used[move.To] == nil {
+			used[move.To] = make(map[string]bool)
+		}
+		for i, id := range ids {
+			name := r.Name
+			if r.Index != nil {
+				name = fmt.Sprintf("%s_%v", name, r.Index)
+			}
+			if len(ids) > 1 {
+				name = fmt.Sprintf("%s_%d", name, i)
+			}
+			imports = append(imports, moved{resourceType: move.To, name: resourceName(name, id, used[move.To]), id: id})
+		}
+	}
+	return removed, imports, nil
+}
+
+// warnModule warns of the resources to move in child modules, whose configurations are not
+// generated, so they are left to be moved by hand.
+func warnModule(module moduleJSON) {
+	byFrom := make(map[string]string)
+	for _, m := range tencentcloud.StateMoves() {
+		byFrom[m.From] = m.To
+	}
+	for _, r := range module.Resources {
+		if to, ok := byFrom[r.Type]; ok {
+			log.Printf("[WARN] %s in module %s is not moved, move it to %s by hand", r.Address, module.Address, to)
+		}
+	}
+	for _, child := range module.ChildModules {
+		warnModule(child)
+	}
+}
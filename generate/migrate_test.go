@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+func TestPlanMoves(t *testing.T) {
+	moves := []tencentcloud.StateMove{
+		{
+			From: "tencentcloud_rule",
+			To:   "tencentcloud_rule_v2",
+			ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+				zoneId, ok := attributes["zone_id"].(string)
+				if !ok {
+					return nil, fmt.Errorf("attribute zone_id is not set")
+				}
+				return []string{zoneId + "#rule-1", zoneId + "#rule-2"}, nil
+			},
+		},
+		{
+			From: "tencentcloud_attachment",
+			To:   "tencentcloud_attachment_v2",
+			ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+				return []string{attributes["id"].(string)}, nil
+			},
+		},
+	}
+	module := moduleJSON{
+		Resources: []resourceJSON{
+			{Mode: "managed", Type: "tencentcloud_rule", Name: "zone", Values: map[string]interface{}{"zone_id": "zone-1"}},
+			{Mode: "managed", Type: "tencentcloud_attachment", Name: "a", Index: 0.0, Values: map[string]interface{}{"id": "a-0"}},
+			{Mode: "managed", Type: "tencentcloud_attachment", Name: "a", Index: 1.0, Values: map[string]interface{}{"id": "a-1"}},
+			{Mode: "managed", Type: "tencentcloud_attachment", Name: "b", Values: map[string]interface{}{"id": "b"}},
+			{Mode: "managed", Type: "tencentcloud_attachment_v2", Name: "b", Values: map[string]interface{}{"id": "b-v2"}},
+			{Mode: "data", Type: "tencentcloud_attachment", Name: "c", Values: map[string]interface{}{"id": "c"}},
+			{Mode: "managed", Type: "tencentcloud_vpc", Name: "vpc", Values: map[string]interface{}{"id": "vpc-1"}},
+		},
+	}
+
+	removed, imports, err := planMoves(module, moves)
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]string{
+		{"tencentcloud_rule", "zone"},
+		{"tencentcloud_attachment", "a"},
+		{"tencentcloud_attachment", "b"},
+	}, removed)
+	assert.Equal(t, []moved{
+		{resourceType: "tencentcloud_rule_v2", name: "zone_0", id: "zone-1#rule-1"},
+		{resourceType: "tencentcloud_rule_v2", name: "zone_1", id: "zone-1#rule-2"},
+		{resourceType: "tencentcloud_attachment_v2", name: "a_0", id: "a-0"},
+		{resourceType: "tencentcloud_attachment_v2", name: "a_1", id: "a-1"},
+		{resourceType: "tencentcloud_attachment_v2", name: "b_2", id: "b"},
+	}, imports)
+
+	module.Resources = []resourceJSON{{Address: "tencentcloud_rule.broken", Mode: "managed", Type: "tencentcloud_rule", Name: "broken"}}
+	_, _, err = planMoves(module, moves)
+	assert.EqualError(t, err, "tencentcloud_rule.broken cannot be moved to tencentcloud_rule_v2: attribute zone_id is not set")
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	state := map[string]interface{}{
+		"values": map[string]interface{}{
+			"root_module": map[string]interface{}{
+				"resources": []interface{}{
+					map[string]interface{}{
+						"address": "tencentcloud_ccn_attachment.attachment",
+						"mode":    "managed",
+						"type":    "tencentcloud_ccn_attachment",
+						"name":    "attachment",
+						"values": map[string]interface{}{
+							"id":              "0123456789abcdef",
+							"ccn_id":          "ccn-1",
+							"instance_type":   "VPC",
+							"instance_region": "ap-guangzhou",
+							"instance_id":     "vpc-1",
+						},
+					},
+				},
+			},
+		},
+	}
+	raw, err := json.Marshal(state)
+	assert.NoError(t, err)
+	stateFile := filepath.Join(dir, "state.json")
+	assert.NoError(t, os.WriteFile(stateFile, raw, 0644))
+
+	assert.NoError(t, migrate(stateFile, dir))
+	content, err := os.ReadFile(filepath.Join(dir, "moved.tf"))
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`removed {
+  from = tencentcloud_ccn_attachment.attachment
+  lifecycle {
+    destroy = false
+  }
+}
+
+import {
+  to = tencentcloud_ccn_attachment_v2.attachment
+  id = %q
+}
+`, "ccn-1#VPC#ap-guangzhou#vpc-1"), string(content))
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// structuralKeys are the keys of the metadata which are left out of the paths of the attributes, or
+// replaced.
+var structuralKeys = map[string]string{
+	"provider_meta":           "provider",
+	"provider_filter":         "provider",
+	"resource_metas":          "",
+	"resource_filters":        "",
+	"provider_filter_schemas": "",
+	providerSource:            "",
+}
+
+// compare reports the attributes missing from or stale in current, the content of the file of
+// name, compared with generated, or that the file is out of date if they differ otherwise.
+func compare(name string, current, generated []byte) ([]string, error) {
+	var c, g yaml.MapSlice
+	if err := yaml.Unmarshal(current, &c); err != nil {
+		return nil, fmt.Errorf("parse %s failed: %v", name, err)
+	}
+	if err := yaml.Unmarshal(generated, &g); err != nil {
+		return nil, err
+	}
+	currentPaths, generatedPaths := map[string]bool{}, map[string]bool{}
+	attributePaths(c, nil, currentPaths)
+	attributePaths(g, nil, generatedPaths)
+
+	var problems []string
+	for _, path := range sortedPaths(generatedPaths) {
+		if !currentPaths[path] {
+			problems = append(problems, fmt.Sprintf("%s: attribute %s is missing", name, path))
+		}
+	}
+	for _, path := range sortedPaths(currentPaths) {
+		if !generatedPaths[path] {
+			problems = append(problems, fmt.Sprintf("%s: attribute %s is stale", name, path))
+		}
+	}
+	if len(problems) == 0 && !bytes.Equal(current, generated) {
+		problems = append(problems, fmt.Sprintf("%s: metadata of the attributes is out of date", name))
+	}
+	return problems, nil
+}
+
+// attributePaths collects the paths of the attributes of m, such as
+// tencentcloud_dnspod_record.domain.
+func attributePaths(m yaml.MapSlice, path []string, paths map[string]bool) {
+	for _, item := range m {
+		key := fmt.Sprint(item.Key)
+		v, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		if key != "block" {
+			if replaced, ok := structuralKeys[key]; ok {
+				key = replaced
+			}
+			next := path
+			if key != "" {
+				next = append(append([]string{}, path...), key)
+			}
+			attributePaths(v, next, paths)
+			continue
+		}
+		attributes, _ := get(v, "attributes")
+		for _, attr := range toMapSlice(attributes) {
+			attrPath := append(append([]string{}, path...), fmt.Sprint(attr.Key))
+			paths[strings.Join(attrPath, ".")] = true
+			attributePaths(toMapSlice(attr.Value), attrPath, paths)
+		}
+	}
+}
+
+func sortedPaths(paths map[string]bool) []string {
+	result := make([]string, 0, len(paths))
+	for path := range paths {
+		result = append(result, path)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,95 @@
+// Command genmeta generates the metadata of the console, schema/metadata.yaml and schema/filter.json,
+// from the schemas of the provider.
+//
+// The display names, the widgets, the choices and the encryption of the attributes are derived from
+// the schemas, and merged with the hand-curated metadata of schema/overrides.yaml, such as the
+// Chinese display names and the remote choices.
+//
+//	go run ./genmeta
+//
+// With -check, it writes nothing, but reports the attributes missing from or stale in the metadata
+// and exits non-zero if any.
+//
+//	go run ./genmeta -check
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+const (
+	overridesFile = "overrides.yaml"
+	metadataFile  = "metadata.yaml"
+	filterFile    = "filter.json"
+)
+
+func main() {
+	dir := flag.String("dir", "schema", "directory of the metadata and the overrides")
+	check := flag.Bool("check", false, "report the attributes missing from or stale in the metadata instead of writing it")
+	flag.Parse()
+	log.SetFlags(0)
+
+	overrides, err := loadOverrides(filepath.Join(*dir, overridesFile))
+	if err != nil {
+		log.Fatalf("[FAIL!]%v", err)
+	}
+
+	g := &generator{provider: tencentcloud.Provider(), overrides: overrides}
+	metadata, err := g.metadata()
+	if err != nil {
+		log.Fatalf("[FAIL!]%v", err)
+	}
+	filter, err := g.filter()
+	if err != nil {
+		log.Fatalf("[FAIL!]%v", err)
+	}
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{metadataFile, metadata},
+		{filterFile, filter},
+	}
+
+	if *check {
+		var problems []string
+		problems = append(problems, g.problems...)
+		for _, file := range files {
+			name := filepath.Join(*dir, file.name)
+			current, err := os.ReadFile(name)
+			if err != nil {
+				log.Fatalf("[FAIL!]%v", err)
+			}
+			p, err := compare(name, current, file.content)
+			if err != nil {
+				log.Fatalf("[FAIL!]%v", err)
+			}
+			problems = append(problems, p...)
+		}
+		for _, p := range problems {
+			log.Printf("[FAIL!]%s", p)
+		}
+		if len(problems) > 0 {
+			log.Fatalf("[FAIL!]%d problems found, run `go run ./genmeta` to update the metadata", len(problems))
+		}
+		log.Printf("[SUCC.]metadata is up to date")
+		return
+	}
+
+	for _, p := range g.problems {
+		log.Printf("[WARN]%s", p)
+	}
+	for _, file := range files {
+		name := filepath.Join(*dir, file.name)
+		if err := os.WriteFile(name, file.content, 0644); err != nil {
+			log.Fatalf("[FAIL!]%v", err)
+		}
+		fmt.Printf("[SUCC.]write metadata to file success: %s\n", name)
+	}
+}
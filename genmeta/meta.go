@@ -0,0 +1,525 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// providerSource is the source address of the provider in the filters.
+	providerSource = "tencentcloudstack/tencentcloud"
+
+	metadataHeader = "# Code generated by genmeta from the provider schemas and overrides.yaml. DO NOT EDIT.\n"
+)
+
+// overrides is the hand-curated metadata, which is merged over the generated one.
+type overrides struct {
+	Provider  entityOverrides            `yaml:"provider"`
+	Resources map[string]entityOverrides `yaml:"resources"`
+	Filters   struct {
+		Provider  entityOverrides            `yaml:"provider"`
+		Resources map[string]entityOverrides `yaml:"resources"`
+		// Options are the settings of the filters of the provider, such as resources_display.
+		Options yaml.MapSlice `yaml:"options"`
+	} `yaml:"filters"`
+}
+
+// entityOverrides is the hand-curated metadata of the provider, a resource or a filter.
+type entityOverrides struct {
+	// DataSource is the data source whose arguments are the attributes of a filter.
+	DataSource string `yaml:"data_source"`
+	// Exclude are the attributes left out of the metadata.
+	Exclude    []string      `yaml:"exclude"`
+	Metadata   yaml.MapSlice `yaml:"metadata"`
+	Attributes yaml.MapSlice `yaml:"attributes"`
+	Operations []interface{} `yaml:"operations"`
+}
+
+func loadOverrides(filename string) (*overrides, error) {
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	o := &overrides{}
+	if err := yaml.UnmarshalStrict(raw, o); err != nil {
+		return nil, fmt.Errorf("parse %s failed: %v", filename, err)
+	}
+	return o, nil
+}
+
+// generator generates the metadata from the schemas of provider and the overrides.
+type generator struct {
+	provider  *schema.Provider
+	overrides *overrides
+	// problems are the overrides which do not match the schemas.
+	problems []string
+
+	providerMeta yaml.MapSlice
+}
+
+// providerEntity returns the metadata of the provider, which is shared by the filters.
+func (g *generator) providerEntity() yaml.MapSlice {
+	if g.providerMeta == nil {
+		g.providerMeta = g.entity("provider", g.provider.Schema, g.overrides.Provider, true)
+	}
+	return g.providerMeta
+}
+
+// metadata returns the content of metadata.yaml.
+func (g *generator) metadata() ([]byte, error) {
+	result := yaml.MapSlice{
+		{Key: "provider_meta", Value: g.providerEntity()},
+	}
+
+	names := make([]string, 0, len(g.overrides.Resources))
+	for name := range g.overrides.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resources := yaml.MapSlice{}
+	for _, name := range names {
+		r, ok := g.provider.ResourcesMap[name]
+		if !ok {
+			g.problemf("resource %s of the overrides is not supported by the provider", name)
+			continue
+		}
+		resources = append(resources, yaml.MapItem{Key: name, Value: g.entity(name, withId(r.Schema), g.overrides.Resources[name], false)})
+	}
+	result = append(result, yaml.MapItem{Key: "resource_metas", Value: resources})
+
+	content, err := yaml.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(metadataHeader), content...), nil
+}
+
+// entity returns the metadata of the provider or a resource of name with the attributes of s.
+func (g *generator) entity(name string, s map[string]*schema.Schema, o entityOverrides, provider bool) yaml.MapSlice {
+	excluded := make(map[string]bool)
+	for _, key := range o.Exclude {
+		if _, ok := s[key]; !ok {
+			g.problemf("excluded attribute %s of %s is not in the schema", key, name)
+		}
+		excluded[key] = true
+	}
+
+	var keys, arguments []string
+	for key, v := range s {
+		if excluded[key] || v.Deprecated != "" {
+			continue
+		}
+		keys = append(keys, key)
+		if v.Required || v.Optional {
+			arguments = append(arguments, key)
+		}
+	}
+	sortKeys(keys)
+	sort.Strings(arguments)
+
+	attributes := yaml.MapSlice{}
+	for _, key := range keys {
+		attributes = append(attributes, yaml.MapItem{Key: key, Value: attribute(key, s[key], provider)})
+	}
+	attributes = g.mergeAttributes(name, attributes, o.Attributes)
+
+	metadata := yaml.MapSlice{
+		{Key: "display_name", Value: name},
+		{Key: "attr_orders", Value: g.attrOrders(name, arguments, o.Metadata)},
+	}
+	metadata = merge(metadata, remove(o.Metadata, "attr_orders"))
+
+	result := yaml.MapSlice{
+		{Key: "metadata", Value: metadata},
+		{Key: "block", Value: yaml.MapSlice{{Key: "attributes", Value: attributes}}},
+	}
+	if len(o.Operations) > 0 {
+		result = append(result, yaml.MapItem{Key: "operations", Value: o.Operations})
+	}
+	return result
+}
+
+// attrOrders returns the order of the arguments, which is the one of the overrides followed by the
+// rest of the arguments.
+func (g *generator) attrOrders(name string, arguments []string, metadata yaml.MapSlice) []interface{} {
+	var orders []interface{}
+	ordered := make(map[string]bool)
+	if v, ok := get(metadata, "attr_orders"); ok {
+		items, _ := v.([]interface{})
+		for _, item := range items {
+			key, _ := get(toMapSlice(item), "name")
+			if !contains(arguments, fmt.Sprint(key)) {
+				g.problemf("attribute %v in attr_orders of %s is not an argument", key, name)
+				continue
+			}
+			orders = append(orders, item)
+			ordered[fmt.Sprint(key)] = true
+		}
+	}
+	for _, key := range arguments {
+		if !ordered[key] {
+			orders = append(orders, yaml.MapSlice{{Key: "name", Value: key}})
+		}
+	}
+	return orders
+}
+
+// filter returns the content of filter.json.
+func (g *generator) filter() ([]byte, error) {
+	o := g.overrides.Filters
+
+	providerMetadata, _ := get(g.providerEntity(), "metadata")
+	providerFilter := yaml.MapSlice{
+		{Key: "block", Value: yaml.MapSlice{}},
+		{Key: "metadata", Value: merge(toMapSlice(providerMetadata), o.Provider.Metadata)},
+	}
+
+	names := make([]string, 0, len(o.Resources))
+	for name := range o.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resourceFilters := yaml.MapSlice{}
+	for _, name := range names {
+		filter := o.Resources[name]
+		d, ok := g.provider.DataSourcesMap[filter.DataSource]
+		if !ok {
+			g.problemf("data source %q of the filter of %s is not supported by the provider", filter.DataSource, name)
+			continue
+		}
+
+		attributes := yaml.MapSlice{}
+		for _, item := range filter.Attributes {
+			key := fmt.Sprint(item.Key)
+			// the others are reported by mergeAttributes.
+			s, ok := d.Schema[key]
+			if !ok || !s.Required && !s.Optional {
+				continue
+			}
+			attributes = append(attributes, yaml.MapItem{Key: key, Value: filterAttribute(key, s)})
+		}
+		attributes = g.mergeAttributes("the filter of "+name, attributes, filter.Attributes)
+
+		resourceFilters = append(resourceFilters, yaml.MapItem{Key: name, Value: yaml.MapSlice{
+			{Key: "block", Value: yaml.MapSlice{{Key: "attributes", Value: attributes}}},
+			{Key: "metadata", Value: merge(yaml.MapSlice{{Key: "display_name", Value: name}}, filter.Metadata)},
+		}})
+	}
+
+	schemas := yaml.MapSlice{
+		{Key: "provider_filter", Value: providerFilter},
+		{Key: "resource_filters", Value: resourceFilters},
+	}
+	schemas = append(schemas, o.Options...)
+	result := yaml.MapSlice{
+		{Key: "provider_filter_schemas", Value: yaml.MapSlice{
+			{Key: providerSource, Value: yaml.MapSlice{{Key: providerSource, Value: schemas}}},
+		}},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := writeJSON(buf, result, ""); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// attribute returns the metadata of the attribute key of s.
+func attribute(key string, s *schema.Schema, provider bool) yaml.MapSlice {
+	result := yaml.MapSlice{{Key: "display_name", Value: key}}
+	result = append(result, widget(s)...)
+	if s.Sensitive {
+		result = append(result, yaml.MapItem{Key: "is_encrypt", Value: true})
+	}
+	if provider {
+		result = append(result, yaml.MapItem{Key: "is_public", Value: true})
+	}
+	if !s.Required && !s.Optional {
+		result = append(result,
+			yaml.MapItem{Key: "is_backfilled", Value: true},
+			yaml.MapItem{Key: "is_not_edited", Value: true},
+			yaml.MapItem{Key: "constraint_mode", Value: "READONLY"},
+		)
+	}
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		keys := make([]string, 0, len(r.Schema))
+		for k := range r.Schema {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attributes := yaml.MapSlice{}
+		for _, k := range keys {
+			attributes = append(attributes, yaml.MapItem{Key: k, Value: attribute(k, r.Schema[k], false)})
+		}
+		result = append(result, yaml.MapItem{Key: "block", Value: yaml.MapSlice{{Key: "attributes", Value: attributes}}})
+	}
+	return result
+}
+
+// filterAttribute returns the filter of the argument key of s.
+func filterAttribute(key string, s *schema.Schema) yaml.MapSlice {
+	metadata := yaml.MapSlice{
+		{Key: "display_name", Value: key},
+		{Key: "description", Value: s.Description},
+	}
+	metadata = append(metadata, widget(s)...)
+	metadata = append(metadata, yaml.MapItem{Key: "val_source", Value: yaml.MapSlice{{Key: "values", Value: yaml.MapSlice{}}}})
+
+	result := yaml.MapSlice{
+		{Key: "type", Value: typeName(s)},
+		{Key: "metadata", Value: metadata},
+	}
+	if s.Required {
+		result = append(result, yaml.MapItem{Key: "required", Value: true})
+	} else {
+		result = append(result, yaml.MapItem{Key: "optional", Value: true})
+	}
+	return append(result, yaml.MapItem{Key: "description", Value: s.Description})
+}
+
+// widget returns the widget of s, and the choices of it if any.
+func widget(s *schema.Schema) yaml.MapSlice {
+	switch s.Type {
+	case schema.TypeBool:
+		return yaml.MapSlice{{Key: "widget", Value: "select"}, {Key: "values", Value: choices(true, false)}}
+	case schema.TypeString:
+		if values, ok := validValues(s); ok {
+			choice := make([]interface{}, 0, len(values))
+			for _, v := range values {
+				choice = append(choice, v)
+			}
+			return yaml.MapSlice{{Key: "widget", Value: "select"}, {Key: "values", Value: choices(choice...)}}
+		}
+		return yaml.MapSlice{{Key: "widget", Value: "text"}}
+	case schema.TypeInt, schema.TypeFloat:
+		return yaml.MapSlice{{Key: "widget", Value: "text"}}
+	}
+	if _, ok := s.Elem.(*schema.Resource); ok {
+		return yaml.MapSlice{{Key: "widget", Value: "block"}}
+	}
+	return yaml.MapSlice{{Key: "widget", Value: "textarea"}}
+}
+
+// validValuesRegexp matches the sentence of a description listing the valid values of an argument,
+// such as "Valid values: `HTTP` and `HTTPS`.".
+var (
+	validValuesRegexp = regexp.MustCompile("Valid values:((?:[\\s,]*(?:and |or )?`[^`]+`)+)")
+	quotedRegexp      = regexp.MustCompile("`([^`]+)`")
+)
+
+// validValues returns the valid values listed in the description of the string argument s, if it
+// is validated and its validation accepts all of them, which keeps the choices from drifting from
+// the values actually allowed.
+func validValues(s *schema.Schema) ([]string, bool) {
+	if s.ValidateFunc == nil {
+		return nil, false
+	}
+	match := validValuesRegexp.FindStringSubmatch(s.Description)
+	if match == nil {
+		return nil, false
+	}
+	values := make([]string, 0)
+	for _, quoted := range quotedRegexp.FindAllStringSubmatch(match[1], -1) {
+		if _, errs := s.ValidateFunc(quoted[1], "value"); len(errs) > 0 {
+			return nil, false
+		}
+		values = append(values, quoted[1])
+	}
+	return values, true
+}
+
+func choices(values ...interface{}) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, yaml.MapSlice{
+			{Key: "value", Value: v},
+			{Key: "display_name", Value: fmt.Sprint(v)},
+			{Key: "icon_url", Value: ""},
+		})
+	}
+	return result
+}
+
+// typeName returns the type of s in the filters, such as string and set_string.
+func typeName(s *schema.Schema) string {
+	names := map[schema.ValueType]string{
+		schema.TypeBool:   "bool",
+		schema.TypeInt:    "number",
+		schema.TypeFloat:  "number",
+		schema.TypeString: "string",
+		schema.TypeList:   "list",
+		schema.TypeSet:    "set",
+		schema.TypeMap:    "map",
+	}
+	name := names[s.Type]
+	if elem, ok := s.Elem.(*schema.Schema); ok {
+		name += "_" + names[elem.Type]
+	} else if s.Type == schema.TypeMap {
+		name += "_string"
+	}
+	return name
+}
+
+// mergeAttributes merges the attributes of o over the ones of base, which must be the ones of base.
+func (g *generator) mergeAttributes(name string, base, o yaml.MapSlice) yaml.MapSlice {
+	for _, item := range o {
+		current, ok := get(base, item.Key)
+		if !ok {
+			g.problemf("attribute %v of the overrides of %s is not in the schema", item.Key, name)
+			continue
+		}
+		base = set(base, item.Key, merge(toMapSlice(current), toMapSlice(item.Value)))
+	}
+	return base
+}
+
+// merge merges o over base, where o sets the keys of base and removes the keys set to null.
+func merge(base, o yaml.MapSlice) yaml.MapSlice {
+	base = append(yaml.MapSlice{}, base...)
+	for _, item := range o {
+		current, ok := get(base, item.Key)
+		switch {
+		case item.Value == nil:
+			base = remove(base, item.Key)
+		case ok && isMapSlice(current) && isMapSlice(item.Value):
+			base = set(base, item.Key, merge(toMapSlice(current), toMapSlice(item.Value)))
+		default:
+			base = set(base, item.Key, item.Value)
+		}
+	}
+	return base
+}
+
+func (g *generator) problemf(format string, args ...interface{}) {
+	g.problems = append(g.problems, fmt.Sprintf(format, args...))
+}
+
+// withId returns s with the computed id of the resources.
+func withId(s map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{"id": {Type: schema.TypeString, Computed: true}}
+	for k, v := range s {
+		result[k] = v
+	}
+	return result
+}
+
+// sortKeys sorts the keys with id first.
+func sortKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i] == "id" || keys[j] == "id" {
+			return keys[i] == "id" && keys[j] != "id"
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+func get(m yaml.MapSlice, key interface{}) (interface{}, bool) {
+	for _, item := range m {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+func set(m yaml.MapSlice, key, value interface{}) yaml.MapSlice {
+	for i, item := range m {
+		if item.Key == key {
+			m[i].Value = value
+			return m
+		}
+	}
+	return append(m, yaml.MapItem{Key: key, Value: value})
+}
+
+func remove(m yaml.MapSlice, key interface{}) yaml.MapSlice {
+	result := yaml.MapSlice{}
+	for _, item := range m {
+		if item.Key != key {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func isMapSlice(v interface{}) bool {
+	_, ok := v.(yaml.MapSlice)
+	return ok
+}
+
+func toMapSlice(v interface{}) yaml.MapSlice {
+	m, _ := v.(yaml.MapSlice)
+	return m
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSON writes v as JSON indented by four spaces, keeping the order of the keys of MapSlice.
+func writeJSON(buf *bytes.Buffer, v interface{}, indent string) error {
+	inner := indent + "    "
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		if len(v) == 0 {
+			buf.WriteString("{}")
+			return nil
+		}
+		buf.WriteString("{\n")
+		for i, item := range v {
+			buf.WriteString(inner)
+			if err := writeJSON(buf, fmt.Sprint(item.Key), inner); err != nil {
+				return err
+			}
+			buf.WriteString(": ")
+			if err := writeJSON(buf, item.Value, inner); err != nil {
+				return err
+			}
+			if i < len(v)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "}")
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString("[]")
+			return nil
+		}
+		buf.WriteString("[\n")
+		for i, item := range v {
+			buf.WriteString(inner)
+			if err := writeJSON(buf, item, inner); err != nil {
+				return err
+			}
+			if i < len(v)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "]")
+	default:
+		var b bytes.Buffer
+		encoder := json.NewEncoder(&b)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		buf.WriteString(strings.TrimSuffix(b.String(), "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var testProvider = &schema.Provider{
+	Schema: map[string]*schema.Schema{
+		"secret_key": {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"protocol":   {Type: schema.TypeString, Optional: true, ValidateFunc: tccommon.ValidateAllowedStringValue([]string{"HTTP", "HTTPS"}), Description: "The protocol. Valid values: `HTTP` and `HTTPS`. Default is `HTTPS`."},
+		"region":     {Type: schema.TypeString, Required: true},
+	},
+	ResourcesMap: map[string]*schema.Resource{
+		"tencentcloud_vpc": {
+			Schema: map[string]*schema.Schema{
+				"name":                {Type: schema.TypeString, Required: true},
+				"cidr_block":          {Type: schema.TypeString, Required: true},
+				"is_multicast":        {Type: schema.TypeBool, Optional: true},
+				"dns_servers":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"create_time":         {Type: schema.TypeString, Computed: true},
+				"docker_assistant_ip": {Type: schema.TypeString, Optional: true, Deprecated: "It has been deprecated."},
+			},
+		},
+	},
+	DataSourcesMap: map[string]*schema.Resource{
+		"tencentcloud_vpc_instances": {
+			Schema: map[string]*schema.Schema{
+				"name":   {Type: schema.TypeString, Optional: true, Description: "Name of the VPC."},
+				"vpc_id": {Type: schema.TypeString, Optional: true, Description: "ID of the VPC."},
+			},
+		},
+	},
+}
+
+const testOverrides = `
+provider:
+  exclude: [region]
+  metadata:
+    display_name: 腾讯云资源
+  attributes:
+    protocol:
+      display_name: 协议
+resources:
+  tencentcloud_vpc:
+    metadata:
+      display_name: 私有网络
+      attr_orders:
+        - name: name
+        - name: subnet
+    attributes:
+      name:
+        display_name: 名称
+        filter:
+          enable: true
+      vpc_id:
+        display_name: ID
+filters:
+  resources:
+    tencentcloud_vpc:
+      data_source: tencentcloud_vpc_instances
+      attributes:
+        name:
+          metadata:
+            display_name: 名称
+          optional: ~
+          required: true
+  options:
+    resources_optional: false
+`
+
+const expectedMetadata = metadataHeader + `provider_meta:
+  metadata:
+    display_name: 腾讯云资源
+    attr_orders:
+    - name: protocol
+    - name: secret_key
+  block:
+    attributes:
+      protocol:
+        display_name: 协议
+        widget: select
+        values:
+        - value: HTTP
+          display_name: HTTP
+          icon_url: ""
+        - value: HTTPS
+          display_name: HTTPS
+          icon_url: ""
+        is_public: true
+      secret_key:
+        display_name: secret_key
+        widget: text
+        is_encrypt: true
+        is_public: true
+resource_metas:
+  tencentcloud_vpc:
+    metadata:
+      display_name: 私有网络
+      attr_orders:
+      - name: name
+      - name: cidr_block
+      - name: dns_servers
+      - name: is_multicast
+    block:
+      attributes:
+        id:
+          display_name: id
+          widget: text
+          is_backfilled: true
+          is_not_edited: true
+          constraint_mode: READONLY
+        cidr_block:
+          display_name: cidr_block
+          widget: text
+        create_time:
+          display_name: create_time
+          widget: text
+          is_backfilled: true
+          is_not_edited: true
+          constraint_mode: READONLY
+        dns_servers:
+          display_name: dns_servers
+          widget: textarea
+        is_multicast:
+          display_name: is_multicast
+          widget: select
+          values:
+          - value: true
+            display_name: "true"
+            icon_url: ""
+          - value: false
+            display_name: "false"
+            icon_url: ""
+        name:
+          display_name: 名称
+          widget: text
+          filter:
+            enable: true
+`
+
+const expectedFilter = `{
+    "provider_filter_schemas": {
+        "tencentcloudstack/tencentcloud": {
+            "tencentcloudstack/tencentcloud": {
+                "provider_filter": {
+                    "block": {},
+                    "metadata": {
+                        "display_name": "腾讯云资源",
+                        "attr_orders": [
+                            {
+                                "name": "protocol"
+                            },
+                            {
+                                "name": "secret_key"
+                            }
+                        ]
+                    }
+                },
+                "resource_filters": {
+                    "tencentcloud_vpc": {
+                        "block": {
+                            "attributes": {
+                                "name": {
+                                    "type": "string",
+                                    "metadata": {
+                                        "display_name": "名称",
+                                        "description": "Name of the VPC.",
+                                        "widget": "text",
+                                        "val_source": {
+                                            "values": {}
+                                        }
+                                    },
+                                    "description": "Name of the VPC.",
+                                    "required": true
+                                }
+                            }
+                        },
+                        "metadata": {
+                            "display_name": "tencentcloud_vpc"
+                        }
+                    }
+                },
+                "resources_optional": false
+            }
+        }
+    }
+}
+`
+
+func testGenerator(t *testing.T) *generator {
+	filename := filepath.Join(t.TempDir(), overridesFile)
+	assert.NoError(t, os.WriteFile(filename, []byte(testOverrides), 0644))
+	o, err := loadOverrides(filename)
+	assert.NoError(t, err)
+	return &generator{provider: testProvider, overrides: o}
+}
+
+func TestGenerate(t *testing.T) {
+	g := testGenerator(t)
+	metadata, err := g.metadata()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadata, string(metadata))
+
+	filter, err := g.filter()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedFilter, string(filter))
+
+	assert.Equal(t, []string{
+		"attribute vpc_id of the overrides of tencentcloud_vpc is not in the schema",
+		"attribute subnet in attr_orders of tencentcloud_vpc is not an argument",
+	}, g.problems)
+}
+
+func TestCompare(t *testing.T) {
+	g := testGenerator(t)
+	metadata, err := g.metadata()
+	assert.NoError(t, err)
+
+	problems, err := compare(metadataFile, metadata, metadata)
+	assert.NoError(t, err)
+	assert.Empty(t, problems)
+
+	current := strings.Replace(string(metadata), "        dns_servers:\n", "        subnet_ids:\n", 1)
+	problems, err = compare(metadataFile, []byte(current), metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"metadata.yaml: attribute tencentcloud_vpc.dns_servers is missing",
+		"metadata.yaml: attribute tencentcloud_vpc.subnet_ids is stale",
+	}, problems)
+
+	current = strings.Replace(string(metadata), "display_name: 名称", "display_name: name", 1)
+	problems, err = compare(metadataFile, []byte(current), metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"metadata.yaml: metadata of the attributes is out of date"}, problems)
+
+	_, err = compare(metadataFile, []byte("{"), metadata)
+	assert.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	var base, o yaml.MapSlice
+	assert.NoError(t, yaml.Unmarshal([]byte("a: 1\nb:\n  c: 2\n  d: 3\ne: 4\n"), &base))
+	assert.NoError(t, yaml.Unmarshal([]byte("b:\n  d: 5\n  f: 6\ne: ~\ng: [7]\n"), &o))
+	merged, err := yaml.Marshal(merge(base, o))
+	assert.NoError(t, err)
+	assert.Equal(t, "a: 1\nb:\n  c: 2\n  d: 5\n  f: 6\ng:\n- 7\n", string(merged))
+
+	// base is not changed.
+	unchanged, _ := yaml.Marshal(base)
+	assert.Equal(t, "a: 1\nb:\n  c: 2\n  d: 3\ne: 4\n", string(unchanged))
+}
+
+func TestValidValues(t *testing.T) {
+	validate := tccommon.ValidateAllowedStringValue([]string{"A", "B", "C"})
+	cases := []struct {
+		schema   *schema.Schema
+		expected []string
+	}{
+		{&schema.Schema{ValidateFunc: validate, Description: "Valid values: `A`, `B` or `C`. Default is `A`."}, []string{"A", "B", "C"}},
+		{&schema.Schema{ValidateFunc: validate, Description: "The type. Valid values: `A` and `B`."}, []string{"A", "B"}},
+		// the description is out of date with the validation.
+		{&schema.Schema{ValidateFunc: validate, Description: "Valid values: `A` and `D`."}, nil},
+		{&schema.Schema{Description: "Valid values: `A` and `B`."}, nil},
+		{&schema.Schema{ValidateFunc: validate, Description: "The type, `A` by default."}, nil},
+	}
+	for _, c := range cases {
+		values, ok := validValues(c.schema)
+		assert.Equal(t, c.expected != nil, ok, c.schema.Description)
+		assert.Equal(t, c.expected, values, c.schema.Description)
+	}
+}
+
+// TestMetadata checks that the metadata of the repository is generated from the current schemas.
+func TestMetadata(t *testing.T) {
+	dir := filepath.Join("..", "schema")
+	o, err := loadOverrides(filepath.Join(dir, overridesFile))
+	assert.NoError(t, err)
+	g := &generator{provider: tencentcloud.Provider(), overrides: o}
+
+	metadata, err := g.metadata()
+	assert.NoError(t, err)
+	filter, err := g.filter()
+	assert.NoError(t, err)
+	assert.Empty(t, g.problems)
+
+	for name, content := range map[string][]byte{metadataFile: metadata, filterFile: filter} {
+		current, err := os.ReadFile(filepath.Join(dir, name))
+		assert.NoError(t, err)
+		problems, err := compare(name, current, content)
+		assert.NoError(t, err)
+		assert.Empty(t, problems, "run `go run ./genmeta` to update the metadata")
+	}
+}
@@ -0,0 +1,175 @@
+// Command sweeper deletes the cloud resources leaked by the acceptance tests, or left behind by hand.
+//
+// It sweeps the resources of the registered types in each region, in the order of their
+// dependencies, such as the CLBs before the subnets before the VPCs. The resources are selected by
+// tags, name and age, and the ones named with the prefixes `keep` and `Default` are always kept.
+// A run which deletes resources requires -name-regex or -tag, so that it never sweeps every resource
+// of an account. With -dry-run, nothing is deleted and the resources to delete are only reported,
+// with or without them. Credentials are
+// taken from the same environment variables as the provider, such as TENCENTCLOUD_SECRET_ID.
+//
+//	go run ./sweeper -region ap-guangzhou -type tencentcloud_vpc -name-regex '^tf-' -older-than 24h -dry-run -report sweep.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/sweep"
+)
+
+// tagFilters collects the repeated `-tag key=value` flags.
+type tagFilters map[string]string
+
+func (t tagFilters) String() string {
+	pairs := make([]string, 0, len(t))
+	for k, v := range t {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (t tagFilters) Set(value string) error {
+	kv := strings.SplitN(value, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("tag filter %q must be in the format of key=value", value)
+	}
+	t[kv[0]] = kv[1]
+	return nil
+}
+
+func main() {
+	var (
+		regions     = flag.String("region", os.Getenv("TENCENTCLOUD_REGION"), "comma separated regions to sweep, such as ap-guangzhou,ap-shanghai")
+		types       = flag.String("type", "", "comma separated resource types to sweep along with their dependencies, all registered types by default")
+		nameRegex   = flag.String("name-regex", "", "only delete the resources whose names match the regular expression")
+		olderThan   = flag.Duration("older-than", 30*time.Minute, "only delete the resources created longer ago than it, such as 24h")
+		dryRun      = flag.Bool("dry-run", false, "report the resources to delete without deleting them")
+		report      = flag.String("report", "", "file to write the report to, as CSV when it ends with .csv and as JSON otherwise")
+		concurrency = flag.Int("concurrency", 4, "number of resources of a type deleted at the same time")
+		rate        = flag.Float64("rate", 5, "maximal number of deletions per second, unlimited when not positive")
+		creators    = flag.Bool("creators", true, "report the creators of the resources found in the cloud audit logs")
+		list        = flag.Bool("list", false, "list the registered resource types and exit")
+		tags        = tagFilters{}
+	)
+	flag.Var(tags, "tag", "only delete the resources with the tag, in the format of key=value, can be repeated")
+	flag.Parse()
+
+	if *list {
+		for _, t := range sweep.Types() {
+			fmt.Println(t)
+		}
+		return
+	}
+	if *regions == "" {
+		log.Fatal("-region is required")
+	}
+
+	opts := sweep.Options{
+		Filter:      sweep.Filter{Tags: tags, OlderThan: *olderThan},
+		DryRun:      *dryRun,
+		Concurrency: *concurrency,
+		Rate:        *rate,
+		Creators:    *creators,
+	}
+	if *nameRegex != "" {
+		regex, err := regexp.Compile(*nameRegex)
+		if err != nil {
+			log.Fatalf("invalid -name-regex: %v", err)
+		}
+		opts.Filter.NameRegex = regex
+	}
+	if err := checkFilter(opts); err != nil {
+		log.Fatal(err)
+	}
+
+	var selected []string
+	for _, t := range strings.Split(*types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			selected = append(selected, t)
+		}
+	}
+	sweepers, err := sweep.Resolve(selected...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result := &sweep.Report{}
+	var failed error
+	for _, region := range strings.Split(*regions, ",") {
+		if region = strings.TrimSpace(region); region == "" {
+			continue
+		}
+		opts.Region = region
+		r, err := run(region, sweepers, opts)
+		result.Merge(r)
+		if err != nil {
+			failed = fmt.Errorf("sweep region %s failed: %v", region, err)
+			break
+		}
+	}
+
+	count := result.Count()
+	log.Printf("[INFO] %d deleted, %d failed, %d to delete in the dry run, %d kept",
+		count[sweep.StatusDeleted], count[sweep.StatusFailed], count[sweep.StatusDryRun], count[sweep.StatusKept])
+	if *report != "" {
+		if err := writeReport(*report, result); err != nil {
+			log.Fatalf("write report failed: %v", err)
+		}
+	}
+	if failed != nil {
+		log.Fatal(failed)
+	}
+	if count[sweep.StatusFailed] > 0 {
+		os.Exit(1)
+	}
+}
+
+// checkFilter refuses to delete resources selected by their age only, which would sweep every
+// resource of the account.
+func checkFilter(opts sweep.Options) error {
+	if opts.DryRun || opts.Filter.NameRegex != nil || len(opts.Filter.Tags) > 0 {
+		return nil
+	}
+	return fmt.Errorf("-name-regex or -tag is required to delete resources, or report them with -dry-run")
+}
+
+func run(region string, sweepers []*sweep.Sweeper, opts sweep.Options) (*sweep.Report, error) {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	provider := tencentcloud.Provider()
+	diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{"region": region}))
+	if diags.HasError() {
+		return nil, fmt.Errorf("configure provider failed: %v", diags[0].Summary)
+	}
+	client := provider.Meta().(tccommon.ProviderMeta).GetAPIV3Conn()
+
+	return sweep.Run(ctx, client, sweepers, opts)
+}
+
+func writeReport(path string, report *sweep.Report) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return report.WriteCSV(file)
+	}
+	return report.WriteJSON(file)
+}
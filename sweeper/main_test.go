@@ -0,0 +1,43 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/sweep"
+)
+
+func TestTagFilters(t *testing.T) {
+	tags := tagFilters{}
+	assert.NoError(t, tags.Set("env=test"))
+	assert.NoError(t, tags.Set("team=a=b"))
+	assert.Error(t, tags.Set("env"))
+	assert.Equal(t, "env=test,team=a=b", tags.String())
+}
+
+func TestCheckFilter(t *testing.T) {
+	assert.Error(t, checkFilter(sweep.Options{}))
+	assert.NoError(t, checkFilter(sweep.Options{DryRun: true}))
+	assert.NoError(t, checkFilter(sweep.Options{Filter: sweep.Filter{NameRegex: regexp.MustCompile("^tf-")}}))
+	assert.NoError(t, checkFilter(sweep.Options{Filter: sweep.Filter{Tags: map[string]string{"env": "test"}}}))
+}
+
+func TestSweepers(t *testing.T) {
+	sweepers, err := sweep.Resolve()
+	assert.NoError(t, err)
+
+	resources := tencentcloud.Provider().ResourcesMap
+	position := make(map[string]int)
+	for i, s := range sweepers {
+		position[s.Type] = i
+		if _, ok := resources[s.Type]; !ok {
+			t.Errorf("sweeper %s is not a resource type", s.Type)
+		}
+	}
+	assert.Less(t, position["tencentcloud_clb_instance"], position["tencentcloud_subnet"])
+	assert.Less(t, position["tencentcloud_subnet"], position["tencentcloud_vpc"])
+	assert.Less(t, position["tencentcloud_instance"], position["tencentcloud_security_group"])
+}
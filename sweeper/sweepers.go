@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	svcclb "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/clb"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/sweep"
+)
+
+// The sweepers of the resources which most often leak from the acceptance tests. A VPC cannot be
+// deleted with subnets or security groups in it, and a subnet with CLBs or instances in it.
+func init() {
+	sweep.Register(&sweep.Sweeper{
+		Type:         "tencentcloud_instance",
+		CreateAction: "RunInstances",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*sweep.Resource, error) {
+			service := svccvm.NewCvmService(client)
+			instances, err := service.DescribeInstanceByFilter(ctx, nil, map[string]string{})
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*sweep.Resource, 0, len(instances))
+			for _, instance := range instances {
+				result = append(result, &sweep.Resource{
+					Id:         str(instance.InstanceId),
+					Name:       str(instance.InstanceName),
+					CreateTime: parseTime(instance.CreatedTime),
+					Tags:       cvmTags(instance.Tags),
+				})
+			}
+			return result, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *sweep.Resource) error {
+			service := svccvm.NewCvmService(client)
+			return service.DeleteInstance(ctx, r.Id, false)
+		},
+	})
+
+	sweep.Register(&sweep.Sweeper{
+		Type:         "tencentcloud_clb_instance",
+		CreateAction: "CreateLoadBalancer",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*sweep.Resource, error) {
+			service := svcclb.NewClbService(client)
+			clbs, err := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*sweep.Resource, 0, len(clbs))
+			for _, lb := range clbs {
+				result = append(result, &sweep.Resource{
+					Id:         str(lb.LoadBalancerId),
+					Name:       str(lb.LoadBalancerName),
+					CreateTime: parseTime(lb.CreateTime),
+					Tags:       clbTags(lb.Tags),
+				})
+			}
+			return result, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *sweep.Resource) error {
+			service := svcclb.NewClbService(client)
+			return service.DeleteLoadBalancerById(ctx, r.Id)
+		},
+	})
+
+	sweep.Register(&sweep.Sweeper{
+		Type:         "tencentcloud_security_group",
+		Dependencies: []string{"tencentcloud_instance", "tencentcloud_clb_instance"},
+		CreateAction: "CreateSecurityGroup",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*sweep.Resource, error) {
+			service := svcvpc.NewVpcService(client)
+			sgs, err := service.DescribeSecurityGroups(ctx, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*sweep.Resource, 0, len(sgs))
+			for _, sg := range sgs {
+				// the default security group of a project cannot be deleted.
+				if sg.IsDefault != nil && *sg.IsDefault {
+					continue
+				}
+				result = append(result, &sweep.Resource{
+					Id:         str(sg.SecurityGroupId),
+					Name:       str(sg.SecurityGroupName),
+					CreateTime: parseTime(sg.CreatedTime),
+					Tags:       vpcTags(sg.TagSet),
+				})
+			}
+			return result, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *sweep.Resource) error {
+			service := svcvpc.NewVpcService(client)
+			return service.DeleteSecurityGroup(ctx, r.Id)
+		},
+	})
+
+	sweep.Register(&sweep.Sweeper{
+		Type:         "tencentcloud_subnet",
+		Dependencies: []string{"tencentcloud_instance", "tencentcloud_clb_instance"},
+		CreateAction: "CreateSubnet",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*sweep.Resource, error) {
+			service := svcvpc.NewVpcService(client)
+			subnets, err := service.DescribeSubnets(ctx, "", "", "", "", nil, nil, nil, "", "", "")
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*sweep.Resource, 0, len(subnets))
+			for _, subnet := range subnets {
+				createTime := subnet.CreateTime()
+				result = append(result, &sweep.Resource{
+					Id:         subnet.SubnetId(),
+					Name:       subnet.Name(),
+					CreateTime: parseTime(&createTime),
+				})
+			}
+			return result, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *sweep.Resource) error {
+			service := svcvpc.NewVpcService(client)
+			return service.DeleteSubnet(ctx, r.Id)
+		},
+	})
+
+	sweep.Register(&sweep.Sweeper{
+		Type:         "tencentcloud_vpc",
+		Dependencies: []string{"tencentcloud_subnet", "tencentcloud_security_group"},
+		CreateAction: "CreateVpc",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*sweep.Resource, error) {
+			service := svcvpc.NewVpcService(client)
+			vpcs, err := service.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+			if err != nil {
+				return nil, err
+			}
+			result := make([]*sweep.Resource, 0, len(vpcs))
+			for _, info := range vpcs {
+				createTime := info.CreateTime()
+				result = append(result, &sweep.Resource{
+					Id:         info.VpcId(),
+					Name:       info.Name(),
+					CreateTime: parseTime(&createTime),
+					Tags:       vpcTags(info.Tags()),
+				})
+			}
+			return result, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *sweep.Resource) error {
+			service := svcvpc.NewVpcService(client)
+			return service.DeleteVpc(ctx, r.Id)
+		},
+	})
+}
+
+func str(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// parseTime returns the zero time when p cannot be parsed, the age of the resource is unknown then.
+func parseTime(p *string) time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	parsed, err := tccommon.ParsedTime(*p)
+	if err != nil || parsed == nil {
+		return time.Time{}
+	}
+	return *parsed
+}
+
+func cvmTags(tags []*cvm.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[str(tag.Key)] = str(tag.Value)
+	}
+	return result
+}
+
+func clbTags(tags []*clb.TagInfo) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[str(tag.TagKey)] = str(tag.TagValue)
+	}
+	return result
+}
+
+func vpcTags(tags []*vpc.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[str(tag.Key)] = str(tag.Value)
+	}
+	return result
+}
@@ -15,6 +15,7 @@ import (
 	tcprovider "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
 	providercommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 )
 
 var AccProviders map[string]*schema.Provider
@@ -194,6 +195,30 @@ func AccCheckTencentCloudDataSourceID(n string) resource.TestCheckFunc {
 	}
 }
 
+// AccImportStateIdFunc returns the import id of the resource n, the composite id made of its
+// attributes of keys, where the key `id` is the id of the resource.
+func AccImportStateIdFunc(n string, keys ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Can't find resource: %s", n)
+		}
+
+		parts := make([]string, 0, len(keys))
+		for _, key := range keys {
+			value := rs.Primary.Attributes[key]
+			if key == "id" {
+				value = rs.Primary.ID
+			}
+			if value == "" {
+				return "", fmt.Errorf("attribute %s of resource %s is not set", key, n)
+			}
+			parts = append(parts, value)
+		}
+		return compositeid.Join(parts...), nil
+	}
+}
+
 func AccPreCheckBusiness(t *testing.T, accountType string) {
 
 	switch accountType {
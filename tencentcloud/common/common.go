@@ -10,8 +10,6 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"os/user"
-	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -339,39 +337,15 @@ func IsString(data interface{}) bool {
 	return false
 }
 
-// WriteToFile write data to file
+// WriteToFile write data to file atomically, strings as is and others in JSON
 func WriteToFile(filePath string, data interface{}) error {
-	if strings.HasPrefix(filePath, "~") {
-		usr, err := user.Current()
-		if err != nil {
-			return fmt.Errorf("Get current user fail,reason %s", err.Error())
-		}
-		if usr.HomeDir != "" {
-			filePath = strings.Replace(filePath, "~", usr.HomeDir, 1)
-		}
-	}
-
-	fileInfo, err := os.Stat(filePath)
-	if err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("stat old file error,reason %s", err.Error())
-	}
-
-	if !os.IsNotExist(err) {
-		if fileInfo.IsDir() {
-			return fmt.Errorf("old filepath is a dir,can not delete")
-		}
-		if err := os.Remove(filePath); err != nil {
-			return fmt.Errorf("delete old file error,reason %s", err.Error())
-		}
-	}
-
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("create directory error, reason: %s", err.Error())
+	filePath, err := ExpandFilePath(filePath)
+	if err != nil {
+		return err
 	}
 
 	if IsString(data) {
-		return ioutil.WriteFile(filePath, []byte(data.(string)), 0422)
+		return WriteFileContent(filePath, []byte(data.(string)), DefaultResultOutputFileMode)
 	}
 
 	jsonStr, err := json.MarshalIndent(data, "", "\t")
@@ -379,7 +353,7 @@ func WriteToFile(filePath string, data interface{}) error {
 		return fmt.Errorf("json decode error,reason %s", err.Error())
 	}
 
-	return ioutil.WriteFile(filePath, jsonStr, 0422)
+	return WriteFileContent(filePath, jsonStr, DefaultResultOutputFileMode)
 }
 
 // ReadFromFile return file content
@@ -2,10 +2,13 @@ package common
 
 import (
 	"encoding/csv"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/mitchellh/go-homedir"
 )
 
 const (
@@ -78,13 +81,81 @@ func GenerateCsvFile(filePath string, header []string) error {
 	}
 	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	err = writer.Write(header)
+	err = WriteCsv(csv.NewWriter(file), header, nil)
 	if err != nil {
 		log.Printf("[CRITAL] write header to csv file error: %v", err.Error())
 		return err
 	}
-	writer.Flush()
 
 	return nil
 }
+
+// WriteCsv writes header and data to writer and flushes it
+func WriteCsv(writer *csv.Writer, header []string, data [][]string) error {
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(data); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// ExpandFilePath expands the leading ~ of filePath to the home directory
+func ExpandFilePath(filePath string) (string, error) {
+	expanded, err := homedir.Expand(filePath)
+	if err != nil {
+		return "", fmt.Errorf("Get current user fail,reason %s", err.Error())
+	}
+	return expanded, nil
+}
+
+// WriteFileContent writes content to filePath atomically with perm, creating the directory if needed
+func WriteFileContent(filePath string, content []byte, perm os.FileMode) error {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("stat old file error,reason %s", err.Error())
+	}
+	if err == nil && fileInfo.IsDir() {
+		return fmt.Errorf("old filepath is a dir,can not delete")
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory error, reason: %s", err.Error())
+	}
+
+	return WriteFileAtomic(filePath, content, perm)
+}
+
+// WriteFileAtomic writes content to a temporary file in the directory of filePath and renames it to
+// filePath, so that readers never see a partially written file
+func WriteFileAtomic(filePath string, content []byte, perm os.FileMode) (errRet error) {
+	file, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return fmt.Errorf("create temporary file error, reason: %s", err.Error())
+	}
+	defer func() {
+		if errRet != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if _, err := file.Write(content); err != nil {
+		return fmt.Errorf("write file error, reason: %s", err.Error())
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("sync file error, reason: %s", err.Error())
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close file error, reason: %s", err.Error())
+	}
+	if err := os.Chmod(file.Name(), perm); err != nil {
+		return fmt.Errorf("chmod file error, reason: %s", err.Error())
+	}
+	if err := os.Rename(file.Name(), filePath); err != nil {
+		return fmt.Errorf("rename file error, reason: %s", err.Error())
+	}
+	return nil
+}
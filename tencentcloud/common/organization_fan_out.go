@@ -0,0 +1,365 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkcommon "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	organization "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/organization/v20210331"
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+const (
+	// DefaultOrganizationFanOutRoleName is the role assumed in the member accounts unless role_name
+	// of organization_fan_out is set, the role of the organization to access its members.
+	DefaultOrganizationFanOutRoleName = "OrganizationAccessControlRole"
+	// DefaultOrganizationFanOutConcurrency is the max number of the accounts read at the same time
+	// unless max_concurrency of organization_fan_out is set.
+	DefaultOrganizationFanOutConcurrency = 5
+
+	organizationFanOutSessionName = "terraform-organization-fan-out"
+)
+
+// FanOutTarget is a member account of the organization and a region to read a data source in,
+// empty for the global data sources, which are read in the region of the provider.
+type FanOutTarget struct {
+	Uin    int
+	Region string
+}
+
+// String returns the account and the region of t, as reported in the warnings.
+func (t FanOutTarget) String() string {
+	if t.Region == "" {
+		return fmt.Sprintf("account %d", t.Uin)
+	}
+	return fmt.Sprintf("account %d in %s", t.Uin, t.Region)
+}
+
+// FanOutAssumeFunc returns the meta of the provider accessing the account and the region of target.
+type FanOutAssumeFunc func(ctx context.Context, target FanOutTarget) (interface{}, error)
+
+// WithOrganizationFanOut adds the organization_fan_out block to data source r, and wraps its read
+// to read the elements of its computed list in each of the member accounts of the organization
+// through the role assumed in them, tagging them with account_uin. The regional data sources are
+// read in each of regions of the block as well, tagging the elements with region, the global ones
+// in the region of the provider only, leaving region of their elements to the data source, such as:
+//
+//	return tccommon.WithOrganizationFanOut("instance_list", true, &schema.Resource{...})
+//
+// The failure to read an account is reported as a warning, and its elements are left out.
+func WithOrganizationFanOut(list string, regional bool, r *schema.Resource) *schema.Resource {
+	fanOut := map[string]*schema.Schema{
+		"member_uins": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeInt},
+			ExactlyOneOf: []string{"organization_fan_out.0.member_uins", "organization_fan_out.0.node_id"},
+			Description:  "UINs of the member accounts to read.",
+		},
+		"node_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "ID of the organization node whose member accounts to read.",
+		},
+		"role_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     DefaultOrganizationFanOutRoleName,
+			Description: fmt.Sprintf("Name of the role assumed through STS in each of the member accounts. Default is `%s`.", DefaultOrganizationFanOutRoleName),
+		},
+		"max_concurrency": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      DefaultOrganizationFanOutConcurrency,
+			ValidateFunc: ValidateIntegerInRange(1, 20),
+			Description:  fmt.Sprintf("Max number of the accounts read at the same time, range from 1 to 20. Default is `%d`.", DefaultOrganizationFanOutConcurrency),
+		},
+	}
+	if regional {
+		fanOut["regions"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Regions to read in each of the member accounts. Default is the region of the provider.",
+		}
+	}
+	r.Schema["organization_fan_out"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem:        &schema.Resource{Schema: fanOut},
+		Description: fmt.Sprintf("Reads `%s` in the member accounts of the organization instead of the account of the provider, through the role assumed in each of them. The accounts failed to read are reported as warnings.", list),
+	}
+
+	elem := r.Schema[list].Elem.(*schema.Resource)
+	elem.Schema["account_uin"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "UIN of the member account of the element, set if `organization_fan_out` is set.",
+	}
+	if regional {
+		elem.Schema["region"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Region the element is read in, set if `organization_fan_out` is set.",
+		}
+	}
+
+	read := r.Read
+	r.Read = nil
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		v, ok := d.GetOk("organization_fan_out")
+		if !ok {
+			return diag.FromErr(read(d, meta))
+		}
+		m, _ := v.([]interface{})[0].(map[string]interface{})
+		client := meta.(ProviderMeta).GetAPIV3Conn()
+
+		var uins []int
+		for _, uin := range m["member_uins"].([]interface{}) {
+			uins = append(uins, uin.(int))
+		}
+		if nodeId := m["node_id"].(int); nodeId != 0 {
+			var err error
+			if uins, err = organizationNodeMemberUins(ctx, client.UseOrganizationClient(), nodeId); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		regions := []string{""}
+		if regional {
+			regions = []string{client.Region}
+		}
+		if rs, ok := m["regions"].([]interface{}); ok && len(rs) > 0 {
+			regions = regions[:0]
+			for _, region := range rs {
+				regions = append(regions, region.(string))
+			}
+		}
+		targets := make([]FanOutTarget, 0, len(uins)*len(regions))
+		for _, uin := range uins {
+			for _, region := range regions {
+				targets = append(targets, FanOutTarget{Uin: uin, Region: region})
+			}
+		}
+
+		stsClient := client.UseStsClient()
+		roleName := m["role_name"].(string)
+		assume := func(ctx context.Context, target FanOutTarget) (interface{}, error) {
+			return assumeMemberRole(stsClient, client, roleName, target)
+		}
+		return ReadOrganizationFanOut(ctx, r, read, list, d, targets, m["max_concurrency"].(int), assume)
+	}
+	return r
+}
+
+// ReadOrganizationFanOut reads list of data source r by read in each of targets with the meta
+// returned by assume, at most maxConcurrency of them at the same time, and sets list of d to the
+// elements of all of them, in the order of targets.
+func ReadOrganizationFanOut(ctx context.Context, r *schema.Resource, read schema.ReadFunc, list string, d *schema.ResourceData, targets []FanOutTarget, maxConcurrency int, assume FanOutAssumeFunc) diag.Diagnostics {
+	// the arguments of the data source, except those of the fan-out and the output.
+	args := make(map[string]interface{})
+	for k, s := range r.Schema {
+		if k == list || k == "organization_fan_out" || k == "result_output_file" || (!s.Optional && !s.Required) {
+			continue
+		}
+		if v, ok := d.GetOkExists(k); ok {
+			args[k] = v
+		}
+	}
+
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, maxConcurrency)
+		results = make([][]interface{}, len(targets))
+		ids     = make([]string, len(targets))
+		errs    = make([]error, len(targets))
+	)
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target FanOutTarget) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i], ids[i], errs[i] = readFanOutTarget(ctx, r, read, list, args, target, assume)
+		}(i, target)
+	}
+	wg.Wait()
+
+	var (
+		diags   diag.Diagnostics
+		items   = make([]interface{}, 0)
+		readIds []string
+	)
+	for i, target := range targets {
+		if errs[i] != nil {
+			log.Printf("[WARN]%s read %s of %s failed, reason:%s\n", GetLogId(ctx), list, target, errs[i].Error())
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to read %s of %s", list, target),
+				Detail:   errs[i].Error(),
+			})
+			continue
+		}
+		items = append(items, results[i]...)
+		readIds = append(readIds, strconv.Itoa(target.Uin), target.Region, ids[i])
+	}
+
+	d.SetId(helper.DataResourceIdsHash(readIds))
+	if err := d.Set(list, items); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if format, ok := d.GetOk("result_output_format"); !ok || format.(string) == "" {
+			if err := WriteToFile(output.(string), items); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+	return diags
+}
+
+func readFanOutTarget(ctx context.Context, r *schema.Resource, read schema.ReadFunc, list string, args map[string]interface{}, target FanOutTarget, assume FanOutAssumeFunc) ([]interface{}, string, error) {
+	meta, err := assume(ctx, target)
+	if err != nil {
+		return nil, "", err
+	}
+
+	d := r.Data(nil)
+	for k, v := range args {
+		if err := d.Set(k, v); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := read(d, meta); err != nil {
+		return nil, "", err
+	}
+
+	items, _ := d.Get(list).([]interface{})
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			m["account_uin"] = target.Uin
+			if target.Region != "" {
+				m["region"] = target.Region
+			}
+		}
+	}
+	return items, d.Id(), nil
+}
+
+// fanOutMeta is the meta of the provider accessing a member account.
+type fanOutMeta struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (m *fanOutMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return m.client
+}
+
+// assumeMemberRole assumes roleName in the account of target, and returns the meta of the provider
+// accessing the account in the region of target, or of the provider if empty, with the credential
+// of the role.
+func assumeMemberRole(stsClient *sts.Client, client *connectivity.TencentCloudClient, roleName string, target FanOutTarget) (interface{}, error) {
+	request := sts.NewAssumeRoleRequest()
+	request.RoleArn = helper.String(fmt.Sprintf("qcs::cam::uin/%d:roleName/%s", target.Uin, roleName))
+	request.RoleSessionName = helper.String(organizationFanOutSessionName)
+
+	var response *sts.AssumeRoleResponse
+	err := resource.Retry(ReadRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := stsClient.AssumeRole(request)
+		if e != nil {
+			return RetryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assume role %s failed, reason:%s", *request.RoleArn, err.Error())
+	}
+
+	if response == nil || response.Response == nil || response.Response.Credentials == nil {
+		return nil, fmt.Errorf("assume role %s failed, Credentials is nil", *request.RoleArn)
+	}
+	credentials := response.Response.Credentials
+	if credentials.TmpSecretId == nil || credentials.TmpSecretKey == nil || credentials.Token == nil {
+		return nil, fmt.Errorf("assume role %s failed, Credentials is nil", *request.RoleArn)
+	}
+
+	region := target.Region
+	if region == "" {
+		region = client.Region
+	}
+	return &fanOutMeta{client: &connectivity.TencentCloudClient{
+		Credential: sdkcommon.NewTokenCredential(*credentials.TmpSecretId, *credentials.TmpSecretKey, *credentials.Token),
+		Region:     region,
+		Protocol:   client.Protocol,
+		Domain:     client.Domain,
+		CosDomain:  client.CosDomain,
+	}}, nil
+}
+
+// organizationNodeMemberUins returns the UINs of the member accounts of the organization node.
+func organizationNodeMemberUins(ctx context.Context, client *organization.Client, nodeId int) (uins []int, errRet error) {
+	logId := GetLogId(ctx)
+	request := organization.NewDescribeOrganizationMembersRequest()
+	request.NodeId = helper.IntUint64(nodeId)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		var response *organization.DescribeOrganizationMembersResponse
+		err := resource.Retry(ReadRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			result, e := client.DescribeOrganizationMembers(request)
+			if e != nil {
+				return RetryError(e)
+			}
+			response = result
+			return nil
+		})
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || response.Response == nil || len(response.Response.Items) < 1 {
+			break
+		}
+		for _, member := range response.Response.Items {
+			if member.MemberUin != nil {
+				uins = append(uins, int(*member.MemberUin))
+			}
+		}
+		if len(response.Response.Items) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
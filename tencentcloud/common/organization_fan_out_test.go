@@ -0,0 +1,187 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+func testFanOutRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(ProviderMeta).GetAPIV3Conn()
+	if client.Region == "ap-failed" {
+		return errors.New("UnauthorizedOperation")
+	}
+	d.SetId(client.Region)
+	return d.Set("instance_list", []interface{}{
+		map[string]interface{}{"instance_id": "ins-" + client.Region, "instance_name": d.Get("instance_name").(string)},
+	})
+}
+
+func testFanOutResource() *schema.Resource {
+	return WithOrganizationFanOut("instance_list", true, &schema.Resource{
+		Read: testFanOutRead,
+		Schema: map[string]*schema.Schema{
+			"instance_name":      {Type: schema.TypeString, Optional: true},
+			"result_output_file": {Type: schema.TypeString, Optional: true},
+			"instance_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id":   {Type: schema.TypeString, Computed: true},
+						"instance_name": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	})
+}
+
+func testFanOutGlobalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(ProviderMeta).GetAPIV3Conn()
+	d.SetId(client.Region)
+	return d.Set("user_list", []interface{}{
+		map[string]interface{}{"name": "admin"},
+	})
+}
+
+func testFanOutGlobalResource() *schema.Resource {
+	return WithOrganizationFanOut("user_list", false, &schema.Resource{
+		Read: testFanOutGlobalRead,
+		Schema: map[string]*schema.Schema{
+			"user_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestWithOrganizationFanOut(t *testing.T) {
+	r := testFanOutResource()
+	assert.NoError(t, r.InternalValidate(nil, false))
+	assert.Nil(t, r.Read)
+	assert.Contains(t, r.Schema["organization_fan_out"].Elem.(*schema.Resource).Schema, "regions")
+	assert.Contains(t, r.Schema["instance_list"].Elem.(*schema.Resource).Schema, "account_uin")
+	assert.Contains(t, r.Schema["instance_list"].Elem.(*schema.Resource).Schema, "region")
+
+	// the global data sources are not read in regions, nor are their elements tagged with them.
+	global := testFanOutGlobalResource()
+	assert.NoError(t, global.InternalValidate(nil, false))
+	assert.NotContains(t, global.Schema["organization_fan_out"].Elem.(*schema.Resource).Schema, "regions")
+	assert.Contains(t, global.Schema["user_list"].Elem.(*schema.Resource).Schema, "account_uin")
+	assert.NotContains(t, global.Schema["user_list"].Elem.(*schema.Resource).Schema, "region")
+
+	// without organization_fan_out, the data source reads the account of the provider.
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("instance_name", "web"))
+	meta := &fanOutMeta{client: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"instance_id": "ins-ap-guangzhou", "instance_name": "web", "account_uin": 0, "region": ""},
+	}, d.Get("instance_list"))
+}
+
+func TestReadOrganizationFanOut(t *testing.T) {
+	r := testFanOutResource()
+	filePath := filepath.Join(t.TempDir(), "instances.json")
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("instance_name", "web"))
+	assert.NoError(t, d.Set("result_output_file", filePath))
+
+	var (
+		mu       sync.Mutex
+		assumed  []FanOutTarget
+		running  int
+		parallel int
+	)
+	assume := func(ctx context.Context, target FanOutTarget) (interface{}, error) {
+		mu.Lock()
+		assumed = append(assumed, target)
+		running++
+		if running > parallel {
+			parallel = running
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		if target.Uin == 300 {
+			return nil, errors.New("AuthFailure.RoleNotExist")
+		}
+		return &fanOutMeta{client: &connectivity.TencentCloudClient{Region: target.Region}}, nil
+	}
+
+	targets := []FanOutTarget{
+		{Uin: 100, Region: "ap-guangzhou"},
+		{Uin: 100, Region: "ap-shanghai"},
+		{Uin: 200, Region: "ap-guangzhou"},
+		{Uin: 200, Region: "ap-failed"},
+		{Uin: 300, Region: "ap-guangzhou"},
+	}
+	diags := ReadOrganizationFanOut(context.Background(), r, testFanOutRead, "instance_list", d, targets, 2, assume)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 2)
+	for _, diagnostic := range diags {
+		assert.Equal(t, diag.Warning, diagnostic.Severity)
+	}
+	assert.Equal(t, "Failed to read instance_list of account 200 in ap-failed", diags[0].Summary)
+	assert.Equal(t, "UnauthorizedOperation", diags[0].Detail)
+	assert.Equal(t, "AuthFailure.RoleNotExist", diags[1].Detail)
+	assert.Len(t, assumed, len(targets))
+	assert.LessOrEqual(t, parallel, 2)
+
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"instance_id": "ins-ap-guangzhou", "instance_name": "web", "account_uin": 100, "region": "ap-guangzhou"},
+		map[string]interface{}{"instance_id": "ins-ap-shanghai", "instance_name": "web", "account_uin": 100, "region": "ap-shanghai"},
+		map[string]interface{}{"instance_id": "ins-ap-guangzhou", "instance_name": "web", "account_uin": 200, "region": "ap-guangzhou"},
+	}, d.Get("instance_list"))
+
+	// the output file has the elements of all the accounts.
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "ins-ap-shanghai")
+}
+
+func TestReadOrganizationFanOutGlobal(t *testing.T) {
+	r := testFanOutGlobalResource()
+	d := r.TestResourceData()
+
+	var regions []string
+	assume := func(ctx context.Context, target FanOutTarget) (interface{}, error) {
+		regions = append(regions, target.Region)
+		if target.Uin == 200 {
+			return nil, errors.New("AuthFailure.RoleNotExist")
+		}
+		return &fanOutMeta{client: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}, nil
+	}
+
+	targets := []FanOutTarget{{Uin: 100}, {Uin: 200}}
+	diags := ReadOrganizationFanOut(context.Background(), r, testFanOutGlobalRead, "user_list", d, targets, 1, assume)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Failed to read user_list of account 200", diags[0].Summary)
+	assert.Equal(t, []string{"", ""}, regions)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "admin", "account_uin": 100},
+	}, d.Get("user_list"))
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
+)
+
+// QuotaFunc returns the key and the name of the quota of the resource planned to be created, and
+// how to fetch its usage. An empty key skips the check, such as when the zone is not known yet.
+type QuotaFunc func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (key, name string, fetch quota.FetchFunc)
+
+// QuotaCountFunc returns the number of the resources planned to be created at once, such as the
+// instances of an instance set. Zero skips the check, such as when the number is not known yet.
+type QuotaCountFunc func(d *schema.ResourceDiff) int64
+
+// QuotaCheckDiff returns a CustomizeDiff adding the resource planned to be created to its quota of f
+// if quota_check of the provider is set.
+func QuotaCheckDiff(f QuotaFunc) schema.CustomizeDiffFunc {
+	return QuotaCheckDiffN(f, func(*schema.ResourceDiff) int64 { return 1 })
+}
+
+// QuotaCheckDiffN is QuotaCheckDiff of the resources creating count of them at once.
+func QuotaCheckDiffN(f QuotaFunc, count QuotaCountFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" || meta == nil {
+			return nil
+		}
+		client := meta.(ProviderMeta).GetAPIV3Conn()
+		if client == nil || !client.QuotaChecker.Enabled() {
+			return nil
+		}
+
+		n := count(d)
+		if n <= 0 {
+			return nil
+		}
+		key, name, fetch := f(ctx, d, client)
+		if key == "" {
+			return nil
+		}
+		return client.QuotaChecker.Plan(ctx, key, name, n, fetch)
+	}
+}
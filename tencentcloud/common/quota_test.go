@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
+)
+
+type testProviderMeta struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (m *testProviderMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return m.client
+}
+
+func TestQuotaCheckDiff(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		CustomizeDiff: QuotaCheckDiff(func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+			vpcId := d.Get("vpc_id").(string)
+			return "subnet:" + vpcId, "subnets of " + vpcId, func(ctx context.Context) (quota.Usage, error) {
+				calls++
+				return quota.Usage{Used: 9, Limit: 10}, nil
+			}
+		}),
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"vpc_id": "vpc-1"})
+	meta := &testProviderMeta{client: &connectivity.TencentCloudClient{QuotaChecker: quota.NewChecker(quota.ModeError)}}
+
+	_, err := r.SimpleDiff(ctx, nil, config, meta)
+	assert.NoError(t, err)
+	_, err = r.SimpleDiff(ctx, nil, config, meta)
+	assert.EqualError(t, err, "quota of subnets of vpc-1 would be exceeded: 9 used and 2 planned to be created, but the limit is 10")
+	assert.Equal(t, 1, calls)
+
+	// the existing resources are not counted.
+	_, err = r.SimpleDiff(ctx, &terraform.InstanceState{ID: "subnet-1", Attributes: map[string]string{"id": "subnet-1", "vpc_id": "vpc-1"}}, config, meta)
+	assert.NoError(t, err)
+
+	// the quotas are not checked unless quota_check is set.
+	_, err = r.SimpleDiff(ctx, nil, config, &testProviderMeta{client: &connectivity.TencentCloudClient{}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestQuotaCheckDiffN(t *testing.T) {
+	ctx := context.Background()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone":           {Type: schema.TypeString, Required: true, ForceNew: true},
+			"instance_count": {Type: schema.TypeInt, Optional: true},
+		},
+		CustomizeDiff: QuotaCheckDiffN(func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+			zone := d.Get("zone").(string)
+			return "instance:" + zone, "instances in " + zone, func(ctx context.Context) (quota.Usage, error) {
+				return quota.Usage{Used: 5, Limit: 10}, nil
+			}
+		}, func(d *schema.ResourceDiff) int64 {
+			return int64(d.Get("instance_count").(int))
+		}),
+	}
+	meta := &testProviderMeta{client: &connectivity.TencentCloudClient{QuotaChecker: quota.NewChecker(quota.ModeError)}}
+
+	_, err := r.SimpleDiff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"zone": "ap-guangzhou-3", "instance_count": 4}), meta)
+	assert.NoError(t, err)
+	_, err = r.SimpleDiff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"zone": "ap-guangzhou-3", "instance_count": 2}), meta)
+	assert.EqualError(t, err, "quota of instances in ap-guangzhou-3 would be exceeded: 5 used and 6 planned to be created, but the limit is 10")
+
+	// a count of zero skips the check.
+	_, err = r.SimpleDiff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"zone": "ap-guangzhou-3"}), meta)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,267 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResultFilter filters the elements of the result of a data source by the values of the attribute
+// of Name, a path such as `tags.env` or `data_disks.data_disk_type`.
+type ResultFilter struct {
+	Name   string
+	Values []string
+	Regex  bool
+}
+
+// WithResultFilter adds the client-side filter blocks, sort_by and most_recent to data source r,
+// and wraps its read to filter and sort the elements of its computed list after the API call, such as:
+//
+//	return tccommon.WithResultFilter("instance_list", &schema.Resource{...})
+//
+// It panics if r declares any of the attributes, which would be overwritten otherwise.
+func WithResultFilter(list string, r *schema.Resource) *schema.Resource {
+	for name, attribute := range resultFilterAttributes(list) {
+		if _, ok := r.Schema[name]; ok {
+			panic(fmt.Sprintf("attribute %s of result filters is declared by the data source", name))
+		}
+		r.Schema[name] = attribute
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return filterResult(r, list, d)
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(filterResult(r, list, d))...)
+		}
+	}
+	return r
+}
+
+// resultFilterAttributes returns the attributes WithResultFilter adds to the data source of list.
+func resultFilterAttributes(list string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"filter": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: fmt.Sprintf("Client-side filters of `%s`, applied after the query. An element is kept if it matches all of the filters.", list),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: fmt.Sprintf("Path of the attribute of the elements of `%s` to filter by, such as `name` or `tags.key` of nested attributes.", list),
+					},
+					"values": {
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Values of the attribute. An element matches if any of its values of the attribute equals any of them.",
+					},
+					"regex": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether `values` are regular expressions matched against the values of the attribute. Default is `false`.",
+					},
+				},
+			},
+		},
+		"sort_by": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Path of the attribute of the elements of `%s` to sort by in ascending order, such as `create_time`. Numbers are compared by value, others as strings.", list),
+		},
+		"most_recent": {
+			Type:         schema.TypeBool,
+			Optional:     true,
+			RequiredWith: []string{"sort_by"},
+			Description:  fmt.Sprintf("Whether to keep only the last element of `%s` sorted by `sort_by`, such as the latest created one. Default is `false`.", list),
+		},
+	}
+}
+
+func filterResult(r *schema.Resource, list string, d *schema.ResourceData) error {
+	var filters []ResultFilter
+	for _, item := range d.Get("filter").([]interface{}) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		filter := ResultFilter{Name: m["name"].(string), Regex: m["regex"].(bool)}
+		for _, v := range m["values"].([]interface{}) {
+			if v != nil {
+				filter.Values = append(filter.Values, v.(string))
+			}
+		}
+		filters = append(filters, filter)
+	}
+	sortBy := d.Get("sort_by").(string)
+	mostRecent := d.Get("most_recent").(bool)
+	if len(filters) == 0 && sortBy == "" {
+		return nil
+	}
+
+	elem := r.Schema[list].Elem.(*schema.Resource)
+	items := resultOutputValue(d.Get(list)).([]interface{})
+	items, err := FilterResults(elem, items, filters, sortBy, mostRecent)
+	if err != nil {
+		return err
+	}
+	if err := d.Set(list, items); err != nil {
+		return err
+	}
+
+	// the data source has written the unfiltered result already.
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if format, ok := d.GetOk("result_output_format"); !ok || format.(string) == "" {
+			return WriteToFile(output.(string), items)
+		}
+	}
+	return nil
+}
+
+// FilterResults returns the items, the elements of elem, matching all of filters, sorted by the
+// attribute of sortBy if not empty, and only the last of them if mostRecent.
+func FilterResults(elem *schema.Resource, items []interface{}, filters []ResultFilter, sortBy string, mostRecent bool) ([]interface{}, error) {
+	matchers := make([][]resultMatcher, 0, len(filters))
+	for _, filter := range filters {
+		if err := checkResultPath(elem, filter.Name); err != nil {
+			return nil, err
+		}
+		var ms []resultMatcher
+		for _, value := range filter.Values {
+			value := value
+			if !filter.Regex {
+				ms = append(ms, func(s string) bool { return s == value })
+				continue
+			}
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("filter %s contains an invalid regular expression: %s", filter.Name, err.Error())
+			}
+			ms = append(ms, re.MatchString)
+		}
+		matchers = append(matchers, ms)
+	}
+
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		matched := true
+		for i, filter := range filters {
+			if !matchAnyValue(resultValues(item, strings.Split(filter.Name, ".")), matchers[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = append(result, item)
+		}
+	}
+
+	if sortBy == "" {
+		return result, nil
+	}
+	if err := checkResultPath(elem, sortBy); err != nil {
+		return nil, err
+	}
+	path := strings.Split(sortBy, ".")
+	sort.SliceStable(result, func(i, j int) bool {
+		return lessResultValues(resultValues(result[i], path), resultValues(result[j], path))
+	})
+	if mostRecent && len(result) > 1 {
+		result = result[len(result)-1:]
+	}
+	return result, nil
+}
+
+// checkResultPath checks that path is an attribute of elem or of its nested blocks. The keys of
+// maps are not checked.
+func checkResultPath(elem *schema.Resource, path string) error {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		s, ok := elem.Schema[part]
+		if !ok {
+			return fmt.Errorf("%s is not an attribute of the result", path)
+		}
+		if s.Type == schema.TypeMap {
+			return nil
+		}
+		nested, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			if i != len(parts)-1 {
+				return fmt.Errorf("%s is not an attribute of the result", path)
+			}
+			return nil
+		}
+		elem = nested
+	}
+	return nil
+}
+
+// resultValues returns the values at path in v as strings, flattening the lists.
+func resultValues(v interface{}, path []string) []string {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var result []string
+		for _, item := range value {
+			result = append(result, resultValues(item, path)...)
+		}
+		return result
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil
+		}
+		return resultValues(value[path[0]], path[1:])
+	}
+	if len(path) != 0 {
+		return nil
+	}
+	s, _ := csvCell(v)
+	return []string{s}
+}
+
+type resultMatcher func(string) bool
+
+func matchAnyValue(values []string, matchers []resultMatcher) bool {
+	for _, value := range values {
+		for _, match := range matchers {
+			if match(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lessResultValues compares the first values of a and b, by value if both are numbers. Missing
+// values are the least.
+func lessResultValues(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) < len(b)
+	}
+	x, errX := strconv.ParseFloat(a[0], 64)
+	y, errY := strconv.ParseFloat(b[0], 64)
+	if errX == nil && errY == nil {
+		return x < y
+	}
+	return a[0] < b[0]
+}
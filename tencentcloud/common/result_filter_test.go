@@ -0,0 +1,149 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+var testFilterElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"instance_id":   {Type: schema.TypeString, Computed: true},
+		"instance_name": {Type: schema.TypeString, Computed: true},
+		"cpu":           {Type: schema.TypeInt, Computed: true},
+		"create_time":   {Type: schema.TypeString, Computed: true},
+		"tags":          {Type: schema.TypeMap, Computed: true},
+		"data_disks": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"data_disk_type": {Type: schema.TypeString, Computed: true},
+				},
+			},
+		},
+	},
+}
+
+func testFilterItems() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"instance_id": "ins-1", "instance_name": "web-1", "cpu": 8, "create_time": "2024-03-01T00:00:00Z",
+			"tags":       map[string]interface{}{"env": "prod"},
+			"data_disks": []interface{}{map[string]interface{}{"data_disk_type": "CLOUD_SSD"}},
+		},
+		map[string]interface{}{
+			"instance_id": "ins-2", "instance_name": "db-1", "cpu": 16, "create_time": "2024-01-01T00:00:00Z",
+			"tags": map[string]interface{}{"env": "test"},
+		},
+		map[string]interface{}{
+			"instance_id": "ins-3", "instance_name": "web-2", "cpu": 2, "create_time": "2024-02-01T00:00:00Z",
+			"tags":       map[string]interface{}{"env": "prod"},
+			"data_disks": []interface{}{map[string]interface{}{"data_disk_type": "CLOUD_PREMIUM"}, map[string]interface{}{"data_disk_type": "CLOUD_SSD"}},
+		},
+	}
+}
+
+func testFilterIds(items []interface{}) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.(map[string]interface{})["instance_id"].(string))
+	}
+	return ids
+}
+
+func TestFilterResults(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		filters    []ResultFilter
+		sortBy     string
+		mostRecent bool
+		expected   []string
+	}{
+		{name: "none", expected: []string{"ins-1", "ins-2", "ins-3"}},
+		{name: "exact", filters: []ResultFilter{{Name: "instance_name", Values: []string{"web-1", "db-1"}}}, expected: []string{"ins-1", "ins-2"}},
+		{name: "regex", filters: []ResultFilter{{Name: "instance_name", Values: []string{"^web-"}, Regex: true}}, expected: []string{"ins-1", "ins-3"}},
+		{name: "number", filters: []ResultFilter{{Name: "cpu", Values: []string{"16"}}}, expected: []string{"ins-2"}},
+		{name: "map", filters: []ResultFilter{{Name: "tags.env", Values: []string{"prod"}}}, expected: []string{"ins-1", "ins-3"}},
+		{name: "nested", filters: []ResultFilter{{Name: "data_disks.data_disk_type", Values: []string{"CLOUD_PREMIUM"}}}, expected: []string{"ins-3"}},
+		{
+			name: "all filters",
+			filters: []ResultFilter{
+				{Name: "tags.env", Values: []string{"prod"}},
+				{Name: "data_disks.data_disk_type", Values: []string{"CLOUD_SSD"}},
+				{Name: "cpu", Values: []string{"[0-4]"}, Regex: true},
+			},
+			expected: []string{"ins-3"},
+		},
+		{name: "sort by number", sortBy: "cpu", expected: []string{"ins-3", "ins-1", "ins-2"}},
+		{name: "sort by time", sortBy: "create_time", expected: []string{"ins-2", "ins-3", "ins-1"}},
+		{name: "sort by missing", sortBy: "data_disks.data_disk_type", expected: []string{"ins-2", "ins-3", "ins-1"}},
+		{name: "most recent", filters: []ResultFilter{{Name: "tags.env", Values: []string{"prod"}}}, sortBy: "create_time", mostRecent: true, expected: []string{"ins-1"}},
+	} {
+		items, err := FilterResults(testFilterElem, testFilterItems(), c.filters, c.sortBy, c.mostRecent)
+		assert.NoError(t, err, c.name)
+		assert.Equal(t, c.expected, testFilterIds(items), c.name)
+	}
+
+	_, err := FilterResults(testFilterElem, testFilterItems(), []ResultFilter{{Name: "name", Values: []string{"web-1"}}}, "", false)
+	assert.EqualError(t, err, "name is not an attribute of the result")
+	_, err = FilterResults(testFilterElem, testFilterItems(), []ResultFilter{{Name: "cpu.count", Values: []string{"1"}}}, "", false)
+	assert.EqualError(t, err, "cpu.count is not an attribute of the result")
+	_, err = FilterResults(testFilterElem, testFilterItems(), nil, "zone", false)
+	assert.EqualError(t, err, "zone is not an attribute of the result")
+	_, err = FilterResults(testFilterElem, testFilterItems(), []ResultFilter{{Name: "instance_name", Values: []string{"("}, Regex: true}}, "", false)
+	assert.Error(t, err)
+}
+
+func TestWithResultFilter(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "instances.json")
+	r := WithResultFilter("instance_list", &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("id")
+			if err := d.Set("instance_list", testFilterItems()); err != nil {
+				return err
+			}
+			return WriteToFile(d.Get("result_output_file").(string), testFilterItems())
+		},
+		Schema: map[string]*schema.Schema{
+			"result_output_file": {Type: schema.TypeString, Optional: true},
+			"instance_list":      {Type: schema.TypeList, Computed: true, Elem: testFilterElem},
+		},
+	})
+	assert.NoError(t, r.InternalValidate(nil, false))
+
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("result_output_file", filePath))
+	assert.NoError(t, d.Set("filter", []interface{}{
+		map[string]interface{}{"name": "instance_name", "values": []interface{}{"web-.*"}, "regex": true},
+	}))
+	assert.NoError(t, d.Set("sort_by", "cpu"))
+	assert.NoError(t, r.Read(d, nil))
+	assert.Equal(t, []string{"ins-3", "ins-1"}, testFilterIds(d.Get("instance_list").([]interface{})))
+
+	// the output file has the filtered result.
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "ins-3")
+	assert.NotContains(t, string(content), "ins-2")
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"most_recent": true}))
+	assert.True(t, diags.HasError())
+	diags = r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"most_recent": true, "sort_by": "create_time"}))
+	assert.False(t, diags.HasError())
+}
+
+func TestWithResultFilterDeclared(t *testing.T) {
+	assert.PanicsWithValue(t, "attribute sort_by of result filters is declared by the data source", func() {
+		WithResultFilter("instance_list", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sort_by":       {Type: schema.TypeString, Optional: true},
+				"instance_list": {Type: schema.TypeList, Computed: true, Elem: testFilterElem},
+			},
+		})
+	})
+}
@@ -0,0 +1,261 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	ResultOutputFormatJSON  = "json"
+	ResultOutputFormatJSONL = "jsonl"
+	ResultOutputFormatCSV   = "csv"
+	ResultOutputFormatYAML  = "yaml"
+
+	// DefaultResultOutputFileMode is the mode of the file of result_output_file unless
+	// result_output_file_mode is set.
+	DefaultResultOutputFileMode os.FileMode = 0422
+)
+
+var ResultOutputFormats = []string{
+	ResultOutputFormatJSON,
+	ResultOutputFormatJSONL,
+	ResultOutputFormatCSV,
+	ResultOutputFormatYAML,
+}
+
+var fileModeRegexp = regexp.MustCompile(`^0?[0-7]{3}$`)
+
+// WithResultOutput adds result_output_format and result_output_file_mode to data source r if it
+// supports result_output_file, and wraps its read to export the result to the file in the format.
+func WithResultOutput(r *schema.Resource) {
+	if _, ok := r.Schema["result_output_file"]; !ok {
+		return
+	}
+	if _, ok := r.Schema["result_output_format"]; ok {
+		return
+	}
+
+	r.Schema["result_output_format"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: ValidateAllowedStringValue(ResultOutputFormats),
+		Description:  "Format of the file of `result_output_file`. Valid values: `json`, `jsonl`, `csv` and `yaml`. Each row of the file is an element of the computed list of the data source, or the computed attributes of the data source if it has none or several. If not set, the legacy JSON of the data source is written.",
+	}
+	r.Schema["result_output_file_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: ValidateFileMode,
+		Description:  "Permission of the file of `result_output_file` in octal, such as `0644`. Default is `0422`.",
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return writeResultOutput(r, d)
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(writeResultOutput(r, d))...)
+		}
+	}
+}
+
+// ValidateFileMode validates a permission of file in octal, such as 0644.
+func ValidateFileMode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !fileModeRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must be a file mode in octal such as 0644, got %s", k, value))
+	}
+	return
+}
+
+func writeResultOutput(r *schema.Resource, d *schema.ResourceData) error {
+	output, ok := d.GetOk("result_output_file")
+	if !ok || output.(string) == "" {
+		return nil
+	}
+	filePath, err := ExpandFilePath(output.(string))
+	if err != nil {
+		return err
+	}
+
+	mode := DefaultResultOutputFileMode
+	v, modeOk := d.GetOk("result_output_file_mode")
+	if modeOk {
+		m, err := strconv.ParseUint(v.(string), 8, 32)
+		if err != nil {
+			return fmt.Errorf("parse result_output_file_mode error, reason %s", err.Error())
+		}
+		mode = os.FileMode(m)
+	}
+
+	format := d.Get("result_output_format").(string)
+	if format == "" {
+		// the data source has written its legacy output already.
+		if modeOk {
+			return os.Chmod(filePath, mode)
+		}
+		return nil
+	}
+
+	columns, rows := ResultOutputRows(r, d)
+	content, err := EncodeResultOutput(format, columns, rows)
+	if err != nil {
+		return err
+	}
+	return WriteFileContent(filePath, content, mode)
+}
+
+// ResultOutputRows returns the columns and the rows of the result of data source r: the elements
+// of its only computed list of blocks, or a single row of its computed attributes otherwise.
+func ResultOutputRows(r *schema.Resource, d *schema.ResourceData) (columns []string, rows []map[string]interface{}) {
+	var lists, attributes []string
+	for k, s := range r.Schema {
+		if !s.Computed || s.Optional || s.Required || s.Deprecated != "" {
+			continue
+		}
+		attributes = append(attributes, k)
+		if _, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			lists = append(lists, k)
+		}
+	}
+	sort.Strings(attributes)
+
+	rows = []map[string]interface{}{}
+	if len(lists) == 1 {
+		for k := range r.Schema[lists[0]].Elem.(*schema.Resource).Schema {
+			columns = append(columns, k)
+		}
+		sort.Strings(columns)
+		for _, item := range resultOutputValue(d.Get(lists[0])).([]interface{}) {
+			if row, ok := item.(map[string]interface{}); ok {
+				rows = append(rows, row)
+			}
+		}
+		return
+	}
+
+	row := make(map[string]interface{}, len(attributes))
+	for _, k := range attributes {
+		row[k] = resultOutputValue(d.Get(k))
+	}
+	return attributes, append(rows, row)
+}
+
+// resultOutputValue converts the sets in v into lists.
+func resultOutputValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return resultOutputValue(value.List())
+	case []interface{}:
+		result := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			result = append(result, resultOutputValue(item))
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			result[k] = resultOutputValue(item)
+		}
+		return result
+	}
+	return v
+}
+
+// EncodeResultOutput encodes rows in format:
+//
+//   - json: an array of objects.
+//   - jsonl: an object per line.
+//   - csv: a header of columns and a record per row, whose nested values are encoded in JSON.
+//   - yaml: a sequence of mappings.
+//
+// The keys of the objects, the columns of the records and the keys of the mappings are sorted.
+func EncodeResultOutput(format string, columns []string, rows []map[string]interface{}) ([]byte, error) {
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+
+	switch format {
+	case ResultOutputFormatJSON:
+		content, err := json.MarshalIndent(rows, "", "\t")
+		if err != nil {
+			return nil, fmt.Errorf("json encode error, reason %s", err.Error())
+		}
+		return append(content, '\n'), nil
+	case ResultOutputFormatJSONL:
+		var buf bytes.Buffer
+		for _, row := range rows {
+			line, err := json.Marshal(row)
+			if err != nil {
+				return nil, fmt.Errorf("json encode error, reason %s", err.Error())
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+		return buf.Bytes(), nil
+	case ResultOutputFormatCSV:
+		records := make([][]string, 0, len(rows))
+		for _, row := range rows {
+			record := make([]string, 0, len(columns))
+			for _, column := range columns {
+				cell, err := csvCell(row[column])
+				if err != nil {
+					return nil, err
+				}
+				record = append(record, cell)
+			}
+			records = append(records, record)
+		}
+		var buf bytes.Buffer
+		if err := WriteCsv(csv.NewWriter(&buf), columns, records); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case ResultOutputFormatYAML:
+		content, err := yaml.Marshal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("yaml encode error, reason %s", err.Error())
+		}
+		return content, nil
+	}
+	return nil, fmt.Errorf("unsupported result output format %s", format)
+}
+
+func csvCell(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int:
+		return strconv.Itoa(value), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	}
+	content, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("json encode error, reason %s", err.Error())
+	}
+	return string(content), nil
+}
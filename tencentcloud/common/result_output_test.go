@@ -0,0 +1,137 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testResultOutputDataSource(read schema.ReadFunc) *schema.Resource {
+	return &schema.Resource{
+		Read: read,
+		Schema: map[string]*schema.Schema{
+			"name":               {Type: schema.TypeString, Optional: true},
+			"result_output_file": {Type: schema.TypeString, Optional: true},
+			"ids":                {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"instance_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {Type: schema.TypeString, Computed: true},
+						"port":        {Type: schema.TypeInt, Computed: true},
+						"tags":        {Type: schema.TypeMap, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+var testInstanceList = []interface{}{
+	map[string]interface{}{"instance_id": "ins-1", "port": 80, "tags": map[string]interface{}{"env": "test"}},
+	map[string]interface{}{"instance_id": "ins-2,a", "port": 443},
+}
+
+func TestEncodeResultOutput(t *testing.T) {
+	columns := []string{"instance_id", "port", "tags"}
+	rows := []map[string]interface{}{
+		{"instance_id": "ins-1", "port": 80, "tags": map[string]interface{}{"env": "test"}},
+		{"instance_id": "ins-2,a", "port": 443, "tags": map[string]interface{}{}},
+	}
+
+	for _, c := range []struct {
+		format   string
+		expected string
+	}{
+		{ResultOutputFormatJSON, "[\n\t{\n\t\t\"instance_id\": \"ins-1\",\n\t\t\"port\": 80,\n\t\t\"tags\": {\n\t\t\t\"env\": \"test\"\n\t\t}\n\t},\n\t{\n\t\t\"instance_id\": \"ins-2,a\",\n\t\t\"port\": 443,\n\t\t\"tags\": {}\n\t}\n]\n"},
+		{ResultOutputFormatJSONL, "{\"instance_id\":\"ins-1\",\"port\":80,\"tags\":{\"env\":\"test\"}}\n{\"instance_id\":\"ins-2,a\",\"port\":443,\"tags\":{}}\n"},
+		{ResultOutputFormatCSV, "instance_id,port,tags\nins-1,80,\"{\"\"env\"\":\"\"test\"\"}\"\n\"ins-2,a\",443,{}\n"},
+		{ResultOutputFormatYAML, "- instance_id: ins-1\n  port: 80\n  tags:\n    env: test\n- instance_id: ins-2,a\n  port: 443\n  tags: {}\n"},
+	} {
+		content, err := EncodeResultOutput(c.format, columns, rows)
+		assert.NoError(t, err, c.format)
+		assert.Equal(t, c.expected, string(content), c.format)
+	}
+
+	content, err := EncodeResultOutput(ResultOutputFormatJSON, columns, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]\n", string(content))
+
+	_, err = EncodeResultOutput("xml", columns, rows)
+	assert.Error(t, err)
+}
+
+func TestResultOutputRows(t *testing.T) {
+	r := testResultOutputDataSource(nil)
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("ids", []string{"ins-1", "ins-2,a"}))
+	assert.NoError(t, d.Set("instance_list", testInstanceList))
+
+	columns, rows := ResultOutputRows(r, d)
+	assert.Equal(t, []string{"instance_id", "port", "tags"}, columns)
+	assert.Equal(t, []map[string]interface{}{
+		{"instance_id": "ins-1", "port": 80, "tags": map[string]interface{}{"env": "test"}},
+		{"instance_id": "ins-2,a", "port": 443, "tags": map[string]interface{}{}},
+	}, rows)
+
+	// without a computed list of blocks, the computed attributes are a single row.
+	delete(r.Schema, "instance_list")
+	columns, rows = ResultOutputRows(r, d)
+	assert.Equal(t, []string{"ids"}, columns)
+	assert.Equal(t, []map[string]interface{}{{"ids": []interface{}{"ins-1", "ins-2,a"}}}, rows)
+}
+
+func TestWithResultOutput(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "output", "instances.csv")
+
+	r := testResultOutputDataSource(func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId("id")
+		if err := d.Set("instance_list", testInstanceList); err != nil {
+			return err
+		}
+		return WriteToFile(d.Get("result_output_file").(string), testInstanceList)
+	})
+	WithResultOutput(r)
+	assert.Contains(t, r.Schema, "result_output_format")
+	assert.Contains(t, r.Schema, "result_output_file_mode")
+
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("result_output_file", filePath))
+	assert.NoError(t, d.Set("result_output_format", ResultOutputFormatCSV))
+	assert.NoError(t, d.Set("result_output_file_mode", "0644"))
+	assert.NoError(t, r.Read(d, nil))
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "instance_id,port,tags\nins-1,80,\"{\"\"env\"\":\"\"test\"\"}\"\n\"ins-2,a\",443,{}\n", string(content))
+	info, err := os.Stat(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	// no temporary files are left.
+	entries, err := os.ReadDir(filepath.Dir(filePath))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// without a format, the legacy output is kept with the mode.
+	d = r.TestResourceData()
+	assert.NoError(t, d.Set("result_output_file", filePath))
+	assert.NoError(t, d.Set("result_output_file_mode", "600"))
+	assert.NoError(t, r.Read(d, nil))
+	content, err = os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "\"instance_id\": \"ins-1\"")
+	info, err = os.Stat(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, errs := ValidateFileMode("0644", "result_output_file_mode")
+	assert.Empty(t, errs)
+	_, errs = ValidateFileMode("0844", "result_output_file_mode")
+	assert.NotEmpty(t, errs)
+}
@@ -109,6 +109,9 @@ import (
 	wedatav20250806 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wedata/v20250806"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wss/v20180426"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sellable"
 )
 
 //internal version: replace import begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -126,6 +129,10 @@ type TencentCloudClient struct {
 	Protocol   string
 	Domain     string
 	CosDomain  string
+	// QuotaChecker checks the quotas of the resources planned to be created if quota_check is set.
+	QuotaChecker *quota.Checker
+	// SellableCache caches the instance types offered in the region for the sellable checks of a plan.
+	SellableCache *sellable.Cache
 
 	cosConn             *s3.S3
 	tencentCosConn      *cos.Client
@@ -0,0 +1,238 @@
+// Package certcheck validates PEM encoded certificates and private keys offline, before they are
+// uploaded: parsing, private key and certificate match, chain order and completeness, and the
+// coverage of domains by the subject alternative names.
+package certcheck
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// ParseCertificates parses the PEM encoded certificates in data, the leaf certificate first.
+func ParseCertificates(data string) ([]*x509.Certificate, error) {
+	var (
+		certs []*x509.Certificate
+		rest  = []byte(data)
+	)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("certificate %d: unexpected PEM block %q, expect CERTIFICATE", len(certs)+1, block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d: %v", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, fmt.Errorf("unexpected content after certificate %d", len(certs))
+	}
+	return certs, nil
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #1, PKCS #8 or SEC 1 private key.
+func ParsePrivateKey(data string) (crypto.Signer, error) {
+	block, rest := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, errors.New("unexpected content after the private key")
+	}
+	if x509.IsEncryptedPEMBlock(block) { //nolint:staticcheck
+		return nil, errors.New("private key is encrypted")
+	}
+
+	var (
+		key interface{}
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unexpected PEM block %q, expect a private key", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// CheckKeyPair checks that key is the private key of cert.
+func CheckKeyPair(cert *x509.Certificate, key crypto.Signer) error {
+	type equaler interface {
+		Equal(crypto.PublicKey) bool
+	}
+
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		if pub.(equaler).Equal(key.Public()) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T of certificate %s", pub, Name(cert))
+	}
+	return fmt.Errorf("private key does not match the certificate %s", Name(cert))
+}
+
+// CheckChain checks that every certificate of chain is issued by the next one. If roots is not
+// nil, it also checks that the last certificate is a root of roots or is issued by one of them.
+func CheckChain(chain []*x509.Certificate, roots *x509.CertPool, now time.Time) error {
+	for i := 0; i < len(chain)-1; i++ {
+		if chain[i].CheckSignatureFrom(chain[i+1]) == nil {
+			continue
+		}
+		for j := range chain {
+			if j != i+1 && j != i && chain[i].CheckSignatureFrom(chain[j]) == nil {
+				return fmt.Errorf("certificate chain is out of order: certificate %d %s is issued by certificate %d %s, which should follow it",
+					i+1, Name(chain[i]), j+1, Name(chain[j]))
+			}
+		}
+		return fmt.Errorf("certificate chain is broken: certificate %d %s is not issued by certificate %d %s",
+			i+1, Name(chain[i]), i+2, Name(chain[i+1]))
+	}
+
+	if roots == nil {
+		return nil
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		var unknown x509.UnknownAuthorityError
+		if errors.As(err, &unknown) {
+			last := chain[len(chain)-1]
+			return fmt.Errorf("certificate chain is incomplete: no trusted root issues %s, append the intermediate certificate of %q",
+				Name(last), last.Issuer.String())
+		}
+		return fmt.Errorf("certificate chain is invalid: %v", err)
+	}
+	return nil
+}
+
+// CheckDomains checks that every domain is covered by the subject alternative names of cert.
+func CheckDomains(cert *x509.Certificate, domains []string) error {
+	var missing []string
+	for _, domain := range domains {
+		if !Covers(cert, domain) {
+			missing = append(missing, domain)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("certificate %s does not cover %s, its subject alternative names are %s",
+			Name(cert), strings.Join(missing, ", "), strings.Join(SubjectAltNames(cert), ", "))
+	}
+	return nil
+}
+
+// Covers reports whether the subject alternative names of cert cover domain, which is a host
+// name, a wildcard name such as `*.example.com` or an IP address.
+func Covers(cert *x509.Certificate, domain string) bool {
+	if ip := net.ParseIP(domain); ip != nil {
+		for _, candidate := range cert.IPAddresses {
+			if candidate.Equal(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	for _, name := range cert.DNSNames {
+		if MatchName(name, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchName reports whether the subject alternative name pattern covers domain. A wildcard
+// only matches a single leftmost label, and a wildcard domain is only covered by the same wildcard.
+func MatchName(pattern, domain string) bool {
+	pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if pattern == domain {
+		return true
+	}
+	if !strings.HasPrefix(pattern, "*.") || strings.HasPrefix(domain, "*.") {
+		return false
+	}
+	dot := strings.IndexByte(domain, '.')
+	return dot > 0 && domain[dot:] == pattern[1:]
+}
+
+// SubjectAltNames returns the DNS names and IP addresses of cert.
+func SubjectAltNames(cert *x509.Certificate) []string {
+	names := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	return names
+}
+
+// FingerprintSHA256 returns the SHA-256 fingerprint of cert in lower case hex.
+func FingerprintSHA256(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// DaysUntilExpiry returns the number of whole days from now to the expiry of cert, negative once expired.
+func DaysUntilExpiry(cert *x509.Certificate, now time.Time) int {
+	return DaysUntil(cert.NotAfter, now)
+}
+
+// DaysUntil returns the number of whole days from now to t, rounded down.
+func DaysUntil(t, now time.Time) int {
+	left := t.Sub(now)
+	days := int(left / (24 * time.Hour))
+	if left < 0 && left%(24*time.Hour) != 0 {
+		days--
+	}
+	return days
+}
+
+// Name returns a short description of cert for error messages.
+func Name(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return fmt.Sprintf("(CN=%s)", cert.Subject.CommonName)
+	}
+	return fmt.Sprintf("(%s)", cert.Subject.String())
+}
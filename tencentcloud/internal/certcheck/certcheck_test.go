@@ -0,0 +1,195 @@
+package certcheck
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+	pem  string
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, isCA bool, dnsNames ...string) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              testNow.Add(90 * 24 * time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		DNSNames:              dnsNames,
+		IPAddresses:           []net.IP{net.ParseIP("192.0.2.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	issuer, signer := template, crypto.Signer(key)
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func TestParseCertificates(t *testing.T) {
+	root := newTestCert(t, "root", nil, true)
+	leaf := newTestCert(t, "leaf", root, false, "example.com")
+
+	certs, err := ParseCertificates(leaf.pem + root.pem)
+	assert.NoError(t, err)
+	if assert.Len(t, certs, 2) {
+		assert.Equal(t, "leaf", certs[0].Subject.CommonName)
+	}
+
+	_, err = ParseCertificates("hello")
+	assert.EqualError(t, err, "no PEM encoded certificate found")
+
+	_, err = ParseCertificates(leaf.pem + "trailing")
+	assert.EqualError(t, err, "unexpected content after certificate 1")
+
+	_, err = ParseCertificates(strings.Replace(leaf.pem, "CERTIFICATE", "PRIVATE KEY", 2))
+	assert.EqualError(t, err, `certificate 1: unexpected PEM block "PRIVATE KEY", expect CERTIFICATE`)
+}
+
+func TestParsePrivateKeyAndCheckKeyPair(t *testing.T) {
+	root := newTestCert(t, "root", nil, true)
+	leaf := newTestCert(t, "leaf", root, false, "example.com")
+
+	ecDer, _ := x509.MarshalECPrivateKey(leaf.key.(*ecdsa.PrivateKey))
+	key, err := ParsePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDer})))
+	assert.NoError(t, err)
+	assert.NoError(t, CheckKeyPair(leaf.cert, key))
+	assert.EqualError(t, CheckKeyPair(root.cert, key), "private key does not match the certificate (CN=root)")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	key, err = ParsePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})))
+	assert.NoError(t, err)
+	assert.Error(t, CheckKeyPair(leaf.cert, key))
+
+	_, err = ParsePrivateKey(leaf.pem)
+	assert.EqualError(t, err, `unexpected PEM block "CERTIFICATE", expect a private key`)
+
+	_, err = ParsePrivateKey("")
+	assert.EqualError(t, err, "no PEM encoded private key found")
+}
+
+func TestCheckChain(t *testing.T) {
+	root := newTestCert(t, "root", nil, true)
+	intermediate := newTestCert(t, "intermediate", root, true)
+	leaf := newTestCert(t, "leaf", intermediate, false, "example.com")
+	other := newTestCert(t, "other", nil, true)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	tests := []struct {
+		name  string
+		chain []*testCert
+		roots *x509.CertPool
+		err   string
+	}{
+		{name: "ordered", chain: []*testCert{leaf, intermediate}, roots: roots},
+		{name: "with root", chain: []*testCert{leaf, intermediate, root}, roots: roots},
+		{name: "leaf only without roots", chain: []*testCert{leaf}},
+		{
+			name:  "out of order",
+			chain: []*testCert{leaf, root, intermediate},
+			err:   "certificate chain is out of order: certificate 1 (CN=leaf) is issued by certificate 3 (CN=intermediate), which should follow it",
+		},
+		{
+			name:  "broken",
+			chain: []*testCert{leaf, other},
+			err:   "certificate chain is broken: certificate 1 (CN=leaf) is not issued by certificate 2 (CN=other)",
+		},
+		{
+			name:  "incomplete",
+			chain: []*testCert{leaf},
+			roots: roots,
+			err:   `certificate chain is incomplete: no trusted root issues (CN=leaf), append the intermediate certificate of "CN=intermediate"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := make([]*x509.Certificate, 0, len(tt.chain))
+			for _, c := range tt.chain {
+				chain = append(chain, c.cert)
+			}
+			err := CheckChain(chain, tt.roots, testNow)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckDomains(t *testing.T) {
+	root := newTestCert(t, "root", nil, true)
+	leaf := newTestCert(t, "leaf", root, false, "example.com", "*.example.com")
+
+	assert.NoError(t, CheckDomains(leaf.cert, []string{"example.com", "WWW.example.com.", "*.example.com", "192.0.2.1"}))
+	assert.EqualError(t, CheckDomains(leaf.cert, []string{"a.b.example.com", "example.org", "192.0.2.2"}),
+		"certificate (CN=leaf) does not cover a.b.example.com, example.org, 192.0.2.2, its subject alternative names are example.com, *.example.com, 192.0.2.1")
+}
+
+func TestMatchName(t *testing.T) {
+	tests := []struct {
+		pattern, domain string
+		match           bool
+	}{
+		{"example.com", "example.com", true},
+		{"Example.com.", "example.COM", true},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.www.example.com", false},
+		{"*.example.com", "*.example.com", true},
+		{"www.example.com", "*.example.com", false},
+		{"*.example.com", "*.www.example.com", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, MatchName(tt.pattern, tt.domain), "%s %s", tt.pattern, tt.domain)
+	}
+}
+
+func TestDaysUntilExpiry(t *testing.T) {
+	root := newTestCert(t, "root", nil, true)
+	assert.Equal(t, 90, DaysUntilExpiry(root.cert, testNow))
+	assert.Equal(t, 89, DaysUntilExpiry(root.cert, testNow.Add(time.Hour)))
+	assert.Equal(t, 0, DaysUntilExpiry(root.cert, root.cert.NotAfter))
+	assert.Equal(t, -1, DaysUntilExpiry(root.cert, root.cert.NotAfter.Add(time.Hour)))
+	assert.Len(t, FingerprintSHA256(root.cert), 64)
+}
@@ -0,0 +1,193 @@
+// Package cidralloc allocates non-overlapping IPv4 subnet CIDR blocks inside the CIDR blocks of a VPC.
+//
+// Allocation is deterministic: requests are served from the largest block to the smallest one,
+// requests of the same size keep their order, and every block is placed at the lowest free
+// aligned address of the first pool which can hold it. Requests already served by existing
+// blocks, such as the subnets created from an earlier allocation, keep their blocks.
+package cidralloc
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+)
+
+const (
+	// MaxPrefixLength is the longest prefix which can be allocated.
+	MaxPrefixLength = 32
+)
+
+// Request asks for one block of PrefixLength bits. Name and Zone are passed through to the allocation.
+type Request struct {
+	Name         string
+	Zone         string
+	PrefixLength int
+}
+
+// Existing is a block in use with a name and a zone, such as the CIDR block of a subnet.
+type Existing struct {
+	Name   string
+	Zone   string
+	Prefix netip.Prefix
+}
+
+// Allocation is a block allocated for a request.
+type Allocation struct {
+	Request
+	// Prefix is the allocated block.
+	Prefix netip.Prefix
+	// Pool is the pool the block is allocated from.
+	Pool netip.Prefix
+}
+
+type span struct {
+	from uint64
+	to   uint64
+}
+
+// Allocate allocates a block for every request from pools, avoiding the used blocks and the blocks
+// allocated for other requests. Pools are tried in order, used blocks may be outside of the pools.
+// The allocations are returned in the order of requests.
+func Allocate(pools, used []netip.Prefix, requests []Request) ([]Allocation, error) {
+	return AllocateExisting(pools, used, nil, requests)
+}
+
+// AllocateExisting is Allocate with existing blocks. A request is served the existing block of the
+// same name, zone and prefix length inside the pools if any, such as the subnet created from its
+// earlier allocation, so that the allocations stay the same once their blocks are in use. The other
+// existing blocks are avoided as the used ones.
+func AllocateExisting(pools, used []netip.Prefix, existing []Existing, requests []Request) ([]Allocation, error) {
+	for _, pool := range pools {
+		if !pool.IsValid() || !pool.Addr().Is4() {
+			return nil, fmt.Errorf("invalid pool %q, expect an IPv4 CIDR", pool)
+		}
+	}
+
+	taken := make([]span, 0, len(used)+len(existing)+len(requests))
+	for _, prefix := range used {
+		if !prefix.IsValid() {
+			return nil, fmt.Errorf("invalid used CIDR %q", prefix)
+		}
+		if !prefix.Addr().Is4() {
+			continue
+		}
+		taken = append(taken, prefixSpan(prefix))
+	}
+	for _, e := range existing {
+		if !e.Prefix.IsValid() {
+			return nil, fmt.Errorf("invalid existing CIDR %q", e.Prefix)
+		}
+		if !e.Prefix.Addr().Is4() {
+			continue
+		}
+		taken = append(taken, prefixSpan(e.Prefix))
+	}
+
+	for i, request := range requests {
+		if request.PrefixLength < 0 || request.PrefixLength > MaxPrefixLength {
+			return nil, fmt.Errorf("request %d (%s): invalid prefix length %d", i, request.Name, request.PrefixLength)
+		}
+	}
+
+	allocations := make([]Allocation, len(requests))
+	allocated := make([]bool, len(requests))
+	matched := make([]bool, len(existing))
+	for i, request := range requests {
+		for j, e := range existing {
+			if matched[j] || e.Name != request.Name || e.Zone != request.Zone || e.Prefix.Bits() != request.PrefixLength || !e.Prefix.Addr().Is4() {
+				continue
+			}
+			pool, ok := poolOf(pools, e.Prefix)
+			if !ok {
+				continue
+			}
+			allocations[i] = Allocation{Request: request, Prefix: e.Prefix.Masked(), Pool: pool}
+			allocated[i], matched[j] = true, true
+			break
+		}
+	}
+
+	order := make([]int, 0, len(requests))
+	for i := range requests {
+		if !allocated[i] {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return requests[order[i]].PrefixLength < requests[order[j]].PrefixLength
+	})
+
+	for _, i := range order {
+		request := requests[i]
+		for _, pool := range pools {
+			block, ok := allocate(pool, taken, request.PrefixLength)
+			if !ok {
+				continue
+			}
+			taken = append(taken, prefixSpan(block))
+			allocations[i] = Allocation{Request: request, Prefix: block, Pool: pool.Masked()}
+			allocated[i] = true
+			break
+		}
+		if !allocated[i] {
+			return nil, fmt.Errorf("request %d (%s): no free /%d block left in %v", i, request.Name, request.PrefixLength, pools)
+		}
+	}
+
+	return allocations, nil
+}
+
+// poolOf returns the first of pools containing prefix.
+func poolOf(pools []netip.Prefix, prefix netip.Prefix) (netip.Prefix, bool) {
+	for _, pool := range pools {
+		if pool.Bits() <= prefix.Bits() && pool.Masked().Contains(prefix.Masked().Addr()) {
+			return pool.Masked(), true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// allocate returns the lowest free aligned block of bits inside pool.
+func allocate(pool netip.Prefix, taken []span, bits int) (netip.Prefix, bool) {
+	if bits < pool.Bits() {
+		return netip.Prefix{}, false
+	}
+
+	var (
+		limit = prefixSpan(pool)
+		size  = uint64(1) << (MaxPrefixLength - bits)
+		start = limit.from
+	)
+
+	for start+size-1 <= limit.to {
+		candidate := span{from: start, to: start + size - 1}
+		conflictEnd, conflict := uint64(0), false
+		for _, t := range taken {
+			if t.from <= candidate.to && candidate.from <= t.to && (!conflict || t.to > conflictEnd) {
+				conflictEnd, conflict = t.to, true
+			}
+		}
+		if !conflict {
+			return netip.PrefixFrom(toAddr(start), bits), true
+		}
+		// Blocks are aligned to their size, skip to the first aligned address after the conflict.
+		start = (conflictEnd/size + 1) * size
+	}
+
+	return netip.Prefix{}, false
+}
+
+func prefixSpan(prefix netip.Prefix) span {
+	prefix = prefix.Masked()
+	from := uint64(toUint32(prefix.Addr()))
+	return span{from: from, to: from + uint64(1)<<(MaxPrefixLength-prefix.Bits()) - 1}
+}
+
+func toUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func toAddr(v uint64) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
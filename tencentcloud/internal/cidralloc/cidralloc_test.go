@@ -0,0 +1,298 @@
+package cidralloc
+
+import (
+	"math/rand"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func prefixes(items ...string) []netip.Prefix {
+	result := make([]netip.Prefix, 0, len(items))
+	for _, item := range items {
+		result = append(result, netip.MustParsePrefix(item))
+	}
+	return result
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pools    []string
+		used     []string
+		requests []Request
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "empty vpc",
+			pools:    []string{"10.0.0.0/16"},
+			requests: []Request{{Name: "a", PrefixLength: 24}, {Name: "b", PrefixLength: 24}},
+			expected: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		{
+			name:     "no request",
+			pools:    []string{"10.0.0.0/16"},
+			requests: []Request{},
+			expected: []string{},
+		},
+		{
+			name:     "skip existing subnets",
+			pools:    []string{"10.0.0.0/16"},
+			used:     []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.3.0/24"},
+			requests: []Request{{Name: "a", PrefixLength: 24}, {Name: "b", PrefixLength: 24}},
+			expected: []string{"10.0.2.0/24", "10.0.4.0/24"},
+		},
+		{
+			name:     "larger blocks first",
+			pools:    []string{"10.0.0.0/16"},
+			requests: []Request{{Name: "small", PrefixLength: 26}, {Name: "large", PrefixLength: 20}, {Name: "medium", PrefixLength: 24}},
+			expected: []string{"10.0.17.0/26", "10.0.0.0/20", "10.0.16.0/24"},
+		},
+		{
+			name:     "same size keeps request order",
+			pools:    []string{"10.0.0.0/16"},
+			requests: []Request{{Name: "b", PrefixLength: 24}, {Name: "a", PrefixLength: 24}, {Name: "c", PrefixLength: 24}},
+			expected: []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:     "aligned after small used block",
+			pools:    []string{"10.0.0.0/16"},
+			used:     []string{"10.0.0.64/26"},
+			requests: []Request{{Name: "a", PrefixLength: 24}, {Name: "b", PrefixLength: 26}},
+			expected: []string{"10.0.1.0/24", "10.0.0.0/26"},
+		},
+		{
+			name:     "fill holes",
+			pools:    []string{"192.168.0.0/24"},
+			used:     []string{"192.168.0.0/26", "192.168.0.128/26"},
+			requests: []Request{{Name: "a", PrefixLength: 26}, {Name: "b", PrefixLength: 26}},
+			expected: []string{"192.168.0.64/26", "192.168.0.192/26"},
+		},
+		{
+			name:     "reserved range larger than request",
+			pools:    []string{"10.0.0.0/16"},
+			used:     []string{"10.0.0.0/17"},
+			requests: []Request{{Name: "a", PrefixLength: 24}},
+			expected: []string{"10.0.128.0/24"},
+		},
+		{
+			name:     "used blocks outside of pools are ignored",
+			pools:    []string{"10.0.0.0/24"},
+			used:     []string{"172.16.0.0/12", "10.1.0.0/16", "2001:db8::/32"},
+			requests: []Request{{Name: "a", PrefixLength: 25}},
+			expected: []string{"10.0.0.0/25"},
+		},
+		{
+			name:     "used block covering the pool",
+			pools:    []string{"10.0.0.0/24", "10.1.0.0/24"},
+			used:     []string{"10.0.0.0/8"},
+			requests: []Request{{Name: "a", PrefixLength: 25}},
+			wantErr:  true,
+		},
+		{
+			name:     "overflow to secondary pool",
+			pools:    []string{"10.0.0.0/24", "172.16.0.0/24"},
+			used:     []string{"10.0.0.0/25"},
+			requests: []Request{{Name: "a", PrefixLength: 25}, {Name: "b", PrefixLength: 25}, {Name: "c", PrefixLength: 26}},
+			expected: []string{"10.0.0.128/25", "172.16.0.0/25", "172.16.0.128/26"},
+		},
+		{
+			name:     "request larger than primary pool",
+			pools:    []string{"10.0.0.0/24", "172.16.0.0/16"},
+			requests: []Request{{Name: "a", PrefixLength: 20}, {Name: "b", PrefixLength: 28}},
+			expected: []string{"172.16.0.0/20", "10.0.0.0/28"},
+		},
+		{
+			name:     "whole pool",
+			pools:    []string{"10.0.0.0/24"},
+			requests: []Request{{Name: "a", PrefixLength: 24}},
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "exhausted pool",
+			pools:    []string{"10.0.0.0/24"},
+			requests: []Request{{Name: "a", PrefixLength: 25}, {Name: "b", PrefixLength: 25}, {Name: "c", PrefixLength: 26}},
+			wantErr:  true,
+		},
+		{
+			name:     "request larger than every pool",
+			pools:    []string{"10.0.0.0/24"},
+			requests: []Request{{Name: "a", PrefixLength: 23}},
+			wantErr:  true,
+		},
+		{
+			name:     "no pool",
+			requests: []Request{{Name: "a", PrefixLength: 24}},
+			wantErr:  true,
+		},
+		{
+			name:     "unmasked pool",
+			pools:    []string{"10.0.0.7/24"},
+			requests: []Request{{Name: "a", PrefixLength: 26}},
+			expected: []string{"10.0.0.0/26"},
+		},
+		{
+			name:     "end of address space",
+			pools:    []string{"255.255.255.0/24"},
+			used:     []string{"255.255.255.0/25"},
+			requests: []Request{{Name: "a", PrefixLength: 25}},
+			expected: []string{"255.255.255.128/25"},
+		},
+		{
+			name:     "end of address space exhausted",
+			pools:    []string{"255.255.255.0/24"},
+			used:     []string{"255.255.255.128/25"},
+			requests: []Request{{Name: "a", PrefixLength: 25}, {Name: "b", PrefixLength: 25}},
+			wantErr:  true,
+		},
+		{
+			name:     "single address",
+			pools:    []string{"10.0.0.0/30"},
+			used:     []string{"10.0.0.0/32", "10.0.0.2/31"},
+			requests: []Request{{Name: "a", PrefixLength: 32}},
+			expected: []string{"10.0.0.1/32"},
+		},
+		{
+			name:     "ipv6 pool",
+			pools:    []string{"2001:db8::/56"},
+			requests: []Request{{Name: "a", PrefixLength: 24}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid prefix length",
+			pools:    []string{"10.0.0.0/16"},
+			requests: []Request{{Name: "a", PrefixLength: 33}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		allocations, err := Allocate(prefixes(tt.pools...), prefixes(tt.used...), tt.requests)
+		if tt.wantErr {
+			assert.Error(t, err, tt.name)
+			continue
+		}
+		if !assert.NoError(t, err, tt.name) {
+			continue
+		}
+		actual := make([]string, 0, len(allocations))
+		for i, allocation := range allocations {
+			assert.Equal(t, tt.requests[i], allocation.Request, tt.name)
+			actual = append(actual, allocation.Prefix.String())
+		}
+		assert.Equal(t, tt.expected, actual, tt.name)
+	}
+}
+
+func TestAllocatePool(t *testing.T) {
+	allocations, err := Allocate(
+		prefixes("10.0.0.0/24", "172.16.0.0/24"),
+		prefixes("10.0.0.0/24"),
+		[]Request{{Name: "a", Zone: "ap-guangzhou-3", PrefixLength: 26}},
+	)
+	assert.NoError(t, err)
+	if assert.Len(t, allocations, 1) {
+		assert.Equal(t, "172.16.0.0/24", allocations[0].Pool.String())
+		assert.Equal(t, "ap-guangzhou-3", allocations[0].Zone)
+	}
+}
+
+func TestAllocateExisting(t *testing.T) {
+	pools := prefixes("10.0.0.0/16")
+	requests := []Request{
+		{Name: "app", Zone: "ap-guangzhou-3", PrefixLength: 24},
+		{Name: "db", Zone: "ap-guangzhou-4", PrefixLength: 26},
+		{Name: "cache", Zone: "ap-guangzhou-4", PrefixLength: 26},
+	}
+
+	first, err := Allocate(pools, prefixes("10.0.0.0/20"), requests)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.16.0/24", "10.0.17.0/26", "10.0.17.64/26"}, allocatedPrefixes(first))
+
+	// the subnets of app and db are created from the allocation, the next allocation is the same.
+	existing := []Existing{
+		{Name: "app", Zone: "ap-guangzhou-3", Prefix: netip.MustParsePrefix("10.0.16.0/24")},
+		{Name: "db", Zone: "ap-guangzhou-4", Prefix: netip.MustParsePrefix("10.0.17.0/26")},
+		{Name: "other", Zone: "ap-guangzhou-4", Prefix: netip.MustParsePrefix("10.0.18.0/24")},
+	}
+	again, err := AllocateExisting(pools, prefixes("10.0.0.0/20"), existing, requests)
+	assert.NoError(t, err)
+	assert.Equal(t, first, again)
+
+	// an existing block of another zone or size is avoided instead.
+	existing = []Existing{{Name: "app", Zone: "ap-guangzhou-4", Prefix: netip.MustParsePrefix("10.0.0.0/24")}}
+	again, err = AllocateExisting(pools, nil, existing, requests[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.1.0/24"}, allocatedPrefixes(again))
+
+	// an existing block outside of the pools is avoided as well.
+	existing = []Existing{{Name: "app", Zone: "ap-guangzhou-3", Prefix: netip.MustParsePrefix("172.16.0.0/24")}}
+	again, err = AllocateExisting(pools, nil, existing, requests[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/24"}, allocatedPrefixes(again))
+}
+
+func allocatedPrefixes(allocations []Allocation) []string {
+	result := make([]string, 0, len(allocations))
+	for _, allocation := range allocations {
+		result = append(result, allocation.Prefix.String())
+	}
+	return result
+}
+
+func TestAllocateDeterministic(t *testing.T) {
+	pools := prefixes("10.0.0.0/16", "10.1.0.0/20")
+	used := prefixes("10.0.0.0/24", "10.0.5.128/25", "10.0.200.0/21")
+	requests := []Request{
+		{Name: "a", PrefixLength: 24}, {Name: "b", PrefixLength: 20}, {Name: "c", PrefixLength: 27},
+		{Name: "d", PrefixLength: 24}, {Name: "e", PrefixLength: 18}, {Name: "f", PrefixLength: 28},
+	}
+
+	first, err := Allocate(pools, used, requests)
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		again, err := Allocate(pools, used, requests)
+		assert.NoError(t, err)
+		assert.Equal(t, first, again)
+	}
+}
+
+// TestAllocateRandom checks the invariants of allocations against random inputs: every block is
+// aligned, inside a pool, and overlaps neither a used block nor another allocated block.
+func TestAllocateRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pools := prefixes("10.0.0.0/20", "10.0.64.0/22")
+
+	for round := 0; round < 500; round++ {
+		used := make([]netip.Prefix, 0)
+		for i := r.Intn(8); i > 0; i-- {
+			addr := netip.AddrFrom4([4]byte{10, 0, byte(r.Intn(80)), byte(r.Intn(256))})
+			used = append(used, netip.PrefixFrom(addr, 20+r.Intn(13)).Masked())
+		}
+		requests := make([]Request, 0)
+		for i := r.Intn(10); i > 0; i-- {
+			requests = append(requests, Request{PrefixLength: 21 + r.Intn(12)})
+		}
+
+		allocations, err := Allocate(pools, used, requests)
+		if err != nil {
+			continue
+		}
+		assert.Len(t, allocations, len(requests))
+
+		taken := append([]netip.Prefix{}, used...)
+		for i, allocation := range allocations {
+			block := allocation.Prefix
+			assert.Equal(t, requests[i].PrefixLength, block.Bits())
+			assert.Equal(t, block.Masked(), block, "block %s is not aligned", block)
+			assert.True(t, allocation.Pool.Contains(block.Addr()) && allocation.Pool.Bits() <= block.Bits(),
+				"block %s is outside of pool %s", block, allocation.Pool)
+			for _, other := range taken {
+				assert.False(t, other.Overlaps(block), "block %s overlaps %s", block, other)
+			}
+			taken = append(taken, block)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Package compositeid builds and parses the ids of resources made of several segments, such as
+// `<mysql_id>#<account_name>`.
+//
+// Segments are joined with "#", the same as helper.IdFormat. A "#" or "\" inside a value is escaped
+// with a "\", so that every value survives a round trip, while the ids of values without them are
+// exactly the ones built by helper.IdFormat and can be parsed as before.
+//
+// The importers of the resources with composite ids are built on a Format. The resources which
+// still split their ids by hand in Read, Update or Delete are listed in testdata/unmigrated.txt,
+// and TestUnmigrated keeps the list from growing, so they move onto a Format one at a time.
+package compositeid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	separator = '#'
+	escape    = '\\'
+)
+
+// Type is the type of the value of a segment.
+type Type int
+
+const (
+	TypeString Type = iota
+	TypeInt
+)
+
+// Segment is a named part of a composite id.
+type Segment struct {
+	Name     string
+	Type     Type
+	Optional bool
+}
+
+// String returns a segment of string value.
+func String(name string) Segment {
+	return Segment{Name: name, Type: TypeString}
+}
+
+// Int returns a segment of integer value.
+func Int(name string) Segment {
+	return Segment{Name: name, Type: TypeInt}
+}
+
+// Optional returns s which can be left out, only the trailing segments of a format can be optional.
+func Optional(s Segment) Segment {
+	s.Optional = true
+	return s
+}
+
+// Single is the format of the ids made of the id of the resource only, its ImportState rejects an
+// empty import id or one of several segments.
+var Single = New(String("id"))
+
+// Format is the declared segments of the ids of a resource.
+type Format struct {
+	segments []Segment
+	required int
+}
+
+// New returns the format of segments. It panics on duplicated names or an optional segment
+// followed by a required one, as they are mistakes in the declaration.
+func New(segments ...Segment) *Format {
+	f := &Format{segments: segments}
+	names := make(map[string]bool, len(segments))
+	for i, s := range segments {
+		if s.Name == "" || names[s.Name] {
+			panic(fmt.Sprintf("compositeid: segment %d has an empty or duplicated name %q", i, s.Name))
+		}
+		names[s.Name] = true
+		if !s.Optional {
+			if i != f.required {
+				panic(fmt.Sprintf("compositeid: required segment %s follows an optional one", s.Name))
+			}
+			f.required++
+		}
+	}
+	return f
+}
+
+// String returns the format for messages, such as `<ccn_id>#<region>[#<dst_region>]`.
+func (f *Format) String() string {
+	var b strings.Builder
+	for i, s := range f.segments {
+		if s.Optional {
+			b.WriteByte('[')
+		}
+		if i > 0 {
+			b.WriteByte(separator)
+		}
+		b.WriteString("<" + s.Name + ">")
+	}
+	b.WriteString(strings.Repeat("]", len(f.segments)-f.required))
+	return b.String()
+}
+
+// Join returns the id of values, given in the order of the segments. Trailing optional values
+// which are empty are left out.
+func (f *Format) Join(values ...string) string {
+	if len(values) > len(f.segments) {
+		panic(fmt.Sprintf("compositeid: %d values are given to the format %s", len(values), f))
+	}
+	for len(values) > f.required && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+	return Join(values...)
+}
+
+// Parse parses id, and returns an error naming the expected format when it does not match.
+func (f *Format) Parse(id string) (*ID, error) {
+	values := Split(id)
+	if len(values) < f.required || len(values) > len(f.segments) {
+		return nil, f.errorf(id, "it has %d segments", len(values))
+	}
+	for i, value := range values {
+		s := f.segments[i]
+		if value == "" && !s.Optional {
+			return nil, f.errorf(id, "%s is empty", s.Name)
+		}
+		if value != "" && s.Type == TypeInt {
+			if _, err := strconv.Atoi(value); err != nil {
+				return nil, f.errorf(id, "%s is not an integer", s.Name)
+			}
+		}
+	}
+	return &ID{format: f, values: values}, nil
+}
+
+func (f *Format) errorf(id, reason string, args ...interface{}) error {
+	return fmt.Errorf("id %s is broken, %s, it should be in the format of %s", id, fmt.Sprintf(reason, args...), f)
+}
+
+// ImportState is the importer state function of a resource whose import id is in the format.
+// Each segment is set to the argument of its name, except the segment named `id`, which becomes
+// the id of the resource. Without an `id` segment, the id is the normalized import id.
+func (f *Format) ImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id, err := f.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := id.String()
+	for _, s := range f.segments {
+		if !id.Has(s.Name) {
+			continue
+		}
+		if s.Name == "id" {
+			resourceId = id.Get(s.Name)
+			continue
+		}
+
+		var value interface{} = id.Get(s.Name)
+		if s.Type == TypeInt {
+			value = id.Int(s.Name)
+		}
+		if err := d.Set(s.Name, value); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(resourceId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// ImportStatePassthrough is the importer state function of a resource whose id is in the format and
+// whose Read sets the arguments from it. It only checks the import id and normalizes it.
+func (f *Format) ImportStatePassthrough(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id, err := f.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+func (f *Format) index(name string) int {
+	for i, s := range f.segments {
+		if s.Name == name {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("compositeid: segment %s is not in the format %s", name, f))
+}
+
+// ID is a parsed composite id.
+type ID struct {
+	format *Format
+	values []string
+}
+
+// Has reports whether the segment of name is given in the id.
+func (id *ID) Has(name string) bool {
+	i := id.format.index(name)
+	return i < len(id.values) && id.values[i] != ""
+}
+
+// Get returns the value of the segment of name, and an empty string when it is left out.
+func (id *ID) Get(name string) string {
+	i := id.format.index(name)
+	if i >= len(id.values) {
+		return ""
+	}
+	return id.values[i]
+}
+
+// Int returns the value of the integer segment of name, and 0 when it is left out.
+func (id *ID) Int(name string) int {
+	value, _ := strconv.Atoi(id.Get(name))
+	return value
+}
+
+// String returns the normalized id.
+func (id *ID) String() string {
+	return id.format.Join(id.values...)
+}
+
+// Join escapes values and joins them with "#".
+func Join(values ...string) string {
+	var b strings.Builder
+	for i, value := range values {
+		if i > 0 {
+			b.WriteByte(separator)
+		}
+		for j := 0; j < len(value); j++ {
+			if value[j] == separator || value[j] == escape {
+				b.WriteByte(escape)
+			}
+			b.WriteByte(value[j])
+		}
+	}
+	return b.String()
+}
+
+// Split splits id on the unescaped "#", and unescapes the values. A "\" which escapes neither "#"
+// nor "\" is kept as it is, so the ids built before escaping are split the same as strings.Split.
+func Split(id string) []string {
+	values := make([]string, 0, strings.Count(id, string(separator))+1)
+	var b strings.Builder
+	for i := 0; i < len(id); i++ {
+		switch c := id[i]; {
+		case c == escape && i+1 < len(id) && (id[i+1] == separator || id[i+1] == escape):
+			b.WriteByte(id[i+1])
+			i++
+		case c == separator:
+			values = append(values, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(values, b.String())
+}
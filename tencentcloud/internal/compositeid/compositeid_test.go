@@ -0,0 +1,164 @@
+package compositeid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinSplitRoundTrip(t *testing.T) {
+	roundTrip := func(first string, rest []string) bool {
+		values := append([]string{first}, rest...)
+		return reflect.DeepEqual(values, Split(Join(values...)))
+	}
+	assert.NoError(t, quick.Check(roundTrip, nil))
+
+	// values made of the special characters only, which random strings rarely hit.
+	special := func(first []bool, rest [][]bool) bool {
+		values := []string{specialString(first)}
+		for _, r := range rest {
+			values = append(values, specialString(r))
+		}
+		return reflect.DeepEqual(values, Split(Join(values...)))
+	}
+	assert.NoError(t, quick.Check(special, nil))
+}
+
+func specialString(bits []bool) string {
+	var b strings.Builder
+	for _, bit := range bits {
+		if bit {
+			b.WriteByte('#')
+		} else {
+			b.WriteByte('\\')
+		}
+	}
+	return b.String()
+}
+
+func TestSplitLegacy(t *testing.T) {
+	for _, id := range []string{"cdb-1#root#%", "ccn-1#ap-guangzhou", "a\\b#c", "#", "plain"} {
+		assert.Equal(t, strings.Split(id, "#"), Split(id), id)
+	}
+	assert.Equal(t, "cdb-1#root#%", Join("cdb-1", "root", "%"))
+	assert.Equal(t, []string{"a#b", "c\\"}, Split(`a\#b#c\\`))
+}
+
+func TestFormat(t *testing.T) {
+	f := New(String("ccn_id"), String("region"), Optional(String("dst_region")))
+	assert.Equal(t, "<ccn_id>#<region>[#<dst_region>]", f.String())
+	assert.Equal(t, "ccn-1#ap-guangzhou", f.Join("ccn-1", "ap-guangzhou", ""))
+
+	id, err := f.Parse("ccn-1#ap-guangzhou")
+	assert.NoError(t, err)
+	assert.Equal(t, "ccn-1", id.Get("ccn_id"))
+	assert.False(t, id.Has("dst_region"))
+	assert.Equal(t, "", id.Get("dst_region"))
+
+	id, err = f.Parse("ccn-1#ap-guangzhou#ap-beijing")
+	assert.NoError(t, err)
+	assert.True(t, id.Has("dst_region"))
+	assert.Equal(t, "ap-beijing", id.Get("dst_region"))
+
+	_, err = f.Parse("ccn-1")
+	assert.EqualError(t, err, "id ccn-1 is broken, it has 1 segments, it should be in the format of <ccn_id>#<region>[#<dst_region>]")
+	_, err = f.Parse("ccn-1#a#b#c")
+	assert.Error(t, err)
+	_, err = f.Parse("#ap-guangzhou")
+	assert.EqualError(t, err, "id #ap-guangzhou is broken, ccn_id is empty, it should be in the format of <ccn_id>#<region>[#<dst_region>]")
+
+	ports := New(String("listener_id"), Int("port"))
+	id, err = ports.Parse("lbl-1#80")
+	assert.NoError(t, err)
+	assert.Equal(t, 80, id.Int("port"))
+	_, err = ports.Parse("lbl-1#http")
+	assert.EqualError(t, err, "id lbl-1#http is broken, port is not an integer, it should be in the format of <listener_id>#<port>")
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	f := New(String("a"), String("b"), Optional(String("c")))
+	roundTrip := func(a, b, c string) bool {
+		if a == "" || b == "" {
+			return true
+		}
+		id, err := f.Parse(f.Join(a, b, c))
+		return err == nil && id.Get("a") == a && id.Get("b") == b && id.Get("c") == c
+	}
+	assert.NoError(t, quick.Check(roundTrip, nil))
+}
+
+func TestNewPanics(t *testing.T) {
+	assert.Panics(t, func() { New(String("a"), String("a")) })
+	assert.Panics(t, func() { New(Optional(String("a")), String("b")) })
+	assert.Panics(t, func() { New(String("a")).Join("1", "2") })
+}
+
+func TestImportState(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service_id": {Type: schema.TypeString, Required: true},
+			"port":       {Type: schema.TypeInt, Optional: true},
+		},
+	}
+
+	f := New(String("service_id"), String("id"), Optional(Int("port")))
+	d := res.Data(nil)
+	d.SetId("service-1#api-1#8080")
+	imported, err := f.ImportState(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "api-1", imported[0].Id())
+	assert.Equal(t, "service-1", imported[0].Get("service_id"))
+	assert.Equal(t, 8080, imported[0].Get("port"))
+
+	d = res.Data(nil)
+	d.SetId("service-1")
+	_, err = f.ImportState(d, nil)
+	assert.EqualError(t, err, "id service-1 is broken, it has 1 segments, it should be in the format of <service_id>#<id>[#<port>]")
+
+	f = New(String("service_id"), Optional(Int("port")))
+	d = res.Data(nil)
+	d.SetId("service-1#")
+	imported, err = f.ImportState(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "service-1", imported[0].Id())
+}
+
+func TestSingleImportState(t *testing.T) {
+	res := &schema.Resource{Schema: map[string]*schema.Schema{}}
+
+	d := res.Data(nil)
+	d.SetId("lane-1")
+	imported, err := Single.ImportState(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "lane-1", imported[0].Id())
+
+	d = res.Data(nil)
+	d.SetId("cluster-1#lane-1")
+	_, err = Single.ImportState(d, nil)
+	assert.EqualError(t, err, "id cluster-1#lane-1 is broken, it has 2 segments, it should be in the format of <id>")
+}
+
+func TestImportStatePassthrough(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	f := New(String("bucket"), String("template_id"))
+	d := res.Data(nil)
+	d.SetId("bucket-1#template-1")
+	imported, err := f.ImportStatePassthrough(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket-1#template-1", imported[0].Id())
+	assert.Equal(t, "", imported[0].Get("bucket"))
+
+	d = res.Data(nil)
+	d.SetId("bucket-1#")
+	_, err = f.ImportStatePassthrough(d, nil)
+	assert.EqualError(t, err, "id bucket-1# is broken, template_id is empty, it should be in the format of <bucket>#<template_id>")
+}
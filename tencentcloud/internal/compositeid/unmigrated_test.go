@@ -0,0 +1,69 @@
+package compositeid
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// splitByHandRegexp matches the ids split without a Format.
+var splitByHandRegexp = regexp.MustCompile(`strings\.Split\(d\.Id\(\)|helper\.IdParse\(`)
+
+// TestUnmigrated checks that testdata/unmigrated.txt lists exactly the files which still split the
+// ids of their resources by hand, so that new resources declare a Format and the list only shrinks.
+func TestUnmigrated(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "unmigrated.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed := strings.Fields(string(b))
+	assert.True(t, sort.StringsAreSorted(listed), "testdata/unmigrated.txt is not sorted")
+
+	root := filepath.Join("..", "..")
+	var found []string
+	err = filepath.WalkDir(root, func(path string, e fs.DirEntry, err error) error {
+		if err != nil || e.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if splitByHandRegexp.Match(src) {
+			rel, _ := filepath.Rel(root, path)
+			found = append(found, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range difference(found, listed) {
+		t.Errorf("%s splits the id by hand, declare a Format of it instead", f)
+	}
+	for _, f := range difference(listed, found) {
+		t.Errorf("%s no longer splits the id by hand, remove it from testdata/unmigrated.txt", f)
+	}
+}
+
+// difference returns the elements of a which are not in b.
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, s := range b {
+		in[s] = true
+	}
+	var d []string
+	for _, s := range a {
+		if !in[s] {
+			d = append(d, s)
+		}
+	}
+	return d
+}
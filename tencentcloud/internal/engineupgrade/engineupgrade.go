@@ -0,0 +1,73 @@
+// Package engineupgrade checks engine version upgrade paths and schedules the switch of an upgraded
+// database instance to the new version in a daily window.
+package engineupgrade
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// WindowRegexp matches a daily window `HH:mm-HH:mm`.
+var WindowRegexp = regexp.MustCompile(`^([01]\d|2[0-3]):([0-5]\d)-([01]\d|2[0-3]):([0-5]\d)$`)
+
+// CheckPath rejects downgrades and upgrades skipping a major version, versions being the supported
+// versions in ascending order. Versions not in it are left to the API to check.
+func CheckPath(versions []string, oldVersion, newVersion string) error {
+	oldIndex, newIndex := -1, -1
+	for i, version := range versions {
+		if version == oldVersion {
+			oldIndex = i
+		}
+		if version == newVersion {
+			newIndex = i
+		}
+	}
+
+	if oldIndex < 0 || newIndex < 0 {
+		return nil
+	}
+	if newIndex < oldIndex {
+		return fmt.Errorf("`engine_version` cannot be downgraded from %s to %s", oldVersion, newVersion)
+	}
+	if newIndex > oldIndex+1 {
+		return fmt.Errorf("`engine_version` cannot be upgraded from %s to %s directly, upgrade to %s first", oldVersion, newVersion, versions[oldIndex+1])
+	}
+	return nil
+}
+
+// WindowWait returns how long to wait from now until the daily window `HH:mm-HH:mm` in loc opens,
+// zero if now is inside the window. A window ending before its start spans midnight.
+func WindowWait(window string, loc *time.Location, now time.Time) (time.Duration, error) {
+	matches := WindowRegexp.FindStringSubmatch(window)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid upgrade window %q, expect `HH:mm-HH:mm`", window)
+	}
+
+	minutes := func(hour, minute string) int {
+		h, _ := strconv.Atoi(hour)
+		m, _ := strconv.Atoi(minute)
+		return h*60 + m
+	}
+
+	var (
+		start   = minutes(matches[1], matches[2])
+		end     = minutes(matches[3], matches[4])
+		local   = now.In(loc)
+		current = local.Hour()*60 + local.Minute()
+	)
+
+	inside := false
+	if start <= end {
+		inside = current >= start && current < end
+	} else {
+		inside = current >= start || current < end
+	}
+	if inside {
+		return 0, nil
+	}
+
+	wait := time.Duration((start-current+24*60)%(24*60)) * time.Minute
+	return wait - time.Duration(local.Second())*time.Second, nil
+}
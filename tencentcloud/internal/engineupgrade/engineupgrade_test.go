@@ -0,0 +1,48 @@
+package engineupgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var versions = []string{"5.5", "5.6", "5.7", "8.0"}
+
+func TestCheckPath(t *testing.T) {
+	assert.NoError(t, CheckPath(versions, "5.7", "5.7"))
+	assert.NoError(t, CheckPath(versions, "5.7", "8.0"))
+	assert.NoError(t, CheckPath(versions, "5.7", "9.0"))
+	assert.EqualError(t, CheckPath(versions, "8.0", "5.7"), "`engine_version` cannot be downgraded from 8.0 to 5.7")
+	assert.EqualError(t, CheckPath(versions, "5.6", "8.0"), "`engine_version` cannot be upgraded from 5.6 to 8.0 directly, upgrade to 5.7 first")
+}
+
+func TestWindowWait(t *testing.T) {
+	beijing := time.FixedZone("UTC+8", 8*3600)
+	at := func(hour, minute, second int) time.Time {
+		// the same instant in UTC, to check that the window is in loc.
+		return time.Date(2024, 5, 1, hour, minute, second, 0, beijing).UTC()
+	}
+
+	cases := []struct {
+		window string
+		now    time.Time
+		wait   time.Duration
+	}{
+		{"02:00-04:00", at(3, 0, 0), 0},
+		{"02:00-04:00", at(1, 30, 0), 30 * time.Minute},
+		{"02:00-04:00", at(1, 30, 20), 29*time.Minute + 40*time.Second},
+		{"02:00-04:00", at(4, 0, 0), 22 * time.Hour},
+		{"23:00-01:00", at(0, 30, 0), 0},
+		{"23:00-01:00", at(23, 0, 0), 0},
+		{"23:00-01:00", at(1, 0, 0), 22 * time.Hour},
+	}
+	for _, c := range cases {
+		wait, err := WindowWait(c.window, beijing, c.now)
+		assert.NoError(t, err)
+		assert.Equal(t, c.wait, wait, "%s at %s", c.window, c.now.In(beijing).Format("15:04:05"))
+	}
+
+	_, err := WindowWait("2:00-4:00", beijing, at(0, 0, 0))
+	assert.EqualError(t, err, "invalid upgrade window \"2:00-4:00\", expect `HH:mm-HH:mm`")
+}
@@ -92,6 +92,13 @@ func PInt64(pointer *int64) int64 {
 	return *pointer
 }
 
+func PFloat64(pointer *float64) float64 {
+	if pointer == nil {
+		return 0
+	}
+	return *pointer
+}
+
 func PStrings(strs []*string) []string {
 	if len(strs) == 0 {
 		return nil
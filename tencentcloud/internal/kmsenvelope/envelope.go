@@ -0,0 +1,101 @@
+// Package kmsenvelope implements envelope encryption for payloads over the KMS size limit.
+//
+// The payload is encrypted locally with AES-GCM under a data key generated by KMS, and the
+// envelope keeps the data key encrypted by KMS along with the encrypted payload. The envelope is
+// base64 encoded like a KMS ciphertext blob, and is told apart from one by its magic prefix.
+package kmsenvelope
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	magic   = "tckms-envelope\n"
+	version = 1
+)
+
+// Envelope is a payload encrypted with a data key.
+type Envelope struct {
+	Version int `json:"v"`
+	// EncryptedDataKey is the KMS ciphertext blob of the data key.
+	EncryptedDataKey string `json:"k"`
+	Nonce            []byte `json:"n"`
+	Ciphertext       []byte `json:"c"`
+}
+
+// Seal encrypts plaintext with dataKey, authenticating aad, and returns the base64 encoded envelope.
+func Seal(dataKey []byte, encryptedDataKey string, plaintext, aad []byte) (string, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(&Envelope{
+		Version:          version,
+		EncryptedDataKey: encryptedDataKey,
+		Nonce:            nonce,
+		Ciphertext:       aead.Seal(nil, nonce, plaintext, aad),
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(append([]byte(magic), body...)), nil
+}
+
+// Parse decodes blob, and reports whether it is an envelope rather than a KMS ciphertext blob.
+func Parse(blob string) (*Envelope, bool, error) {
+	raw, err := base64.StdEncoding.DecodeString(blob)
+	if err != nil || !bytes.HasPrefix(raw, []byte(magic)) {
+		return nil, false, nil
+	}
+
+	envelope := &Envelope{}
+	if err := json.Unmarshal(raw[len(magic):], envelope); err != nil {
+		return nil, true, fmt.Errorf("invalid envelope: %v", err)
+	}
+	if envelope.Version != version {
+		return nil, true, fmt.Errorf("unsupported envelope version %d", envelope.Version)
+	}
+	if envelope.EncryptedDataKey == "" {
+		return nil, true, errors.New("invalid envelope: missing data key")
+	}
+	return envelope, true, nil
+}
+
+// Open decrypts the payload with dataKey, the plaintext of EncryptedDataKey.
+func (e *Envelope) Open(dataKey, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid envelope: bad nonce size")
+	}
+	plaintext, err := aead.Open(nil, e.Nonce, e.Ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("decrypt envelope failed, the data key or the encryption context does not match")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != 32 {
+		return nil, fmt.Errorf("data key must be 32 bytes, got %d", len(dataKey))
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,59 @@
+package kmsenvelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealOpen(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{7}, 32)
+	plaintext := bytes.Repeat([]byte("secret"), 2000)
+	aad := []byte(`{"app":"web"}`)
+
+	blob, err := Seal(dataKey, "encrypted-data-key", plaintext, aad)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	envelope, ok, err := Parse(blob)
+	assert.True(t, ok)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "encrypted-data-key", envelope.EncryptedDataKey)
+
+	opened, err := envelope.Open(dataKey, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, opened)
+
+	_, err = envelope.Open(dataKey, []byte(`{"app":"db"}`))
+	assert.EqualError(t, err, "decrypt envelope failed, the data key or the encryption context does not match")
+
+	_, err = envelope.Open(bytes.Repeat([]byte{8}, 32), aad)
+	assert.Error(t, err)
+
+	_, err = envelope.Open([]byte("short"), aad)
+	assert.EqualError(t, err, "data key must be 32 bytes, got 5")
+}
+
+func TestParse(t *testing.T) {
+	// a KMS ciphertext blob is not an envelope.
+	_, ok, err := Parse(base64.StdEncoding.EncodeToString([]byte{0x01, 0x02, 0x03}))
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	_, ok, err = Parse("not base64 !")
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	_, ok, err = Parse(base64.StdEncoding.EncodeToString([]byte(magic + "{")))
+	assert.True(t, ok)
+	assert.Error(t, err)
+
+	_, ok, err = Parse(base64.StdEncoding.EncodeToString([]byte(magic + `{"v":2,"k":"x"}`)))
+	assert.True(t, ok)
+	assert.EqualError(t, err, "unsupported envelope version 2")
+}
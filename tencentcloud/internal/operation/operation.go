@@ -0,0 +1,199 @@
+// Package operation runs one-off actions, such as restarting an instance or rolling back a disk,
+// as resources.
+//
+// An operation resource runs its action when it is created and does nothing when it is destroyed.
+// Resource adds the attributes shared by all of them:
+//
+//   - triggers, any change of which replaces the resource and thus runs the action again;
+//   - last_run_at, the time the action last ran;
+//   - request_id, task_id and result, recorded by SetResponse from the response of the action.
+//
+// The action and its wait share the create timeout of the resource, DefaultTimeout unless declared:
+// the action is given a context which expires with the timeout when it is a CreateContext, and
+// reads it by d.Timeout(schema.TimeoutCreate) for its own waits otherwise. An action which starts
+// an async task returns once the task is started, and AsyncResource waits for the task within what
+// is left of the timeout, unless `wait` is set to false.
+package operation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+// DefaultTimeout is the create timeout of the operations which do not declare one.
+const DefaultTimeout = 20 * time.Minute
+
+// taskIdKeys are the fields of the responses recorded as the task id, in the order of precedence.
+var taskIdKeys = []string{"TaskId", "FlowId", "JobId", "AsyncRequestId"}
+
+// Waiter reports whether the async task of the operation d has finished, by returning nil, or is
+// still running, by returning a retryable error.
+type Waiter func(ctx context.Context, d *schema.ResourceData, meta interface{}) *resource.RetryError
+
+// Resource returns r with the attributes of an operation, whose action is the Create of r.
+func Resource(r *schema.Resource) *schema.Resource {
+	return build(r, nil)
+}
+
+// AsyncResource returns r with the attributes of an operation, whose action is the Create of r,
+// followed by polling wait until the async task of the action finishes.
+func AsyncResource(r *schema.Resource, wait Waiter) *schema.Resource {
+	return build(r, wait)
+}
+
+func build(r *schema.Resource, wait Waiter) *schema.Resource {
+	if r.Schema == nil {
+		r.Schema = make(map[string]*schema.Schema)
+	}
+	for name, s := range attributes(wait != nil) {
+		if _, ok := r.Schema[name]; ok {
+			// a recorded attribute may be declared by the resource, such as task_id of the
+			// operation which manages the task of the id, and is kept then.
+			if s.Computed && name != "last_run_at" {
+				continue
+			}
+			panic(fmt.Sprintf("attribute %s of operations is declared by the resource", name))
+		}
+		r.Schema[name] = s
+	}
+
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{}
+	}
+	if r.Timeouts.Create == nil {
+		r.Timeouts.Create = schema.DefaultTimeout(DefaultTimeout)
+	}
+
+	create, createContext := r.Create, r.CreateContext
+	r.Create = nil
+	// the context of CreateContext expires with the create timeout of d.
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		start := time.Now()
+		ctx = context.WithValue(ctx, tccommon.LogIdKey, tccommon.GetLogId(tccommon.ContextNil))
+		if createContext != nil {
+			if diags := createContext(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+		} else if err := create(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("last_run_at", start.UTC().Format(time.RFC3339))
+
+		if wait == nil || !waits(d) {
+			return nil
+		}
+		timeout := d.Timeout(schema.TimeoutCreate) - time.Since(start)
+		return diag.FromErr(resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			return wait(ctx, d, meta)
+		}))
+	}
+
+	if r.Delete == nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		}
+	}
+	// `wait` is the only attribute which changes without running the action again.
+	if wait != nil && r.Update == nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		}
+	}
+	return r
+}
+
+func attributes(async bool) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary map of values which, when changed, runs the operation again.",
+		},
+		"last_run_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time the operation last ran, in RFC3339 format.",
+		},
+		"request_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The request ID of the last run of the operation.",
+		},
+		"task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the async task started by the last run of the operation, if any.",
+		},
+		"result": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The response of the last run of the operation, in JSON format.",
+		},
+	}
+	if async {
+		// wait is computed rather than defaulted, so that the states of the operations created
+		// before it was added, which have no wait, have no diff either.
+		result["wait"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to wait for the async task of the operation to finish. Default is `true`.",
+		}
+	}
+	return result
+}
+
+// waits reports whether the async operation d waits for its task, which it does unless wait is
+// set to false, and records it in wait.
+func waits(d *schema.ResourceData) bool {
+	wait := true
+	if v, ok := d.GetOkExists("wait"); ok {
+		wait = v.(bool)
+	}
+	_ = d.Set("wait", wait)
+	return wait
+}
+
+// SetResponse records the request id, the task id and the result of the operation d from response,
+// which is the response of any API of tencentcloud-sdk-go. It must not be called by the operations
+// which declare any of these attributes themselves.
+func SetResponse(d *schema.ResourceData, response interface{ ToJsonString() string }) {
+	var body struct {
+		Response map[string]interface{}
+	}
+	decoder := json.NewDecoder(bytes.NewBufferString(response.ToJsonString()))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil || body.Response == nil {
+		return
+	}
+
+	if requestId, ok := body.Response["RequestId"].(string); ok {
+		_ = d.Set("request_id", requestId)
+		delete(body.Response, "RequestId")
+	}
+	taskId := ""
+	for _, key := range taskIdKeys {
+		if v, ok := body.Response[key]; ok && v != nil {
+			taskId = fmt.Sprint(v)
+			break
+		}
+	}
+	_ = d.Set("task_id", taskId)
+
+	result, err := json.Marshal(body.Response)
+	if err != nil {
+		return
+	}
+	_ = d.Set("result", string(result))
+}
@@ -0,0 +1,173 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResponse string
+
+func (r fakeResponse) ToJsonString() string {
+	return string(r)
+}
+
+func fakeOperation(create schema.CreateFunc) *schema.Resource {
+	return &schema.Resource{
+		Create: create,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func TestResource(t *testing.T) {
+	r := Resource(fakeOperation(func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId(d.Get("instance_id").(string))
+		SetResponse(d, fakeResponse(`{"Response": {"FlowId": 12345678901, "RequestId": "req-1"}}`))
+		return nil
+	}))
+	assert.NoError(t, r.InternalValidate(nil, true))
+	assert.True(t, r.Schema["triggers"].ForceNew)
+	assert.NotContains(t, r.Schema, "wait")
+	assert.Equal(t, DefaultTimeout, *r.Timeouts.Create)
+	assert.Nil(t, r.Create)
+	assert.NotNil(t, r.Delete)
+
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("instance_id", "crs-1"))
+	before := time.Now().UTC().Truncate(time.Second)
+	assert.False(t, r.CreateContext(context.Background(), d, nil).HasError())
+
+	assert.Equal(t, "crs-1", d.Id())
+	lastRunAt, err := time.Parse(time.RFC3339, d.Get("last_run_at").(string))
+	assert.NoError(t, err)
+	assert.False(t, lastRunAt.Before(before))
+	assert.Equal(t, "req-1", d.Get("request_id"))
+	assert.Equal(t, "12345678901", d.Get("task_id"))
+	assert.JSONEq(t, `{"FlowId": 12345678901}`, d.Get("result").(string))
+
+	failed := Resource(fakeOperation(func(d *schema.ResourceData, meta interface{}) error {
+		return fmt.Errorf("instance is busy")
+	}))
+	d = failed.TestResourceData()
+	assert.Equal(t, "instance is busy", failed.CreateContext(context.Background(), d, nil)[0].Summary)
+	assert.Equal(t, "", d.Get("last_run_at"))
+
+	assert.Panics(t, func() {
+		Resource(&schema.Resource{Schema: map[string]*schema.Schema{"triggers": {Type: schema.TypeString, Optional: true}}})
+	})
+	own := &schema.Schema{Type: schema.TypeString, Required: true, ForceNew: true}
+	r = Resource(&schema.Resource{Schema: map[string]*schema.Schema{"task_id": own}})
+	assert.Same(t, own, r.Schema["task_id"])
+	assert.Contains(t, r.Schema, "request_id")
+}
+
+func TestAsyncResource(t *testing.T) {
+	polls := 0
+	r := AsyncResource(fakeOperation(func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId("crs-1")
+		SetResponse(d, fakeResponse(`{"Response": {"TaskId": 7, "RequestId": "req-2"}}`))
+		return nil
+	}), func(ctx context.Context, d *schema.ResourceData, meta interface{}) *resource.RetryError {
+		assert.Equal(t, "7", d.Get("task_id"))
+		polls++
+		if polls < 2 {
+			return resource.RetryableError(fmt.Errorf("task %s is running", d.Get("task_id")))
+		}
+		return nil
+	})
+	assert.NoError(t, r.InternalValidate(nil, true))
+	assert.Nil(t, r.Schema["wait"].Default)
+	assert.True(t, r.Schema["wait"].Computed)
+	assert.NotNil(t, r.Update)
+
+	// an unset wait waits, as the states without wait do.
+	d := r.TestResourceData()
+	assert.False(t, r.CreateContext(context.Background(), d, nil).HasError())
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, true, d.Get("wait"))
+
+	polls = 0
+	d = r.TestResourceData()
+	assert.NoError(t, d.Set("wait", false))
+	assert.False(t, r.CreateContext(context.Background(), d, nil).HasError())
+	assert.Equal(t, 0, polls)
+	assert.Equal(t, false, d.Get("wait"))
+
+	failed := AsyncResource(fakeOperation(func(d *schema.ResourceData, meta interface{}) error {
+		return nil
+	}), func(ctx context.Context, d *schema.ResourceData, meta interface{}) *resource.RetryError {
+		return resource.NonRetryableError(fmt.Errorf("task failed"))
+	})
+	d = failed.TestResourceData()
+	assert.NoError(t, d.Set("wait", true))
+	assert.Equal(t, "task failed", failed.CreateContext(context.Background(), d, nil)[0].Summary)
+}
+
+func TestAsyncResourceTimeout(t *testing.T) {
+	var deadline time.Time
+	r := AsyncResource(&schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			deadline, _ = ctx.Deadline()
+			d.SetId("crs-1")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}, func(ctx context.Context, d *schema.ResourceData, meta interface{}) *resource.RetryError {
+		return resource.RetryableError(fmt.Errorf("task is running"))
+	})
+	assert.NoError(t, r.InternalValidate(nil, true))
+
+	// the context passed by the SDK expires with the create timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	d := r.TestResourceData()
+	assert.NoError(t, d.Set("wait", true))
+	diags := r.CreateContext(ctx, d, nil)
+	assert.True(t, diags.HasError())
+	want, _ := ctx.Deadline()
+	assert.Equal(t, want, deadline)
+	assert.Equal(t, "crs-1", d.Id())
+}
+
+func TestSetResponse(t *testing.T) {
+	r := Resource(fakeOperation(nil))
+	cases := []struct {
+		response  string
+		requestId string
+		taskId    string
+		result    string
+	}{
+		{`{"Response": {"RequestId": "req-1"}}`, "req-1", "", `{}`},
+		{`{"Response": {"JobId": "job-1", "FlowId": 3, "RequestId": "req-2"}}`, "req-2", "3", `{"FlowId": 3, "JobId": "job-1"}`},
+		{`{"Response": {"TaskId": null, "AsyncRequestId": "async-1", "RequestId": "req-3"}}`, "req-3", "async-1", `{"TaskId": null, "AsyncRequestId": "async-1"}`},
+		{`not json`, "", "", ""},
+	}
+	for _, c := range cases {
+		d := r.TestResourceData()
+		SetResponse(d, fakeResponse(c.response))
+		assert.Equal(t, c.requestId, d.Get("request_id"), c.response)
+		assert.Equal(t, c.taskId, d.Get("task_id"), c.response)
+		if c.result == "" {
+			assert.Equal(t, "", d.Get("result"), c.response)
+		} else {
+			assert.JSONEq(t, c.result, d.Get("result").(string), c.response)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Package price estimates the prices of resources over a period from the prices returned by the
+// Inquiry*Price APIs of the products. The prepaid resources are priced by the APIs for their whole
+// subscription, and the postpaid ones per charge unit, such as per hour, possibly in steps by the
+// hours used.
+package price
+
+import "math"
+
+// HoursPerMonth is the hours of a month a postpaid resource is estimated to run.
+const HoursPerMonth = 730
+
+// ChargeUnitHour is the charge unit of the resources postpaid by hour. The resources postpaid by
+// other charge units, such as by GB of traffic, are priced by usage and estimated at zero.
+const ChargeUnitHour = "HOUR"
+
+const (
+	// secondStepHours and thirdStepHours are the hours used after which the second and the third
+	// steps of a stepped unit price apply.
+	secondStepHours = 96
+	thirdStepHours  = 360
+)
+
+// Price is the price of a resource returned by an Inquiry*Price API, in the currency of the
+// account.
+type Price struct {
+	Prepaid bool
+	// OriginalPrice and DiscountPrice are the prices of a prepaid resource for its subscription.
+	OriginalPrice float64
+	DiscountPrice float64
+	// UnitPrice and UnitPriceDiscount are the prices of a postpaid resource per ChargeUnit, the
+	// price of the first step if the unit price is stepped.
+	UnitPrice         float64
+	UnitPriceDiscount float64
+	ChargeUnit        string
+	// UnitPriceSteps and UnitPriceDiscountSteps are the unit prices of the second and the third
+	// steps of a stepped unit price, empty if it is not stepped.
+	UnitPriceSteps         []float64
+	UnitPriceDiscountSteps []float64
+}
+
+// Estimate returns the original and the discounted prices of p over months, rounded to cents.
+func (p Price) Estimate(months int) (original, discount float64) {
+	if p.Prepaid {
+		return Round(p.OriginalPrice), Round(p.DiscountPrice)
+	}
+	if p.ChargeUnit != "" && p.ChargeUnit != ChargeUnitHour {
+		return 0, 0
+	}
+
+	hours := float64(months * HoursPerMonth)
+	return Round(steppedPrice(p.UnitPrice, p.UnitPriceSteps, hours)), Round(steppedPrice(p.UnitPriceDiscount, p.UnitPriceDiscountSteps, hours))
+}
+
+// steppedPrice returns the price of hours at unitPrice for the first step and steps for the rest.
+func steppedPrice(unitPrice float64, steps []float64, hours float64) float64 {
+	if len(steps) == 0 {
+		return unitPrice * hours
+	}
+
+	total := unitPrice * math.Min(hours, secondStepHours)
+	if len(steps) == 1 {
+		return total + steps[0]*math.Max(hours-secondStepHours, 0)
+	}
+	total += steps[0] * math.Max(math.Min(hours, thirdStepHours)-secondStepHours, 0)
+	return total + steps[1]*math.Max(hours-thirdStepHours, 0)
+}
+
+// Round rounds v to cents.
+func Round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// FromCents converts the cents returned by some of the APIs to the currency unit.
+func FromCents(cents float64) float64 {
+	return cents / 100
+}
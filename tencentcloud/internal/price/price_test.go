@@ -0,0 +1,80 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	cases := []struct {
+		name             string
+		price            Price
+		months           int
+		expectedOriginal float64
+		expectedDiscount float64
+	}{
+		{
+			name:             "prepaid",
+			price:            Price{Prepaid: true, OriginalPrice: 1234.567, DiscountPrice: 987.654},
+			months:           3,
+			expectedOriginal: 1234.57,
+			expectedDiscount: 987.65,
+		},
+		{
+			name:             "postpaid by hour",
+			price:            Price{UnitPrice: 0.5, UnitPriceDiscount: 0.4, ChargeUnit: ChargeUnitHour},
+			months:           2,
+			expectedOriginal: 730,
+			expectedDiscount: 584,
+		},
+		{
+			name:             "postpaid without charge unit",
+			price:            Price{UnitPrice: 0.1, UnitPriceDiscount: 0.1},
+			months:           1,
+			expectedOriginal: 73,
+			expectedDiscount: 73,
+		},
+		{
+			name: "postpaid by hour in two steps",
+			price: Price{
+				UnitPrice: 1, UnitPriceDiscount: 1, ChargeUnit: ChargeUnitHour,
+				UnitPriceSteps: []float64{0.5}, UnitPriceDiscountSteps: []float64{0.25},
+			},
+			months:           1,
+			expectedOriginal: 96 + 0.5*634,
+			expectedDiscount: 96 + 0.25*634,
+		},
+		{
+			name: "postpaid by hour in three steps",
+			price: Price{
+				UnitPrice: 1, UnitPriceDiscount: 0.8, ChargeUnit: ChargeUnitHour,
+				UnitPriceSteps: []float64{0.5, 0.2}, UnitPriceDiscountSteps: []float64{0.4, 0.1},
+			},
+			months:           1,
+			expectedOriginal: 96 + 0.5*264 + 0.2*370,
+			expectedDiscount: 0.8*96 + 0.4*264 + 0.1*370,
+		},
+		{
+			name:             "postpaid by traffic",
+			price:            Price{UnitPrice: 0.8, UnitPriceDiscount: 0.8, ChargeUnit: "GB"},
+			months:           1,
+			expectedOriginal: 0,
+			expectedDiscount: 0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			original, discount := c.price.Estimate(c.months)
+			assert.Equal(t, Round(c.expectedOriginal), original)
+			assert.Equal(t, Round(c.expectedDiscount), discount)
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, 0.3, Round(0.1+0.2))
+	assert.Equal(t, 12.35, Round(12.345001))
+	assert.Equal(t, 1.5, FromCents(150))
+}
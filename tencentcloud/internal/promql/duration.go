@@ -0,0 +1,69 @@
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationRegexp = regexp.MustCompile(`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`)
+
+var durationUnits = []struct {
+	unit string
+	size time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+}
+
+// ParseDuration parses a Prometheus duration such as `1h30m`, units must be given from the largest to the smallest.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "0" {
+		return 0, nil
+	}
+	matches := durationRegexp.FindStringSubmatch(s)
+	if s == "" || matches == nil {
+		return 0, fmt.Errorf("not a valid duration string: %q", s)
+	}
+
+	var d time.Duration
+	for i, unit := range durationUnits {
+		value := matches[2*i+2]
+		if value == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || time.Duration(n) > (1<<63-1)/unit.size {
+			return 0, fmt.Errorf("duration out of range: %q", s)
+		}
+		d += time.Duration(n) * unit.size
+		if d < 0 {
+			return 0, fmt.Errorf("duration out of range: %q", s)
+		}
+	}
+	return d, nil
+}
+
+// FormatDuration formats d the way Prometheus does, e.g. `1h30m`, so that equal durations compare equal.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var b strings.Builder
+	for _, unit := range durationUnits {
+		if n := d / unit.size; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10))
+			b.WriteString(unit.unit)
+			d -= n * unit.size
+		}
+	}
+	return b.String()
+}
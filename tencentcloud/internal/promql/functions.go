@@ -0,0 +1,135 @@
+package promql
+
+// function describes the signature of a PromQL function.
+type function struct {
+	argTypes []ValueType
+	// variadic is the number of trailing optional arguments, -1 means the last argument repeats.
+	variadic   int
+	returnType ValueType
+}
+
+func (f function) minArgs() int {
+	switch {
+	case f.variadic > 0:
+		return len(f.argTypes) - f.variadic
+	case f.variadic < 0:
+		return len(f.argTypes) - 1
+	}
+	return len(f.argTypes)
+}
+
+func (f function) argType(i int) ValueType {
+	if i >= len(f.argTypes) {
+		return f.argTypes[len(f.argTypes)-1]
+	}
+	return f.argTypes[i]
+}
+
+func vectorFunc(argTypes ...ValueType) function {
+	return function{argTypes: argTypes, returnType: ValueTypeVector}
+}
+
+var (
+	instantVector = vectorFunc(ValueTypeVector)
+	rangeVector   = vectorFunc(ValueTypeMatrix)
+	timeOfVector  = function{argTypes: []ValueType{ValueTypeVector}, variadic: 1, returnType: ValueTypeVector}
+)
+
+// functions are the functions supported by Prometheus.
+var functions = map[string]function{
+	"abs":                          instantVector,
+	"absent":                       instantVector,
+	"absent_over_time":             rangeVector,
+	"acos":                         instantVector,
+	"acosh":                        instantVector,
+	"asin":                         instantVector,
+	"asinh":                        instantVector,
+	"atan":                         instantVector,
+	"atanh":                        instantVector,
+	"avg_over_time":                rangeVector,
+	"ceil":                         instantVector,
+	"changes":                      rangeVector,
+	"clamp":                        vectorFunc(ValueTypeVector, ValueTypeScalar, ValueTypeScalar),
+	"clamp_max":                    vectorFunc(ValueTypeVector, ValueTypeScalar),
+	"clamp_min":                    vectorFunc(ValueTypeVector, ValueTypeScalar),
+	"cos":                          instantVector,
+	"cosh":                         instantVector,
+	"count_over_time":              rangeVector,
+	"day_of_month":                 timeOfVector,
+	"day_of_week":                  timeOfVector,
+	"day_of_year":                  timeOfVector,
+	"days_in_month":                timeOfVector,
+	"deg":                          instantVector,
+	"delta":                        rangeVector,
+	"deriv":                        rangeVector,
+	"double_exponential_smoothing": vectorFunc(ValueTypeMatrix, ValueTypeScalar, ValueTypeScalar),
+	"exp":                          instantVector,
+	"floor":                        instantVector,
+	"histogram_avg":                instantVector,
+	"histogram_count":              instantVector,
+	"histogram_fraction":           vectorFunc(ValueTypeScalar, ValueTypeScalar, ValueTypeVector),
+	"histogram_quantile":           vectorFunc(ValueTypeScalar, ValueTypeVector),
+	"histogram_stddev":             instantVector,
+	"histogram_stdvar":             instantVector,
+	"histogram_sum":                instantVector,
+	"holt_winters":                 vectorFunc(ValueTypeMatrix, ValueTypeScalar, ValueTypeScalar),
+	"hour":                         timeOfVector,
+	"idelta":                       rangeVector,
+	"increase":                     rangeVector,
+	"irate":                        rangeVector,
+	"label_join":                   {argTypes: []ValueType{ValueTypeVector, ValueTypeString, ValueTypeString, ValueTypeString}, variadic: -1, returnType: ValueTypeVector},
+	"label_replace":                vectorFunc(ValueTypeVector, ValueTypeString, ValueTypeString, ValueTypeString, ValueTypeString),
+	"last_over_time":               rangeVector,
+	"ln":                           instantVector,
+	"log10":                        instantVector,
+	"log2":                         instantVector,
+	"mad_over_time":                rangeVector,
+	"max_over_time":                rangeVector,
+	"min_over_time":                rangeVector,
+	"minute":                       timeOfVector,
+	"month":                        timeOfVector,
+	"pi":                           {returnType: ValueTypeScalar},
+	"predict_linear":               vectorFunc(ValueTypeMatrix, ValueTypeScalar),
+	"present_over_time":            rangeVector,
+	"quantile_over_time":           vectorFunc(ValueTypeScalar, ValueTypeMatrix),
+	"rad":                          instantVector,
+	"rate":                         rangeVector,
+	"resets":                       rangeVector,
+	"round":                        {argTypes: []ValueType{ValueTypeVector, ValueTypeScalar}, variadic: 1, returnType: ValueTypeVector},
+	"scalar":                       {argTypes: []ValueType{ValueTypeVector}, returnType: ValueTypeScalar},
+	"sgn":                          instantVector,
+	"sin":                          instantVector,
+	"sinh":                         instantVector,
+	"sort":                         instantVector,
+	"sort_by_label":                {argTypes: []ValueType{ValueTypeVector, ValueTypeString}, variadic: -1, returnType: ValueTypeVector},
+	"sort_by_label_desc":           {argTypes: []ValueType{ValueTypeVector, ValueTypeString}, variadic: -1, returnType: ValueTypeVector},
+	"sort_desc":                    instantVector,
+	"sqrt":                         instantVector,
+	"stddev_over_time":             rangeVector,
+	"stdvar_over_time":             rangeVector,
+	"sum_over_time":                rangeVector,
+	"tan":                          instantVector,
+	"tanh":                         instantVector,
+	"time":                         {returnType: ValueTypeScalar},
+	"timestamp":                    instantVector,
+	"vector":                       vectorFunc(ValueTypeScalar),
+	"year":                         timeOfVector,
+}
+
+// aggregations maps the aggregation operators to the type of their parameter, if any.
+var aggregations = map[string]ValueType{
+	"avg":          "",
+	"bottomk":      ValueTypeScalar,
+	"count":        "",
+	"count_values": ValueTypeString,
+	"group":        "",
+	"limit_ratio":  ValueTypeScalar,
+	"limitk":       ValueTypeScalar,
+	"max":          "",
+	"min":          "",
+	"quantile":     ValueTypeScalar,
+	"stddev":       "",
+	"stdvar":       "",
+	"sum":          "",
+	"topk":         ValueTypeScalar,
+}
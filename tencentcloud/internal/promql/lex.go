@@ -0,0 +1,278 @@
+package promql
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type itemType int
+
+const (
+	itemEOF itemType = iota
+	itemIdentifier
+	itemMetricIdentifier
+	itemNumber
+	itemDuration
+	itemString
+	itemLeftParen
+	itemRightParen
+	itemLeftBrace
+	itemRightBrace
+	itemLeftBracket
+	itemRightBracket
+	itemComma
+	itemColon
+	itemAt
+	itemAssign
+	itemEqlRegex
+	itemNeq
+	itemNeqRegex
+	itemEql
+	itemLss
+	itemLte
+	itemGtr
+	itemGte
+	itemAdd
+	itemSub
+	itemMul
+	itemDiv
+	itemMod
+	itemPow
+)
+
+type item struct {
+	typ itemType
+	pos int
+	val string
+}
+
+func (i item) String() string {
+	switch i.typ {
+	case itemEOF:
+		return "end of input"
+	case itemString:
+		return "string " + i.val
+	case itemNumber:
+		return "number " + i.val
+	case itemDuration:
+		return "duration " + i.val
+	case itemIdentifier, itemMetricIdentifier:
+		return "identifier " + i.val
+	}
+	return fmt.Sprintf("%q", i.val)
+}
+
+var operators = []struct {
+	val string
+	typ itemType
+}{
+	// longer operators go first.
+	{"==", itemEql},
+	{"!=", itemNeq},
+	{"=~", itemEqlRegex},
+	{"!~", itemNeqRegex},
+	{"<=", itemLte},
+	{">=", itemGte},
+	{"(", itemLeftParen},
+	{")", itemRightParen},
+	{"{", itemLeftBrace},
+	{"}", itemRightBrace},
+	{"[", itemLeftBracket},
+	{"]", itemRightBracket},
+	{",", itemComma},
+	{":", itemColon},
+	{"@", itemAt},
+	{"=", itemAssign},
+	{"<", itemLss},
+	{">", itemGtr},
+	{"+", itemAdd},
+	{"-", itemSub},
+	{"*", itemMul},
+	{"/", itemDiv},
+	{"%", itemMod},
+	{"^", itemPow},
+}
+
+// lex splits input into items, the last one is always itemEOF.
+func lex(input string) ([]item, error) {
+	var (
+		items []item
+		pos   int
+		// inside brackets ':' separates the range and the step of a subquery.
+		brackets int
+	)
+
+	for pos < len(input) {
+		c := input[pos]
+		switch {
+		case isSpace(c):
+			pos++
+			continue
+		case c == '#':
+			for pos < len(input) && input[pos] != '\n' {
+				pos++
+			}
+			continue
+		case isAlpha(c) || c == ':' && brackets == 0:
+			start := pos
+			for pos < len(input) && (isAlphaNumeric(input[pos]) || input[pos] == ':') {
+				pos++
+			}
+			val := input[start:pos]
+			if strings.Contains(val, ":") {
+				items = append(items, item{itemMetricIdentifier, start, val})
+			} else {
+				items = append(items, item{itemIdentifier, start, val})
+			}
+			continue
+		case isDigit(c) || c == '.' && pos+1 < len(input) && isDigit(input[pos+1]):
+			it, err := lexNumberOrDuration(input, pos)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, it)
+			pos += len(it.val)
+			continue
+		case c == '"' || c == '\'' || c == '`':
+			end, err := scanString(input, pos)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item{itemString, pos, input[pos:end]})
+			pos = end
+			continue
+		}
+
+		matched := false
+		for _, op := range operators {
+			if strings.HasPrefix(input[pos:], op.val) {
+				items = append(items, item{op.typ, pos, op.val})
+				switch op.typ {
+				case itemLeftBracket:
+					brackets++
+				case itemRightBracket:
+					brackets--
+				}
+				pos += len(op.val)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			r, _ := utf8.DecodeRuneInString(input[pos:])
+			return nil, newError(input, pos, "unexpected character: %q", r)
+		}
+	}
+
+	return append(items, item{itemEOF, len(input), ""}), nil
+}
+
+func lexNumberOrDuration(input string, start int) (item, error) {
+	if n := scanDuration(input[start:]); n > 0 {
+		return item{itemDuration, start, input[start : start+n]}, nil
+	}
+
+	pos := start
+	digits := isDigit
+	hex := strings.HasPrefix(input[pos:], "0x") || strings.HasPrefix(input[pos:], "0X")
+	if hex {
+		pos += 2
+		digits = isHexDigit
+	}
+	for pos < len(input) && digits(input[pos]) {
+		pos++
+	}
+	if !hex && pos < len(input) && input[pos] == '.' {
+		pos++
+		for pos < len(input) && isDigit(input[pos]) {
+			pos++
+		}
+	}
+	if !hex && pos < len(input) && (input[pos] == 'e' || input[pos] == 'E') {
+		exp := pos + 1
+		if exp < len(input) && (input[exp] == '+' || input[exp] == '-') {
+			exp++
+		}
+		if exp < len(input) && isDigit(input[exp]) {
+			pos = exp
+			for pos < len(input) && isDigit(input[pos]) {
+				pos++
+			}
+		}
+	}
+	if pos < len(input) && (isAlphaNumeric(input[pos]) || input[pos] == '.') {
+		return item{}, newError(input, start, "bad number or duration syntax: %q", input[start:pos+1])
+	}
+	return item{itemNumber, start, input[start:pos]}, nil
+}
+
+// scanDuration returns the length of the duration at the beginning of s, or 0 if there is none.
+func scanDuration(s string) int {
+	pos := 0
+	for pos < len(s) && isDigit(s[pos]) {
+		start := pos
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+		unit := durationUnit(s[pos:])
+		if unit == "" {
+			if start == 0 {
+				return 0
+			}
+			return -1
+		}
+		pos += len(unit)
+	}
+	if pos == 0 || pos < len(s) && (isAlphaNumeric(s[pos]) || s[pos] == '.') {
+		return 0
+	}
+	return pos
+}
+
+func durationUnit(s string) string {
+	for _, unit := range []string{"ms", "y", "w", "d", "h", "m", "s"} {
+		if strings.HasPrefix(s, unit) {
+			return unit
+		}
+	}
+	return ""
+}
+
+func scanString(input string, start int) (int, error) {
+	quote := input[start]
+	pos := start + 1
+	for pos < len(input) {
+		switch c := input[pos]; {
+		case c == quote:
+			return pos + 1, nil
+		case c == '\\' && quote != '`':
+			pos += 2
+		case c == '\n' && quote != '`':
+			return 0, newError(input, start, "unterminated quoted string")
+		default:
+			pos++
+		}
+	}
+	return 0, newError(input, start, "unterminated quoted string")
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isAlpha(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isAlphaNumeric(c byte) bool {
+	return isAlpha(c) || isDigit(c)
+}
@@ -0,0 +1,627 @@
+// Package promql validates PromQL expressions and Prometheus rule files without evaluating them.
+//
+// It is not the Prometheus parser, but a smaller one of the same grammar and static type checks:
+// selectors, range vectors, subqueries, offset and @ modifiers, aggregations, function calls and
+// binary expressions with vector matching. It is tested against the cases of the Prometheus parser
+// tests in testdata/prometheus.txt, outside of them the two may disagree, so the monitor API still
+// has the final word on an expression accepted here.
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValueType is the type an expression evaluates to.
+type ValueType string
+
+const (
+	ValueTypeScalar ValueType = "scalar"
+	ValueTypeVector ValueType = "vector"
+	ValueTypeMatrix ValueType = "matrix"
+	ValueTypeString ValueType = "string"
+)
+
+// DocumentedType returns the name of the type used by the Prometheus documentation.
+func (t ValueType) DocumentedType() string {
+	switch t {
+	case ValueTypeVector:
+		return "instant vector"
+	case ValueTypeMatrix:
+		return "range vector"
+	}
+	return string(t)
+}
+
+// Error is a syntax or type error at a position of the expression.
+type Error struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: parse error: %s", e.Line, e.Column, e.Msg)
+}
+
+func newError(input string, pos int, format string, args ...interface{}) *Error {
+	line, column := 1, 1
+	for _, c := range input[:pos] {
+		if c == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &Error{Line: line, Column: column, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Parse checks expr and returns the type it evaluates to.
+func Parse(expr string) (valueType ValueType, err error) {
+	items, err := lex(expr)
+	if err != nil {
+		return "", err
+	}
+	if len(items) == 1 {
+		return "", newError(expr, 0, "no expression found in input")
+	}
+
+	p := &parser{input: expr, items: items}
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			valueType, err = "", e
+		}
+	}()
+
+	valueType = p.parseExpr(0)
+	if it := p.peek(); it.typ != itemEOF {
+		p.errorf(it, "unexpected %s", it)
+	}
+	return valueType, nil
+}
+
+type exprKind int
+
+const (
+	kindOther exprKind = iota
+	kindVectorSelector
+	kindMatrixSelector
+	kindSubquery
+)
+
+type parser struct {
+	input string
+	items []item
+	pos   int
+}
+
+func (p *parser) peek() item {
+	return p.items[p.pos]
+}
+
+func (p *parser) next() item {
+	it := p.items[p.pos]
+	if it.typ != itemEOF {
+		p.pos++
+	}
+	return it
+}
+
+func (p *parser) expect(typ itemType, context string) item {
+	it := p.next()
+	if it.typ != typ {
+		p.errorf(it, "unexpected %s in %s", it, context)
+	}
+	return it
+}
+
+func (p *parser) expectDuration(context string) {
+	it := p.expect(itemDuration, context)
+	if _, err := ParseDuration(it.val); err != nil {
+		p.errorf(it, "%s", err)
+	}
+}
+
+func (p *parser) errorf(it item, format string, args ...interface{}) {
+	panic(newError(p.input, it.pos, format, args...))
+}
+
+// keyword returns the lower-cased value of an identifier, keywords are case-insensitive.
+func keyword(it item) string {
+	if it.typ != itemIdentifier {
+		return ""
+	}
+	return strings.ToLower(it.val)
+}
+
+type binaryOp struct {
+	precedence int
+	comparison bool
+	set        bool
+	rightAssoc bool
+}
+
+func (p *parser) binaryOp(it item) (binaryOp, bool) {
+	switch it.typ {
+	case itemEql, itemNeq, itemLss, itemLte, itemGtr, itemGte:
+		return binaryOp{precedence: 3, comparison: true}, true
+	case itemAdd, itemSub:
+		return binaryOp{precedence: 4}, true
+	case itemMul, itemDiv, itemMod:
+		return binaryOp{precedence: 5}, true
+	case itemPow:
+		return binaryOp{precedence: 6, rightAssoc: true}, true
+	}
+	switch keyword(it) {
+	case "or":
+		return binaryOp{precedence: 1, set: true}, true
+	case "and", "unless":
+		return binaryOp{precedence: 2, set: true}, true
+	case "atan2":
+		return binaryOp{precedence: 5}, true
+	}
+	return binaryOp{}, false
+}
+
+func (p *parser) parseExpr(minPrecedence int) ValueType {
+	lhs := p.parseUnary()
+
+	for {
+		opItem := p.peek()
+		op, ok := p.binaryOp(opItem)
+		if !ok || op.precedence < minPrecedence {
+			return lhs
+		}
+		p.next()
+
+		returnBool := false
+		if keyword(p.peek()) == "bool" {
+			if !op.comparison {
+				p.errorf(p.peek(), "bool modifier can only be used on comparison operators")
+			}
+			p.next()
+			returnBool = true
+		}
+
+		matching := false
+		if kw := keyword(p.peek()); kw == "on" || kw == "ignoring" {
+			p.next()
+			labels := p.parseLabels(kw + " clause")
+			matching = true
+
+			if group := p.peek(); keyword(group) == "group_left" || keyword(group) == "group_right" {
+				if op.set {
+					p.errorf(group, "no grouping allowed for %q operation", opItem.val)
+				}
+				p.next()
+				if p.peek().typ == itemLeftParen {
+					for _, label := range p.parseLabels(keyword(group) + " clause") {
+						if kw == "on" && contains(labels, label) {
+							p.errorf(group, "label %q must not occur in ON and GROUP clause at once", label)
+						}
+					}
+				}
+			}
+		}
+
+		next := op.precedence + 1
+		if op.rightAssoc {
+			next = op.precedence
+		}
+		rhs := p.parseExpr(next)
+
+		lhs = p.checkBinary(opItem, op, lhs, rhs, returnBool, matching)
+	}
+}
+
+func (p *parser) checkBinary(opItem item, op binaryOp, lhs, rhs ValueType, returnBool, matching bool) ValueType {
+	for _, t := range []ValueType{lhs, rhs} {
+		if t != ValueTypeScalar && t != ValueTypeVector {
+			p.errorf(opItem, "binary expression must contain only scalar and instant vector types")
+		}
+	}
+
+	bothVectors := lhs == ValueTypeVector && rhs == ValueTypeVector
+	if op.set && !bothVectors {
+		p.errorf(opItem, "set operator %q not allowed in binary scalar expression", opItem.val)
+	}
+	if matching && !bothVectors {
+		p.errorf(opItem, "vector matching only allowed between instant vectors")
+	}
+	if op.comparison && !returnBool && lhs == ValueTypeScalar && rhs == ValueTypeScalar {
+		p.errorf(opItem, "comparisons between scalars must use BOOL modifier")
+	}
+
+	if lhs == ValueTypeVector || rhs == ValueTypeVector {
+		return ValueTypeVector
+	}
+	return ValueTypeScalar
+}
+
+func (p *parser) parseUnary() ValueType {
+	if it := p.peek(); it.typ == itemAdd || it.typ == itemSub {
+		p.next()
+		// unary operators bind weaker than `^`, `-a^b` is `-(a^b)`.
+		t := p.parseExpr(6)
+		if t != ValueTypeScalar && t != ValueTypeVector {
+			p.errorf(it, "unary expression only allowed on expressions of type scalar or instant vector, got %q", t.DocumentedType())
+		}
+		return t
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() ValueType {
+	t, kind := p.parsePrimary()
+
+	var hasOffset, hasAt bool
+	for {
+		it := p.peek()
+		switch {
+		case it.typ == itemLeftBracket:
+			p.next()
+			p.expectDuration("range or subquery")
+			if p.peek().typ == itemColon {
+				p.next()
+				if p.peek().typ == itemDuration {
+					p.expectDuration("subquery")
+				}
+				p.expect(itemRightBracket, "subquery")
+				if t != ValueTypeVector {
+					p.errorf(it, "subquery is only allowed on instant vector, got %s", t.DocumentedType())
+				}
+				t, kind = ValueTypeMatrix, kindSubquery
+			} else {
+				p.expect(itemRightBracket, "range")
+				if kind != kindVectorSelector {
+					p.errorf(it, "ranges only allowed for vector selectors")
+				}
+				if hasOffset || hasAt {
+					p.errorf(it, "no offset or @ modifiers allowed before range")
+				}
+				t, kind = ValueTypeMatrix, kindMatrixSelector
+			}
+			hasOffset, hasAt = false, false
+		case keyword(it) == "offset":
+			p.next()
+			if kind == kindOther {
+				p.errorf(it, "offset modifier must be preceded by an instant vector selector or range vector selector or a subquery")
+			}
+			if hasOffset {
+				p.errorf(it, "offset may not be set multiple times")
+			}
+			if p.peek().typ == itemSub || p.peek().typ == itemAdd {
+				p.next()
+			}
+			p.expectDuration("offset")
+			hasOffset = true
+		case it.typ == itemAt:
+			p.next()
+			if kind == kindOther {
+				p.errorf(it, "@ modifier must be preceded by an instant vector selector or range vector selector or a subquery")
+			}
+			if hasAt {
+				p.errorf(it, "@ <timestamp> may not be set multiple times")
+			}
+			p.parseAtTimestamp()
+			hasAt = true
+		default:
+			return t
+		}
+	}
+}
+
+func (p *parser) parseAtTimestamp() {
+	it := p.next()
+	if it.typ == itemSub || it.typ == itemAdd {
+		it = p.next()
+	}
+	switch {
+	case it.typ == itemNumber:
+	case keyword(it) == "start" || keyword(it) == "end":
+		p.expect(itemLeftParen, "@ modifier")
+		p.expect(itemRightParen, "@ modifier")
+	default:
+		p.errorf(it, "unexpected %s in @ modifier, expected timestamp, start() or end()", it)
+	}
+}
+
+func (p *parser) parsePrimary() (ValueType, exprKind) {
+	it := p.peek()
+	switch it.typ {
+	case itemNumber:
+		p.next()
+		if _, err := parseNumber(it.val); err != nil {
+			p.errorf(it, "error parsing number: %s", err)
+		}
+		return ValueTypeScalar, kindOther
+	case itemString:
+		p.next()
+		p.unquote(it)
+		return ValueTypeString, kindOther
+	case itemLeftParen:
+		p.next()
+		t := p.parseExpr(0)
+		p.expect(itemRightParen, "paren expression")
+		return t, kindOther
+	case itemLeftBrace:
+		p.parseSelector("")
+		return ValueTypeVector, kindVectorSelector
+	case itemIdentifier, itemMetricIdentifier:
+	default:
+		p.errorf(it, "unexpected %s", it)
+	}
+
+	lower := keyword(it)
+	if lower == "inf" || lower == "nan" {
+		p.next()
+		return ValueTypeScalar, kindOther
+	}
+	next := p.items[p.pos+1]
+	if _, ok := aggregations[lower]; ok && (next.typ == itemLeftParen || keyword(next) == "by" || keyword(next) == "without") {
+		return p.parseAggregation(), kindOther
+	}
+	if it.typ == itemIdentifier && next.typ == itemLeftParen {
+		return p.parseCall(), kindOther
+	}
+
+	// any other identifier is a metric name, keywords such as `sum` or `on` included.
+	p.next()
+	p.parseSelector(it.val)
+	return ValueTypeVector, kindVectorSelector
+}
+
+func (p *parser) parseCall() ValueType {
+	name := p.next()
+	f, ok := functions[name.val]
+	if !ok {
+		p.errorf(name, "unknown function with name %q", name.val)
+	}
+
+	p.expect(itemLeftParen, "function call")
+	args := p.parseArgs()
+
+	if len(args) < f.minArgs() || f.variadic >= 0 && len(args) > len(f.argTypes) {
+		expected := strconv.Itoa(f.minArgs())
+		if f.variadic != 0 {
+			expected = "at least " + expected
+		}
+		p.errorf(name, "expected %s argument(s) in call to %q, got %d", expected, name.val, len(args))
+	}
+	for i, arg := range args {
+		if expected := f.argType(i); arg != expected {
+			p.errorf(name, "expected type %s in call to function %q, got %s", expected.DocumentedType(), name.val, arg.DocumentedType())
+		}
+	}
+	return f.returnType
+}
+
+// parseArgs parses the arguments of a call or an aggregation after the opening parenthesis.
+func (p *parser) parseArgs() []ValueType {
+	var args []ValueType
+	if p.peek().typ == itemRightParen {
+		p.next()
+		return args
+	}
+	for {
+		args = append(args, p.parseExpr(0))
+		it := p.next()
+		if it.typ == itemRightParen {
+			return args
+		}
+		if it.typ != itemComma {
+			p.errorf(it, "unexpected %s in argument list, expected \",\" or \")\"", it)
+		}
+	}
+}
+
+func (p *parser) parseAggregation() ValueType {
+	op := p.next()
+	name := keyword(op)
+
+	grouped := false
+	if kw := keyword(p.peek()); kw == "by" || kw == "without" {
+		p.next()
+		p.parseLabels("grouping")
+		grouped = true
+	}
+
+	p.expect(itemLeftParen, "aggregation")
+	args := p.parseArgs()
+
+	if !grouped {
+		if kw := keyword(p.peek()); kw == "by" || kw == "without" {
+			p.next()
+			p.parseLabels("grouping")
+		}
+	}
+
+	param := aggregations[name]
+	expected := 1
+	if param != "" {
+		expected = 2
+	}
+	if len(args) != expected {
+		p.errorf(op, "wrong number of arguments for aggregate expression provided, expected %d, got %d", expected, len(args))
+	}
+	if param != "" && args[0] != param {
+		p.errorf(op, "expected type %s in aggregation parameter, got %s", param.DocumentedType(), args[0].DocumentedType())
+	}
+	if last := args[len(args)-1]; last != ValueTypeVector {
+		p.errorf(op, "expected type instant vector in aggregation expression, got %s", last.DocumentedType())
+	}
+	return ValueTypeVector
+}
+
+// parseLabels parses a parenthesized list of label names.
+func (p *parser) parseLabels(context string) []string {
+	var labels []string
+	p.expect(itemLeftParen, context)
+	for {
+		it := p.next()
+		switch it.typ {
+		case itemRightParen:
+			return labels
+		case itemIdentifier:
+			labels = append(labels, it.val)
+		case itemString:
+			labels = append(labels, p.unquote(it))
+		default:
+			p.errorf(it, "unexpected %s in %s, expected label", it, context)
+		}
+
+		it = p.next()
+		if it.typ == itemRightParen {
+			return labels
+		}
+		if it.typ != itemComma {
+			p.errorf(it, "unexpected %s in %s, expected \",\" or \")\"", it, context)
+		}
+	}
+}
+
+// parseSelector parses the optional label matchers of a vector selector with the metric name.
+func (p *parser) parseSelector(metricName string) {
+	start := p.peek()
+	nonEmpty := metricName != ""
+
+	if p.peek().typ != itemLeftBrace {
+		return
+	}
+	p.next()
+
+	for {
+		it := p.next()
+		if it.typ == itemRightBrace {
+			break
+		}
+
+		var label string
+		switch it.typ {
+		case itemIdentifier:
+			label = it.val
+		case itemString:
+			label = p.unquote(it)
+			// a quoted metric name on its own.
+			if next := p.peek().typ; next == itemComma || next == itemRightBrace {
+				if metricName != "" {
+					p.errorf(it, "metric name must not be set twice: %q or %q", metricName, label)
+				}
+				metricName, nonEmpty = label, true
+				if next == itemComma {
+					p.next()
+				}
+				continue
+			}
+		default:
+			p.errorf(it, "unexpected %s in label matching, expected label", it)
+		}
+
+		op := p.next()
+		switch op.typ {
+		case itemAssign, itemNeq, itemEqlRegex, itemNeqRegex:
+		default:
+			p.errorf(op, "unexpected %s in label matching, expected one of \"=\", \"!=\", \"=~\" or \"!~\"", op)
+		}
+
+		valueItem := p.expect(itemString, "label matching")
+		value := p.unquote(valueItem)
+
+		matchesEmpty := false
+		switch op.typ {
+		case itemAssign:
+			matchesEmpty = value == ""
+		case itemNeq:
+			matchesEmpty = value != ""
+		case itemEqlRegex, itemNeqRegex:
+			re, err := regexp.Compile("^(?:" + value + ")$")
+			if err != nil {
+				p.errorf(valueItem, "invalid regular expression in label matcher: %s", err)
+			}
+			matchesEmpty = re.MatchString("") == (op.typ == itemEqlRegex)
+		}
+
+		if label == "__name__" {
+			if metricName != "" {
+				p.errorf(it, "metric name must not be set twice: %q or %q", metricName, value)
+			}
+			if op.typ == itemAssign {
+				metricName = value
+			}
+		}
+		if !matchesEmpty {
+			nonEmpty = true
+		}
+
+		it = p.next()
+		if it.typ == itemRightBrace {
+			break
+		}
+		if it.typ != itemComma {
+			p.errorf(it, "unexpected %s in label matching, expected \",\" or \"}\"", it)
+		}
+	}
+
+	if !nonEmpty {
+		p.errorf(start, "vector selector must contain at least one non-empty matcher")
+	}
+}
+
+func (p *parser) unquote(it item) string {
+	s, err := unquote(it.val)
+	if err != nil {
+		p.errorf(it, "invalid string %s: %s", it.val, err)
+	}
+	return s
+}
+
+// unquote unquotes a PromQL string, single-quoted strings follow the escaping rules of double-quoted ones.
+func unquote(s string) (string, error) {
+	if s[0] == '\'' {
+		var b strings.Builder
+		b.WriteByte('"')
+		for i := 1; i < len(s)-1; i++ {
+			switch c := s[i]; c {
+			case '\\':
+				if s[i+1] == '\'' {
+					b.WriteByte('\'')
+				} else {
+					b.WriteByte(c)
+					b.WriteByte(s[i+1])
+				}
+				i++
+			case '"':
+				b.WriteString(`\"`)
+			default:
+				b.WriteByte(c)
+			}
+		}
+		b.WriteByte('"')
+		s = b.String()
+	}
+	return strconv.Unquote(s)
+}
+
+func parseNumber(s string) (float64, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		n, err := strconv.ParseInt(s[2:], 16, 64)
+		return float64(n), err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
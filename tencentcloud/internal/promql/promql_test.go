@@ -0,0 +1,307 @@
+package promql
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr      string
+		valueType ValueType
+		err       string
+	}{
+		{expr: `1`, valueType: ValueTypeScalar},
+		{expr: `-1.5e3`, valueType: ValueTypeScalar},
+		{expr: `0x1F + .5`, valueType: ValueTypeScalar},
+		{expr: `Inf`, valueType: ValueTypeScalar},
+		{expr: `"foo"`, valueType: ValueTypeString},
+		{expr: `'it\'s'`, valueType: ValueTypeString},
+		{expr: `up`, valueType: ValueTypeVector},
+		{expr: `job:http_requests:rate5m`, valueType: ValueTypeVector},
+		{expr: `up{job="api", instance=~"10\\..*", env!="", zone!~"a|b"}`, valueType: ValueTypeVector},
+		{expr: `{__name__=~"node_.+"}`, valueType: ValueTypeVector},
+		{expr: `{"up", job="api"}`, valueType: ValueTypeVector},
+		{expr: `up[5m]`, valueType: ValueTypeMatrix},
+		{expr: `up[1h30m] offset 1d`, valueType: ValueTypeMatrix},
+		{expr: `up offset -5m @ 1609746000`, valueType: ValueTypeVector},
+		{expr: `up @ end()`, valueType: ValueTypeVector},
+		{expr: `rate(http_requests_total{code=~"5.."}[5m])`, valueType: ValueTypeVector},
+		{expr: `max_over_time(rate(http_requests_total[5m])[30m:1m])`, valueType: ValueTypeVector},
+		{expr: `min_over_time((up > 0)[1h:])`, valueType: ValueTypeVector},
+		{expr: `sum by (job) (rate(http_requests_total[5m]))`, valueType: ValueTypeVector},
+		{expr: `sum(rate(http_requests_total[5m])) without (instance, pod,)`, valueType: ValueTypeVector},
+		{expr: `SUM(up)`, valueType: ValueTypeVector},
+		{expr: `topk(5, up)`, valueType: ValueTypeVector},
+		{expr: `count_values("version", build_info)`, valueType: ValueTypeVector},
+		{expr: `quantile(0.9, up)`, valueType: ValueTypeVector},
+		{expr: `histogram_quantile(0.99, sum by (le) (rate(latency_bucket[5m])))`, valueType: ValueTypeVector},
+		{expr: `label_replace(up, "dst", "$1", "src", "(.*)")`, valueType: ValueTypeVector},
+		{expr: `label_join(up, "dst", ",")`, valueType: ValueTypeVector},
+		{expr: `label_join(up, "dst", ",", "a", "b", "c")`, valueType: ValueTypeVector},
+		{expr: `round(up)`, valueType: ValueTypeVector},
+		{expr: `round(up, 0.1)`, valueType: ValueTypeVector},
+		{expr: `hour()`, valueType: ValueTypeVector},
+		{expr: `time() - process_start_time_seconds`, valueType: ValueTypeVector},
+		{expr: `scalar(up) * 2`, valueType: ValueTypeScalar},
+		{expr: `1 > bool 2`, valueType: ValueTypeScalar},
+		{expr: `-2 ^ 2 ^ 3`, valueType: ValueTypeScalar},
+		{expr: `a / on (job) group_left (team) b`, valueType: ValueTypeVector},
+		{expr: `a * ignoring (code) group_right b`, valueType: ValueTypeVector},
+		{expr: `a and on (job) b or c unless d`, valueType: ValueTypeVector},
+		{expr: `a atan2 b`, valueType: ValueTypeVector},
+		{expr: `sum`, valueType: ValueTypeVector},
+		{expr: `on`, valueType: ValueTypeVector},
+		{expr: `sum{job="api"} offset 5m`, valueType: ValueTypeVector},
+		{expr: `rate(by[5m]) * on (job) offset`, valueType: ValueTypeVector},
+		{expr: `up offset 5m [1h:]`, valueType: ValueTypeMatrix},
+		{
+			expr: `# errors ratio
+sum(rate(errors_total[5m])) / sum(rate(requests_total[5m])) > 0.05`,
+			valueType: ValueTypeVector,
+		},
+
+		{expr: ``, err: "1:1: parse error: no expression found in input"},
+		{expr: `up{`, err: "1:4: parse error: unexpected end of input in label matching, expected label"},
+		{expr: `up{job=}`, err: `1:8: parse error: unexpected "}" in label matching`},
+		{expr: `up{job~"a"}`, err: `1:7: parse error: unexpected character: '~'`},
+		{expr: `up{job=~"("}`, err: "1:9: parse error: invalid regular expression in label matcher"},
+		{expr: `{job=""}`, err: "1:1: parse error: vector selector must contain at least one non-empty matcher"},
+		{expr: `{job=~".*"}`, err: "1:1: parse error: vector selector must contain at least one non-empty matcher"},
+		{expr: `up{__name__="up"}`, err: "metric name must not be set twice"},
+		{expr: `"foo`, err: "1:1: parse error: unterminated quoted string"},
+		{expr: `up[5]`, err: "1:4: parse error: unexpected number 5 in range or subquery"},
+		{expr: `up[5m1h]`, err: `not a valid duration string: "5m1h"`},
+		{expr: `up[5mo]`, err: "bad number or duration syntax"},
+		{expr: `rate(up)`, err: `1:1: parse error: expected type range vector in call to function "rate", got instant vector`},
+		{expr: `abs(up[5m])`, err: `expected type instant vector in call to function "abs", got range vector`},
+		{expr: `rate(up[5m], 1)`, err: `expected 1 argument(s) in call to "rate", got 2`},
+		{expr: `label_join(up, "dst")`, err: `expected at least 3 argument(s) in call to "label_join", got 2`},
+		{expr: `unknown_func(up)`, err: `unknown function with name "unknown_func"`},
+		{expr: `sum(up[5m])`, err: "expected type instant vector in aggregation expression, got range vector"},
+		{expr: `topk(up)`, err: "wrong number of arguments for aggregate expression provided, expected 2, got 1"},
+		{expr: `topk("5", up)`, err: "expected type scalar in aggregation parameter, got string"},
+		{expr: `sum by job (up)`, err: "unexpected identifier job in grouping"},
+		{expr: `rate(up[5m])[5m]`, err: "ranges only allowed for vector selectors"},
+		{expr: `up offset 5m [5m]`, err: "no offset or @ modifiers allowed before range"},
+		{expr: `up[5m][5m:]`, err: "subquery is only allowed on instant vector, got range vector"},
+		{expr: `sum(up) offset 5m`, err: "offset modifier must be preceded by an instant vector selector"},
+		{expr: `up offset 5m offset 1m`, err: "offset may not be set multiple times"},
+		{expr: `up @ foo`, err: "expected timestamp, start() or end()"},
+		{expr: `1 > 2`, err: "comparisons between scalars must use BOOL modifier"},
+		{expr: `a + bool b`, err: "bool modifier can only be used on comparison operators"},
+		{expr: `a and 1`, err: `set operator "and" not allowed in binary scalar expression`},
+		{expr: `a or on (job) group_left b`, err: `no grouping allowed for "or" operation`},
+		{expr: `a / on (job) group_left (job) b`, err: `label "job" must not occur in ON and GROUP clause at once`},
+		{expr: `1 + on (job) up`, err: "vector matching only allowed between instant vectors"},
+		{expr: `up[5m] + 1`, err: "binary expression must contain only scalar and instant vector types"},
+		{expr: `"a" + 1`, err: "binary expression must contain only scalar and instant vector types"},
+		{expr: `-up[5m]`, err: "unary expression only allowed on expressions of type scalar or instant vector"},
+		{expr: `sum(up) by (job) by (env)`, err: `1:18: parse error: unexpected identifier by`},
+		{expr: `(up`, err: "unexpected end of input in paren expression"},
+		{expr: "up\n  + ", err: "2:5: parse error: unexpected end of input"},
+		{expr: `up == on`, err: "unexpected end of input in on clause"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			valueType, err := Parse(tt.expr)
+			if tt.err != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.valueType, valueType)
+		})
+	}
+}
+
+// TestPrometheusCases checks the parser against the cases of the Prometheus parser tests.
+func TestPrometheusCases(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "prometheus.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		result, expr, ok := strings.Cut(line, "\t")
+		if !ok {
+			t.Fatalf("malformed case %q", line)
+		}
+		t.Run(expr, func(t *testing.T) {
+			valueType, err := Parse(expr)
+			if result == "fail" {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ValueType(result), valueType)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in       string
+		duration time.Duration
+		out      string
+		wantErr  bool
+	}{
+		{in: "0", duration: 0, out: "0s"},
+		{in: "0s", duration: 0, out: "0s"},
+		{in: "90s", duration: 90 * time.Second, out: "1m30s"},
+		{in: "1h30m", duration: 90 * time.Minute, out: "1h30m"},
+		{in: "2w1d", duration: 15 * 24 * time.Hour, out: "2w1d"},
+		{in: "1y", duration: 365 * 24 * time.Hour, out: "1y"},
+		{in: "1500ms", duration: 1500 * time.Millisecond, out: "1s500ms"},
+		{in: "", wantErr: true},
+		{in: "5", wantErr: true},
+		{in: "1m1h", wantErr: true},
+		{in: "-5m", wantErr: true},
+		{in: "5 m", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			duration, err := ParseDuration(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.duration, duration)
+			assert.Equal(t, tt.out, FormatDuration(duration))
+		})
+	}
+}
+
+func TestParseRuleFile(t *testing.T) {
+	ruleFile, errs := ParseRuleFile([]byte(`
+groups:
+  - name: api
+    interval: 30s
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+        labels:
+          team: api
+      - alert: HighErrorRate
+        expr: job:http_errors:rate5m / job:http_requests:rate5m > 0.05
+        for: 10m
+        labels:
+          severity: page
+        annotations:
+          summary: "High error rate on {{ $labels.job }}"
+  - name: node
+    rules:
+      - alert: NodeDown
+        expr: up{job="node"} == 0
+`))
+	assert.Empty(t, errs)
+	if assert.Len(t, ruleFile.Groups, 2) {
+		assert.Equal(t, "api", ruleFile.Groups[0].Name)
+		assert.Equal(t, "30s", ruleFile.Groups[0].Interval)
+		assert.Len(t, ruleFile.Groups[0].Rules, 2)
+		assert.Equal(t, "job:http_requests:rate5m", ruleFile.Groups[0].Rules[0].Name())
+		assert.Equal(t, "HighErrorRate", ruleFile.Groups[0].Rules[1].Name())
+		assert.Equal(t, "10m", ruleFile.Groups[0].Rules[1].For)
+		assert.Equal(t, map[string]string{"severity": "page"}, ruleFile.Groups[0].Rules[1].Labels)
+		assert.Equal(t, "NodeDown", ruleFile.Groups[1].Rules[0].Name())
+	}
+}
+
+func TestParseRuleFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		errs    []string
+	}{
+		{
+			name:    "unknown field",
+			content: "groups:\n  - name: a\n    rulez: []\n",
+			errs:    []string{"field rulez not found"},
+		},
+		{
+			name:    "not yaml",
+			content: "groups: [",
+			errs:    []string{"yaml:"},
+		},
+		{
+			name:    "empty group name",
+			content: "groups:\n  - rules: []\n",
+			errs:    []string{"groupname must not be empty"},
+		},
+		{
+			name:    "duplicated group",
+			content: "groups:\n  - name: a\n    rules: []\n  - name: a\n    rules: []\n",
+			errs:    []string{`"a": repeated in the same file`},
+		},
+		{
+			name:    "invalid interval",
+			content: "groups:\n  - name: a\n    interval: 1x\n    rules: []\n",
+			errs:    []string{`group "a": invalid interval`},
+		},
+		{
+			name: "invalid rules",
+			content: `
+groups:
+  - name: a
+    rules:
+      - expr: up
+      - record: a:b
+        alert: B
+        expr: up
+      - record: 1abc
+        expr: up
+        for: 5m
+        annotations:
+          summary: s
+      - alert: C
+        expr: rate(up)
+      - alert: D
+        expr: up[5m]
+        for: 5
+      - alert: E
+        expr: up
+        labels:
+          bad-name: x
+      - alert: F
+`,
+			errs: []string{
+				`group "a", rule 1, "": one of 'record' or 'alert' must be set`,
+				`group "a", rule 2, "a:b": only one of 'record' and 'alert' must be set`,
+				`group "a", rule 3, "1abc": invalid field 'annotations' in recording rule`,
+				`group "a", rule 3, "1abc": invalid field 'for' in recording rule`,
+				`group "a", rule 3, "1abc": invalid recording rule name: 1abc`,
+				`group "a", rule 4, "C": could not parse expression: 1:1: parse error: expected type range vector in call to function "rate", got instant vector`,
+				`group "a", rule 5, "D": invalid expression type "range vector", must be scalar or instant vector`,
+				`group "a", rule 5, "D": invalid field 'for': not a valid duration string: "5"`,
+				`group "a", rule 6, "E": invalid label name: bad-name`,
+				`group "a", rule 7, "F": field 'expr' must be set in rule`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := ParseRuleFile([]byte(tt.content))
+			if !assert.Len(t, errs, len(tt.errs)) {
+				t.Logf("errors: %v", errs)
+				return
+			}
+			for i, err := range errs {
+				assert.Contains(t, err.Error(), tt.errs[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegexp  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// RuleFile is a Prometheus rule file.
+type RuleFile struct {
+	Groups []RuleGroup `yaml:"groups"`
+}
+
+// RuleGroup is a group of rules evaluated together.
+type RuleGroup struct {
+	Name     string `yaml:"name"`
+	Interval string `yaml:"interval,omitempty"`
+	Limit    int    `yaml:"limit,omitempty"`
+	Rules    []Rule `yaml:"rules"`
+}
+
+// Rule is either a recording rule or an alerting rule.
+type Rule struct {
+	Record        string            `yaml:"record,omitempty"`
+	Alert         string            `yaml:"alert,omitempty"`
+	Expr          string            `yaml:"expr"`
+	For           string            `yaml:"for,omitempty"`
+	KeepFiringFor string            `yaml:"keep_firing_for,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+	Annotations   map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Name returns the name of the recorded metric or of the alert.
+func (r Rule) Name() string {
+	if r.Record != "" {
+		return r.Record
+	}
+	return r.Alert
+}
+
+// ParseRuleFile parses content in the Prometheus rule file format and validates every group and rule,
+// including the PromQL expressions. All the problems found are returned.
+func ParseRuleFile(content []byte) (*RuleFile, []error) {
+	ruleFile := &RuleFile{}
+	if err := yaml.UnmarshalStrict(content, ruleFile); err != nil {
+		return nil, []error{err}
+	}
+
+	var errs []error
+	groups := make(map[string]bool, len(ruleFile.Groups))
+	for _, group := range ruleFile.Groups {
+		if group.Name == "" {
+			errs = append(errs, fmt.Errorf("groupname must not be empty"))
+			continue
+		}
+		if groups[group.Name] {
+			errs = append(errs, fmt.Errorf("%q: repeated in the same file", group.Name))
+		}
+		groups[group.Name] = true
+
+		if group.Interval != "" {
+			if _, err := ParseDuration(group.Interval); err != nil {
+				errs = append(errs, fmt.Errorf("group %q: invalid interval: %v", group.Name, err))
+			}
+		}
+		if group.Limit < 0 {
+			errs = append(errs, fmt.Errorf("group %q: limit must not be negative", group.Name))
+		}
+
+		for i, rule := range group.Rules {
+			for _, err := range rule.validate() {
+				errs = append(errs, fmt.Errorf("group %q, rule %d, %q: %v", group.Name, i+1, rule.Name(), err))
+			}
+		}
+	}
+
+	return ruleFile, errs
+}
+
+func (r Rule) validate() (errs []error) {
+	switch {
+	case r.Record != "" && r.Alert != "":
+		errs = append(errs, fmt.Errorf("only one of 'record' and 'alert' must be set"))
+	case r.Record == "" && r.Alert == "":
+		errs = append(errs, fmt.Errorf("one of 'record' or 'alert' must be set"))
+	}
+
+	if r.Expr == "" {
+		errs = append(errs, fmt.Errorf("field 'expr' must be set in rule"))
+	} else if valueType, err := Parse(r.Expr); err != nil {
+		errs = append(errs, fmt.Errorf("could not parse expression: %v", err))
+	} else if valueType != ValueTypeVector && valueType != ValueTypeScalar {
+		errs = append(errs, fmt.Errorf("invalid expression type %q, must be scalar or instant vector", valueType.DocumentedType()))
+	}
+
+	if r.Record != "" {
+		if len(r.Annotations) > 0 {
+			errs = append(errs, fmt.Errorf("invalid field 'annotations' in recording rule"))
+		}
+		if r.For != "" {
+			errs = append(errs, fmt.Errorf("invalid field 'for' in recording rule"))
+		}
+		if r.KeepFiringFor != "" {
+			errs = append(errs, fmt.Errorf("invalid field 'keep_firing_for' in recording rule"))
+		}
+		if !metricNameRegexp.MatchString(r.Record) {
+			errs = append(errs, fmt.Errorf("invalid recording rule name: %s", r.Record))
+		}
+	}
+
+	for _, field := range []struct{ name, value string }{{"for", r.For}, {"keep_firing_for", r.KeepFiringFor}} {
+		if field.value == "" {
+			continue
+		}
+		if _, err := ParseDuration(field.value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid field '%s': %v", field.name, err))
+		}
+	}
+
+	for _, name := range sortedKeys(r.Labels) {
+		if !labelNameRegexp.MatchString(name) {
+			errs = append(errs, fmt.Errorf("invalid label name: %s", name))
+		}
+	}
+	for _, name := range sortedKeys(r.Annotations) {
+		if !labelNameRegexp.MatchString(name) {
+			errs = append(errs, fmt.Errorf("invalid annotation name: %s", name))
+		}
+	}
+
+	return
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+// Package quota checks at plan time that the resources planned to be created fit in the quotas of
+// the account, so that an apply does not fail halfway with LimitExceeded.
+//
+// The usage of each quota is fetched once and cached by the Checker, and the resources planned to
+// be created are added to it one by one as their diffs are customized. As the usage is fetched
+// before any of the resources of the plan is created, the same counting holds during the apply.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+)
+
+const (
+	// ModeWarn logs a warning if a quota would be exceeded.
+	ModeWarn = "warn"
+	// ModeError fails the plan if a quota would be exceeded.
+	ModeError = "error"
+)
+
+var Modes = []string{ModeWarn, ModeError}
+
+// Usage is the usage of a quota.
+type Usage struct {
+	Used  int64
+	Limit int64
+}
+
+// FetchFunc fetches the usage of a quota.
+type FetchFunc func(ctx context.Context) (Usage, error)
+
+// ExceededError is the error of the resources planned to be created exceeding a quota.
+type ExceededError struct {
+	Name    string
+	Used    int64
+	Planned int64
+	Limit   int64
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("quota of %s would be exceeded: %d used and %d planned to be created, but the limit is %d",
+		e.Name, e.Used, e.Planned, e.Limit)
+}
+
+// Checker checks the quotas in Mode, disabled if nil or Mode is empty.
+type Checker struct {
+	Mode string
+
+	mu     sync.Mutex
+	quotas map[string]*entry
+}
+
+type entry struct {
+	once    sync.Once
+	usage   Usage
+	err     error
+	planned int64
+}
+
+// NewChecker returns a Checker in mode.
+func NewChecker(mode string) *Checker {
+	return &Checker{Mode: mode, quotas: map[string]*entry{}}
+}
+
+// Enabled returns whether the quotas are checked.
+func (c *Checker) Enabled() bool {
+	return c != nil && c.Mode != ""
+}
+
+// Plan adds n resources planned to be created to the quota of key, named name in messages, and
+// checks that the total fits in its limit. The usage is fetched by fetch for the first resource of
+// key only. An exceeded quota is an *ExceededError in ModeError and logged in ModeWarn. A failure to
+// fetch the usage is logged and skips the check of key.
+func (c *Checker) Plan(ctx context.Context, key, name string, n int64, fetch FetchFunc) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	c.mu.Lock()
+	if c.quotas == nil {
+		c.quotas = map[string]*entry{}
+	}
+	e, ok := c.quotas[key]
+	if !ok {
+		e = &entry{}
+		c.quotas[key] = e
+	}
+	c.mu.Unlock()
+
+	e.once.Do(func() {
+		e.usage, e.err = fetch(ctx)
+		if e.err != nil {
+			log.Printf("[WARN] fetch quota of %s failed, skip checking it: %s", name, e.err.Error())
+		}
+	})
+	if e.err != nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	e.planned += n
+	planned := e.planned
+	c.mu.Unlock()
+
+	if e.usage.Used+planned <= e.usage.Limit {
+		return nil
+	}
+	err := &ExceededError{Name: name, Used: e.usage.Used, Planned: planned, Limit: e.usage.Limit}
+	if c.Mode == ModeError {
+		return err
+	}
+	log.Printf("[WARN] %s", err.Error())
+	return nil
+}
@@ -0,0 +1,94 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckerPlan(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	fetch := func(ctx context.Context) (Usage, error) {
+		calls++
+		return Usage{Used: 8, Limit: 10}, nil
+	}
+
+	c := NewChecker(ModeError)
+	assert.NoError(t, c.Plan(ctx, "eip", "EIPs", 1, fetch))
+	assert.NoError(t, c.Plan(ctx, "eip", "EIPs", 1, fetch))
+	err := c.Plan(ctx, "eip", "EIPs", 1, fetch)
+	assert.EqualError(t, err, "quota of EIPs would be exceeded: 8 used and 3 planned to be created, but the limit is 10")
+	var exceeded *ExceededError
+	assert.True(t, errors.As(err, &exceeded))
+	assert.Equal(t, int64(3), exceeded.Planned)
+	assert.Equal(t, 1, calls)
+
+	// the quotas of the other keys are counted separately.
+	assert.NoError(t, c.Plan(ctx, "subnet:vpc-1", "subnets of vpc-1", 2, fetch))
+	assert.Equal(t, 2, calls)
+
+	c = NewChecker(ModeWarn)
+	assert.NoError(t, c.Plan(ctx, "eip", "EIPs", 5, fetch))
+}
+
+func TestCheckerDisabled(t *testing.T) {
+	fetch := func(ctx context.Context) (Usage, error) {
+		t.Fatal("fetched by a disabled checker")
+		return Usage{}, nil
+	}
+
+	var c *Checker
+	assert.False(t, c.Enabled())
+	assert.NoError(t, c.Plan(context.Background(), "eip", "EIPs", 100, fetch))
+	assert.NoError(t, NewChecker("").Plan(context.Background(), "eip", "EIPs", 100, fetch))
+}
+
+func TestCheckerFetchFailed(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context) (Usage, error) {
+		calls++
+		return Usage{}, errors.New("UnauthorizedOperation")
+	}
+
+	c := NewChecker(ModeError)
+	assert.NoError(t, c.Plan(context.Background(), "eip", "EIPs", 100, fetch))
+	assert.NoError(t, c.Plan(context.Background(), "eip", "EIPs", 100, fetch))
+	assert.Equal(t, 1, calls)
+}
+
+func TestCheckerConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	fetch := func(ctx context.Context) (Usage, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return Usage{Used: 0, Limit: 50}, nil
+	}
+
+	c := NewChecker(ModeError)
+	var wg sync.WaitGroup
+	errs := make(chan error, 60)
+	for i := 0; i < 60; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.Plan(context.Background(), "security_group", "security groups", 1, fetch)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	failed := 0
+	for err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	assert.Equal(t, 10, failed)
+	assert.Equal(t, 1, calls)
+}
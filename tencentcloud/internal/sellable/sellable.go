@@ -0,0 +1,181 @@
+// Package sellable checks at plan time that the CVM instance types are sold in the availability
+// zones and with the charge types planned, so that a sold out instance type is found before the
+// apply, and suggests the equivalent instance types sellable instead.
+//
+// The offerings of a region are fetched once per charge type and cached by the Cache, as a plan
+// checks the same few instance types for many resources.
+package sellable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MaxSuggestions is the max number of the equivalent instance types suggested by an UnsellableError.
+const MaxSuggestions = 5
+
+// Offering is an instance type offered in a zone with a charge type.
+type Offering struct {
+	Zone         string
+	InstanceType string
+	ChargeType   string
+	Family       string
+	Cpu          int64
+	Memory       int64
+	Gpu          int64
+	// Sellable is false if the instance type is sold out in the zone.
+	Sellable bool
+}
+
+// UnsellableError is the error of none of the instance types planned being sellable.
+type UnsellableError struct {
+	InstanceTypes []string
+	// Zones is empty if the instance types are checked in all the zones of the region.
+	Zones       []string
+	ChargeType  string
+	Suggestions []string
+}
+
+func (e *UnsellableError) Error() string {
+	var b strings.Builder
+	if len(e.InstanceTypes) == 1 {
+		fmt.Fprintf(&b, "instance type %s is not sellable", e.InstanceTypes[0])
+	} else {
+		fmt.Fprintf(&b, "none of instance types %s is sellable", strings.Join(e.InstanceTypes, ", "))
+	}
+	if len(e.Zones) == 0 {
+		b.WriteString(" in any zone of the region")
+	} else {
+		fmt.Fprintf(&b, " in %s", strings.Join(e.Zones, ", "))
+	}
+	fmt.Fprintf(&b, " with charge type %s", e.ChargeType)
+	if len(e.Suggestions) == 0 {
+		b.WriteString(", and no equivalent instance type is sellable")
+	} else {
+		fmt.Fprintf(&b, ", equivalent sellable instance types: %s", strings.Join(e.Suggestions, ", "))
+	}
+	return b.String()
+}
+
+// Check checks that at least one of instanceTypes is sellable in one of zones, or in any zone if
+// zones is empty, according to offerings of chargeType. The instance types not offered in the
+// region at all are not known to the check, and it passes if none of instanceTypes is known.
+// Otherwise it returns an *UnsellableError suggesting the sellable instance types with the same
+// CPU, memory and GPU as the first known one of instanceTypes, those of its family first.
+func Check(offerings []Offering, chargeType string, zones, instanceTypes []string) error {
+	inZones := func(zone string) bool {
+		if len(zones) == 0 {
+			return true
+		}
+		for _, z := range zones {
+			if z == zone {
+				return true
+			}
+		}
+		return false
+	}
+	types := make(map[string]bool, len(instanceTypes))
+	for _, t := range instanceTypes {
+		types[t] = true
+	}
+
+	known := map[string]*Offering{}
+	for i := range offerings {
+		o := &offerings[i]
+		if o.ChargeType != chargeType || !types[o.InstanceType] {
+			continue
+		}
+		if o.Sellable && inZones(o.Zone) {
+			return nil
+		}
+		if known[o.InstanceType] == nil {
+			known[o.InstanceType] = o
+		}
+	}
+	var spec *Offering
+	for _, t := range instanceTypes {
+		if spec = known[t]; spec != nil {
+			break
+		}
+	}
+	if spec == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	var suggestions []Offering
+	for _, o := range offerings {
+		if o.ChargeType != chargeType || !o.Sellable || !inZones(o.Zone) || types[o.InstanceType] || seen[o.InstanceType] {
+			continue
+		}
+		if o.Cpu != spec.Cpu || o.Memory != spec.Memory || o.Gpu != spec.Gpu {
+			continue
+		}
+		seen[o.InstanceType] = true
+		suggestions = append(suggestions, o)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		si, sj := suggestions[i].Family == spec.Family, suggestions[j].Family == spec.Family
+		if si != sj {
+			return si
+		}
+		return suggestions[i].InstanceType < suggestions[j].InstanceType
+	})
+	if len(suggestions) > MaxSuggestions {
+		suggestions = suggestions[:MaxSuggestions]
+	}
+
+	err := &UnsellableError{InstanceTypes: instanceTypes, Zones: zones, ChargeType: chargeType}
+	for _, o := range suggestions {
+		err.Suggestions = append(err.Suggestions, o.InstanceType)
+	}
+	return err
+}
+
+// FetchFunc fetches the offerings of a charge type in the region.
+type FetchFunc func(ctx context.Context) ([]Offering, error)
+
+// Cache caches the offerings by key, fetching them for the first Get of the key only. A nil Cache
+// fetches them on every Get.
+type Cache struct {
+	mu        sync.Mutex
+	offerings map[string]*entry
+}
+
+type entry struct {
+	once      sync.Once
+	offerings []Offering
+	err       error
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{offerings: map[string]*entry{}}
+}
+
+// Get returns the offerings of key, fetched by fetch if not cached yet. A failure to fetch them is
+// cached as well, so that a plan does not retry it for every resource.
+func (c *Cache) Get(ctx context.Context, key string, fetch FetchFunc) ([]Offering, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	c.mu.Lock()
+	if c.offerings == nil {
+		c.offerings = map[string]*entry{}
+	}
+	e, ok := c.offerings[key]
+	if !ok {
+		e = &entry{}
+		c.offerings[key] = e
+	}
+	c.mu.Unlock()
+
+	e.once.Do(func() {
+		e.offerings, e.err = fetch(ctx)
+	})
+	return e.offerings, e.err
+}
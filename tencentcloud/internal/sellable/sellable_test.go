@@ -0,0 +1,85 @@
+package sellable
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testOfferings = []Offering{
+	{Zone: "ap-guangzhou-3", InstanceType: "S5.MEDIUM4", ChargeType: "POSTPAID_BY_HOUR", Family: "S5", Cpu: 2, Memory: 4, Sellable: false},
+	{Zone: "ap-guangzhou-4", InstanceType: "S5.MEDIUM4", ChargeType: "POSTPAID_BY_HOUR", Family: "S5", Cpu: 2, Memory: 4, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "S5.MEDIUM4", ChargeType: "PREPAID", Family: "S5", Cpu: 2, Memory: 4, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "SA2.MEDIUM4", ChargeType: "POSTPAID_BY_HOUR", Family: "SA2", Cpu: 2, Memory: 4, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "S6.MEDIUM4", ChargeType: "POSTPAID_BY_HOUR", Family: "S6", Cpu: 2, Memory: 4, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "S5.MEDIUM4X", ChargeType: "POSTPAID_BY_HOUR", Family: "S5", Cpu: 2, Memory: 4, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "S5.LARGE8", ChargeType: "POSTPAID_BY_HOUR", Family: "S5", Cpu: 4, Memory: 8, Sellable: true},
+	{Zone: "ap-guangzhou-3", InstanceType: "GN7.2XLARGE32", ChargeType: "POSTPAID_BY_HOUR", Family: "GN7", Cpu: 8, Memory: 32, Gpu: 1, Sellable: false},
+	{Zone: "ap-guangzhou-4", InstanceType: "S6.MEDIUM4", ChargeType: "POSTPAID_BY_HOUR", Family: "S6", Cpu: 2, Memory: 4, Sellable: true},
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check(testOfferings, "POSTPAID_BY_HOUR", []string{"ap-guangzhou-4"}, []string{"S5.MEDIUM4"}))
+	assert.NoError(t, Check(testOfferings, "PREPAID", []string{"ap-guangzhou-3"}, []string{"S5.MEDIUM4"}))
+	// sellable in any of the zones or as any of the instance types.
+	assert.NoError(t, Check(testOfferings, "POSTPAID_BY_HOUR", []string{"ap-guangzhou-3", "ap-guangzhou-4"}, []string{"S5.MEDIUM4"}))
+	assert.NoError(t, Check(testOfferings, "POSTPAID_BY_HOUR", []string{"ap-guangzhou-3"}, []string{"S5.MEDIUM4", "S5.LARGE8"}))
+	assert.NoError(t, Check(testOfferings, "POSTPAID_BY_HOUR", nil, []string{"S5.MEDIUM4"}))
+	// the instance types not offered at all are not known.
+	assert.NoError(t, Check(testOfferings, "POSTPAID_BY_HOUR", []string{"ap-guangzhou-3"}, []string{"S9.MEDIUM4"}))
+
+	err := Check(testOfferings, "POSTPAID_BY_HOUR", []string{"ap-guangzhou-3"}, []string{"S5.MEDIUM4"})
+	assert.EqualError(t, err, "instance type S5.MEDIUM4 is not sellable in ap-guangzhou-3 with charge type POSTPAID_BY_HOUR, equivalent sellable instance types: S5.MEDIUM4X, S6.MEDIUM4, SA2.MEDIUM4")
+	var unsellable *UnsellableError
+	assert.True(t, errors.As(err, &unsellable))
+	assert.Equal(t, []string{"S5.MEDIUM4X", "S6.MEDIUM4", "SA2.MEDIUM4"}, unsellable.Suggestions)
+
+	err = Check(testOfferings, "POSTPAID_BY_HOUR", nil, []string{"S9.2XLARGE32", "GN7.2XLARGE32"})
+	assert.EqualError(t, err, "none of instance types S9.2XLARGE32, GN7.2XLARGE32 is sellable in any zone of the region with charge type POSTPAID_BY_HOUR, and no equivalent instance type is sellable")
+}
+
+func TestCheckMaxSuggestions(t *testing.T) {
+	offerings := []Offering{{Zone: "ap-guangzhou-3", InstanceType: "S5.MEDIUM4", ChargeType: "SPOTPAID", Family: "S5", Cpu: 2, Memory: 4}}
+	for _, family := range []string{"SA5", "SA4", "SA3", "SA2", "S8", "S7", "S6"} {
+		offerings = append(offerings, Offering{Zone: "ap-guangzhou-3", InstanceType: family + ".MEDIUM4", ChargeType: "SPOTPAID", Family: family, Cpu: 2, Memory: 4, Sellable: true})
+	}
+
+	var unsellable *UnsellableError
+	assert.True(t, errors.As(Check(offerings, "SPOTPAID", []string{"ap-guangzhou-3"}, []string{"S5.MEDIUM4"}), &unsellable))
+	assert.Equal(t, []string{"S6.MEDIUM4", "S7.MEDIUM4", "S8.MEDIUM4", "SA2.MEDIUM4", "SA3.MEDIUM4"}, unsellable.Suggestions)
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	fetch := func(ctx context.Context) ([]Offering, error) {
+		calls++
+		return testOfferings, nil
+	}
+
+	c := NewCache()
+	offerings, err := c.Get(ctx, "POSTPAID_BY_HOUR", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, testOfferings, offerings)
+	_, _ = c.Get(ctx, "POSTPAID_BY_HOUR", fetch)
+	assert.Equal(t, 1, calls)
+	_, _ = c.Get(ctx, "PREPAID", fetch)
+	assert.Equal(t, 2, calls)
+
+	failed := func(ctx context.Context) ([]Offering, error) {
+		calls++
+		return nil, errors.New("UnauthorizedOperation")
+	}
+	_, err = c.Get(ctx, "SPOTPAID", failed)
+	assert.EqualError(t, err, "UnauthorizedOperation")
+	_, err = c.Get(ctx, "SPOTPAID", failed)
+	assert.EqualError(t, err, "UnauthorizedOperation")
+	assert.Equal(t, 3, calls)
+
+	var nilCache *Cache
+	_, _ = nilCache.Get(ctx, "POSTPAID_BY_HOUR", fetch)
+	_, _ = nilCache.Get(ctx, "POSTPAID_BY_HOUR", fetch)
+	assert.Equal(t, 5, calls)
+}
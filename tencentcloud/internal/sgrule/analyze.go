@@ -0,0 +1,293 @@
+package sgrule
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+const (
+	FindingShadowed  = "shadowed"
+	FindingDuplicate = "duplicate"
+	FindingWorldOpen = "world_open"
+	// FindingUnanalyzed is the finding of a rule which cannot be parsed and is left out of the analysis.
+	FindingUnanalyzed = "unanalyzed"
+)
+
+// DefaultSensitivePorts are the ports which should never be open to the world.
+var DefaultSensitivePorts = []int{22, 3389}
+
+// Finding is a problem found in a rule set.
+type Finding struct {
+	Type      string
+	Direction string
+	Index     int
+	// Related is the index of the earlier rule causing the finding, -1 if none.
+	Related int
+	Message string
+}
+
+// ParseAll parses the ordered rules of one direction. The rules which cannot be parsed are left
+// out of the result with a FindingUnanalyzed finding each, instead of failing the others.
+func ParseAll(direction string, specs []Spec) ([]*Rule, []Finding) {
+	rules := make([]*Rule, 0, len(specs))
+	findings := make([]Finding, 0)
+	for i, spec := range specs {
+		rule, err := Parse(direction, i, spec)
+		if err != nil {
+			findings = append(findings, Finding{
+				Type:      FindingUnanalyzed,
+				Direction: direction,
+				Index:     i,
+				Related:   -1,
+				Message:   fmt.Sprintf("rule %s.%d is not analyzed: %v", direction, i, err),
+			})
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, findings
+}
+
+// Analyze checks the ordered rules of one direction for shadowed rules, duplicated rules
+// and, for ingress, rules opening sensitive ports to the world.
+// Rules which cannot be evaluated offline never shadow other rules and are never shadowed.
+func Analyze(rules []*Rule, sensitivePorts []int) []Finding {
+	findings := make([]Finding, 0)
+	for j, later := range rules {
+		for _, earlier := range rules[:j] {
+			if !earlier.Covers(later) {
+				continue
+			}
+
+			finding := Finding{
+				Type:      FindingShadowed,
+				Direction: later.Direction,
+				Index:     later.Index,
+				Related:   earlier.Index,
+			}
+			if later.Covers(earlier) && later.Action == earlier.Action {
+				finding.Type = FindingDuplicate
+				finding.Message = fmt.Sprintf("rule %s duplicates rule %s", later, earlier)
+			} else if later.Action == earlier.Action {
+				finding.Message = fmt.Sprintf("rule %s is redundant, traffic is already matched by rule %s", later, earlier)
+			} else {
+				finding.Message = fmt.Sprintf("rule %s never takes effect, traffic is already matched by rule %s", later, earlier)
+			}
+			findings = append(findings, finding)
+			break
+		}
+
+		if later.Direction != DirectionIngress || later.Action != ActionAccept || !later.IsWorldOpen() {
+			continue
+		}
+
+		var (
+			openPorts  = make([]string, 0)
+			maybePorts = make([]string, 0)
+		)
+		for _, port := range sensitivePorts {
+			if !later.CoversPort(port) {
+				continue
+			}
+			dropped, opaque := droppedBefore(rules[:j], later, port)
+			if dropped {
+				continue
+			}
+			openPorts = append(openPorts, fmt.Sprint(port))
+			if opaque != nil {
+				maybePorts = append(maybePorts, fmt.Sprintf("%d by rule %s", port, opaque))
+			}
+		}
+		if len(openPorts) > 0 {
+			message := fmt.Sprintf("rule %s opens sensitive port %s to the world", later, strings.Join(openPorts, ","))
+			if len(maybePorts) > 0 {
+				message += fmt.Sprintf(", unless dropped by the earlier rules which cannot be evaluated offline: port %s", strings.Join(maybePorts, ", port "))
+			}
+			findings = append(findings, Finding{
+				Type:      FindingWorldOpen,
+				Direction: later.Direction,
+				Index:     later.Index,
+				Related:   -1,
+				Message:   message,
+			})
+		}
+	}
+	return findings
+}
+
+// Covers reports whether every traffic matched by other is also matched by r.
+func (r *Rule) Covers(other *Rule) bool {
+	if r.Opaque() || other.Opaque() || r.Direction != other.Direction {
+		return false
+	}
+
+	for _, b := range other.Addresses {
+		covered := false
+		for _, a := range r.Addresses {
+			if a.Contains(b) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+
+	if r.Protocol != ProtocolAll && r.Protocol != other.Protocol {
+		return false
+	}
+	if r.Protocol == ProtocolAll || r.Ports == nil {
+		return true
+	}
+	if other.Ports == nil {
+		return false
+	}
+	for _, port := range other.Ports {
+		if !portsContain(r.Ports, port) {
+			return false
+		}
+	}
+	return true
+}
+
+// Traffic is a connection checked against a rule set.
+type Traffic struct {
+	Address  netip.Addr
+	Protocol string
+	// Port is ignored for ICMP traffic.
+	Port int
+}
+
+// Decision is the result of evaluating traffic against a rule set.
+type Decision struct {
+	Allowed bool
+	// Rule is the rule deciding the traffic, nil when no rule matches.
+	Rule *Rule
+	// Undetermined holds the earlier rules which may match the traffic but cannot be evaluated offline.
+	Undetermined []*Rule
+}
+
+// Evaluate returns the decision of the first rule matching the traffic.
+func Evaluate(rules []*Rule, traffic Traffic) Decision {
+	decision := Decision{}
+	protocol := strings.ToUpper(traffic.Protocol)
+	for _, rule := range rules {
+		if rule.Opaque() {
+			decision.Undetermined = append(decision.Undetermined, rule)
+			continue
+		}
+		if !rule.matches(traffic.Address, protocol, traffic.Port) {
+			continue
+		}
+		decision.Allowed = rule.Action == ActionAccept
+		decision.Rule = rule
+		break
+	}
+	return decision
+}
+
+func (r *Rule) matches(address netip.Addr, protocol string, port int) bool {
+	matched := false
+	for _, addr := range r.Addresses {
+		if addr.ContainsAddr(address) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+
+	if r.Protocol == ProtocolAll {
+		return true
+	}
+	if r.Protocol != protocol {
+		return false
+	}
+	if protocol == ProtocolTCP || protocol == ProtocolUDP {
+		return portsContain(r.Ports, PortRange{From: port, To: port})
+	}
+	return true
+}
+
+// mayCover reports whether the opaque rule r may match the traffic of other, as the addresses or
+// the services it refers to are unknown offline.
+func (r *Rule) mayCover(other *Rule) bool {
+	if !r.Opaque() || r.Direction != other.Direction {
+		return false
+	}
+	if r.ServiceReference != "" || r.Protocol == ProtocolAll {
+		return true
+	}
+	if r.Protocol != other.Protocol {
+		return false
+	}
+	if r.Ports == nil {
+		return true
+	}
+	if other.Ports == nil {
+		return false
+	}
+	for _, port := range other.Ports {
+		if !portsContain(r.Ports, port) {
+			return false
+		}
+	}
+	return true
+}
+
+// droppedBefore reports whether the world open traffic of rule to port is dropped by earlier rules
+// which provably cover it. Otherwise, if every part of the traffic left may be matched by an earlier
+// drop rule which cannot be evaluated offline, such as a drop from a security group, it returns the
+// first of them, as the traffic may be dropped but is never known to be.
+func droppedBefore(earlier []*Rule, rule *Rule, port int) (dropped bool, opaque *Rule) {
+	protocols := []string{rule.Protocol}
+	if rule.Protocol == ProtocolAll {
+		protocols = []string{ProtocolTCP, ProtocolUDP}
+	}
+
+	dropped = true
+	maybe := true
+	for _, addr := range rule.Addresses {
+		if !isFullRange(addr) {
+			continue
+		}
+		for _, protocol := range protocols {
+			probe := &Rule{
+				Direction: rule.Direction,
+				Protocol:  protocol,
+				Ports:     []PortRange{{From: port, To: port}},
+				Addresses: []AddrRange{addr},
+			}
+			covered := false
+			var candidate *Rule
+			for _, e := range earlier {
+				if e.Action != ActionDrop {
+					continue
+				}
+				if e.Covers(probe) {
+					covered = true
+					break
+				}
+				if candidate == nil && e.mayCover(probe) {
+					candidate = e
+				}
+			}
+			if covered {
+				continue
+			}
+			dropped = false
+			if candidate == nil {
+				maybe = false
+			} else if opaque == nil {
+				opaque = candidate
+			}
+		}
+	}
+	if dropped || !maybe {
+		return dropped, nil
+	}
+	return false, opaque
+}
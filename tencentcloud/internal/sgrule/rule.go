@@ -0,0 +1,384 @@
+// Package sgrule parses security group rules and analyzes them offline.
+//
+// Rules are matched top-down: the first rule which matches the traffic decides
+// whether it is accepted or dropped, traffic matching no rule is dropped.
+package sgrule
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ActionAccept = "ACCEPT"
+	ActionDrop   = "DROP"
+
+	ProtocolAll    = "ALL"
+	ProtocolTCP    = "TCP"
+	ProtocolUDP    = "UDP"
+	ProtocolICMP   = "ICMP"
+	ProtocolICMPv6 = "ICMPV6"
+	ProtocolGRE    = "GRE"
+
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+
+	MinPort = 1
+	MaxPort = 65535
+)
+
+// Spec is the raw form of a rule, as declared in `ingress`/`egress` blocks.
+type Spec struct {
+	Action               string
+	Protocol             string
+	Port                 string
+	CidrBlock            string
+	Ipv6CidrBlock        string
+	SourceSecurityId     string
+	AddressTemplateId    string
+	AddressTemplateGroup string
+	ServiceTemplateId    string
+	ServiceTemplateGroup string
+	Description          string
+}
+
+// AddrRange is an inclusive range of addresses of the same family.
+type AddrRange struct {
+	From netip.Addr
+	To   netip.Addr
+}
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	From int
+	To   int
+}
+
+// Rule is a parsed security group rule.
+type Rule struct {
+	Direction string
+	Index     int
+	Action    string
+	Protocol  string
+	// Ports is nil when the rule applies to all ports.
+	Ports []PortRange
+	// Addresses holds the addresses the rule applies to, it is empty when Reference is unresolved.
+	Addresses []AddrRange
+	// Reference is the security group or address template the rule points to.
+	Reference string
+	// ServiceReference is the service template the rule points to, it replaces Protocol and Ports.
+	ServiceReference string
+	Description      string
+}
+
+// Parse parses the rule at index of the given direction.
+func Parse(direction string, index int, spec Spec) (*Rule, error) {
+	rule := &Rule{
+		Direction:   direction,
+		Index:       index,
+		Action:      strings.ToUpper(spec.Action),
+		Description: spec.Description,
+	}
+
+	if rule.Action != ActionAccept && rule.Action != ActionDrop {
+		return nil, fmt.Errorf("%s.%d: invalid action %q, expect `ACCEPT` or `DROP`", direction, index, spec.Action)
+	}
+
+	switch {
+	case spec.CidrBlock != "":
+		addr, err := ParseAddress(spec.CidrBlock)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d: %v", direction, index, err)
+		}
+		rule.Addresses = []AddrRange{addr}
+	case spec.Ipv6CidrBlock != "":
+		addr, err := ParseAddress(spec.Ipv6CidrBlock)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d: %v", direction, index, err)
+		}
+		rule.Addresses = []AddrRange{addr}
+	case spec.SourceSecurityId != "":
+		rule.Reference = spec.SourceSecurityId
+	case spec.AddressTemplateId != "":
+		rule.Reference = spec.AddressTemplateId
+	case spec.AddressTemplateGroup != "":
+		rule.Reference = spec.AddressTemplateGroup
+	}
+
+	switch {
+	case spec.ServiceTemplateId != "":
+		rule.ServiceReference = spec.ServiceTemplateId
+	case spec.ServiceTemplateGroup != "":
+		rule.ServiceReference = spec.ServiceTemplateGroup
+	default:
+		protocol, err := parseProtocol(spec.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d: %v", direction, index, err)
+		}
+		rule.Protocol = protocol
+
+		ports, err := ParsePorts(spec.Port)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d: %v", direction, index, err)
+		}
+		if protocol == ProtocolTCP || protocol == ProtocolUDP {
+			rule.Ports = ports
+		}
+	}
+
+	return rule, nil
+}
+
+// Resolve replaces the address reference of the rule with the addresses returned by resolve.
+// A nil result from resolve keeps the reference unresolved.
+func (r *Rule) Resolve(resolve func(reference string) ([]string, error)) error {
+	if r.Reference == "" || resolve == nil {
+		return nil
+	}
+
+	addresses, err := resolve(r.Reference)
+	if err != nil {
+		return err
+	}
+	if addresses == nil {
+		return nil
+	}
+
+	ranges := make([]AddrRange, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := ParseAddress(address)
+		if err != nil {
+			return fmt.Errorf("%s.%d: %s: %v", r.Direction, r.Index, r.Reference, err)
+		}
+		ranges = append(ranges, addr)
+	}
+
+	r.Addresses = ranges
+	r.Reference = ""
+	return nil
+}
+
+// Opaque reports whether the addresses or services of the rule cannot be evaluated offline.
+func (r *Rule) Opaque() bool {
+	return len(r.Addresses) == 0 || r.ServiceReference != ""
+}
+
+// IsWorldOpen reports whether the rule applies to every IPv4 or IPv6 address.
+func (r *Rule) IsWorldOpen() bool {
+	for _, addr := range r.Addresses {
+		if isFullRange(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CoversPort reports whether the rule applies to the port of TCP or UDP traffic.
+func (r *Rule) CoversPort(port int) bool {
+	if r.ServiceReference != "" {
+		return false
+	}
+	switch r.Protocol {
+	case ProtocolAll:
+		return true
+	case ProtocolTCP, ProtocolUDP:
+		return portsContain(r.Ports, PortRange{From: port, To: port})
+	}
+	return false
+}
+
+func (r *Rule) String() string {
+	var (
+		address = r.Reference
+		service = r.ServiceReference
+	)
+
+	if len(r.Addresses) > 0 {
+		items := make([]string, 0, len(r.Addresses))
+		for _, addr := range r.Addresses {
+			items = append(items, addr.String())
+		}
+		address = strings.Join(items, ",")
+	}
+
+	if service == "" {
+		service = r.Protocol
+		if r.Ports != nil {
+			items := make([]string, 0, len(r.Ports))
+			for _, port := range r.Ports {
+				items = append(items, port.String())
+			}
+			service += ":" + strings.Join(items, ",")
+		}
+	}
+
+	return fmt.Sprintf("%s.%d(%s %s %s)", r.Direction, r.Index, r.Action, address, service)
+}
+
+// ParseAddress parses an IP, a CIDR or an IP range like `10.0.0.1-10.0.0.9`.
+func ParseAddress(s string) (AddrRange, error) {
+	s = strings.TrimSpace(s)
+
+	if from, to, ok := strings.Cut(s, "-"); ok {
+		start, err := netip.ParseAddr(strings.TrimSpace(from))
+		if err != nil {
+			return AddrRange{}, fmt.Errorf("invalid address range %q", s)
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(to))
+		if err != nil {
+			return AddrRange{}, fmt.Errorf("invalid address range %q", s)
+		}
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return AddrRange{}, fmt.Errorf("invalid address range %q", s)
+		}
+		return AddrRange{From: start, To: end}, nil
+	}
+
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return AddrRange{}, fmt.Errorf("invalid CIDR %q", s)
+		}
+		return prefixRange(prefix.Masked()), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return AddrRange{}, fmt.Errorf("invalid address %q", s)
+	}
+	return AddrRange{From: addr, To: addr}, nil
+}
+
+// ParsePorts parses `all`, a port, a port range or a comma separated list of them.
+// It returns nil for all ports.
+func ParsePorts(s string) ([]PortRange, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "all") {
+		return nil, nil
+	}
+
+	ports := make([]PortRange, 0)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		from, to, isRange := strings.Cut(item, "-")
+		start, err := parsePort(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", s)
+		}
+		end := start
+		if isRange {
+			if end, err = parsePort(to); err != nil || end < start {
+				return nil, fmt.Errorf("invalid port %q", s)
+			}
+		}
+		ports = append(ports, PortRange{From: start, To: end})
+	}
+
+	return mergePorts(ports), nil
+}
+
+func (a AddrRange) Contains(b AddrRange) bool {
+	if a.From.Is4() != b.From.Is4() {
+		return false
+	}
+	return !b.From.Less(a.From) && !a.To.Less(b.To)
+}
+
+func (a AddrRange) ContainsAddr(addr netip.Addr) bool {
+	return a.Contains(AddrRange{From: addr, To: addr})
+}
+
+func (a AddrRange) String() string {
+	if a.From == a.To {
+		return a.From.String()
+	}
+	for bits := 0; bits <= a.From.BitLen(); bits++ {
+		prefix := netip.PrefixFrom(a.From, bits).Masked()
+		if prefix.Addr() == a.From && prefixRange(prefix).To == a.To {
+			return prefix.String()
+		}
+	}
+	return a.From.String() + "-" + a.To.String()
+}
+
+func (p PortRange) String() string {
+	if p.From == p.To {
+		return strconv.Itoa(p.From)
+	}
+	return fmt.Sprintf("%d-%d", p.From, p.To)
+}
+
+func parseProtocol(s string) (string, error) {
+	protocol := strings.ToUpper(strings.TrimSpace(s))
+	switch protocol {
+	case "":
+		return ProtocolAll, nil
+	case ProtocolAll, ProtocolTCP, ProtocolUDP, ProtocolICMP, ProtocolICMPv6, ProtocolGRE:
+		return protocol, nil
+	}
+	return "", fmt.Errorf("invalid protocol %q", s)
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || port < MinPort || port > MaxPort {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return port, nil
+}
+
+func prefixRange(prefix netip.Prefix) AddrRange {
+	from := prefix.Addr()
+	raw := from.AsSlice()
+	hostBits := from.BitLen() - prefix.Bits()
+	for i := len(raw) - 1; i >= 0 && hostBits > 0; i-- {
+		if hostBits >= 8 {
+			raw[i] = 0xff
+			hostBits -= 8
+			continue
+		}
+		raw[i] |= byte(1<<hostBits) - 1
+		hostBits = 0
+	}
+	to, _ := netip.AddrFromSlice(raw)
+	return AddrRange{From: from, To: to}
+}
+
+func isFullRange(a AddrRange) bool {
+	if a.From.Is4() {
+		return a == prefixRange(netip.MustParsePrefix("0.0.0.0/0"))
+	}
+	return a == prefixRange(netip.MustParsePrefix("::/0"))
+}
+
+func mergePorts(ports []PortRange) []PortRange {
+	sort.Slice(ports, func(i, j int) bool { return ports[i].From < ports[j].From })
+	merged := make([]PortRange, 0, len(ports))
+	for _, port := range ports {
+		last := len(merged) - 1
+		if last >= 0 && port.From <= merged[last].To+1 {
+			if port.To > merged[last].To {
+				merged[last].To = port.To
+			}
+			continue
+		}
+		merged = append(merged, port)
+	}
+	return merged
+}
+
+// portsContain reports whether b is inside the merged ranges of a, nil means all ports.
+func portsContain(a []PortRange, b PortRange) bool {
+	if a == nil {
+		return true
+	}
+	for _, port := range a {
+		if port.From <= b.From && b.To <= port.To {
+			return true
+		}
+	}
+	return false
+}
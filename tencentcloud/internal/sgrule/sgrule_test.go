@@ -0,0 +1,340 @@
+package sgrule
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, direction string, specs ...Spec) []*Rule {
+	rules := make([]*Rule, 0, len(specs))
+	for i, spec := range specs {
+		rule, err := Parse(direction, i, spec)
+		if err != nil {
+			t.Fatalf("parse rule %d: %v", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func TestParsePorts(t *testing.T) {
+	tests := []struct {
+		in      string
+		out     []PortRange
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"ALL", nil, false},
+		{"all", nil, false},
+		{"80", []PortRange{{80, 80}}, false},
+		{"80-90", []PortRange{{80, 90}}, false},
+		{"443,80", []PortRange{{80, 80}, {443, 443}}, false},
+		{"80-90,85-100,101", []PortRange{{80, 101}}, false},
+		{"0", nil, true},
+		{"65536", nil, true},
+		{"90-80", nil, true},
+		{"http", nil, true},
+	}
+
+	for _, tt := range tests {
+		out, err := ParsePorts(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.out, out, tt.in)
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		in      string
+		from    string
+		to      string
+		str     string
+		wantErr bool
+	}{
+		{"10.0.0.1", "10.0.0.1", "10.0.0.1", "10.0.0.1", false},
+		{"10.0.0.0/24", "10.0.0.0", "10.0.0.255", "10.0.0.0/24", false},
+		{"10.0.0.7/24", "10.0.0.0", "10.0.0.255", "10.0.0.0/24", false},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255", "0.0.0.0/0", false},
+		{"10.0.0.1-10.0.0.9", "10.0.0.1", "10.0.0.9", "10.0.0.1-10.0.0.9", false},
+		{"2001:db8::/32", "2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", "2001:db8::/32", false},
+		{"10.0.0.9-10.0.0.1", "", "", "", true},
+		{"10.0.0.1-2001:db8::1", "", "", "", true},
+		{"10.0.0.0/33", "", "", "", true},
+		{"example.com", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		out, err := ParseAddress(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, netip.MustParseAddr(tt.from), out.From, tt.in)
+		assert.Equal(t, netip.MustParseAddr(tt.to), out.To, tt.in)
+		assert.Equal(t, tt.str, out.String(), tt.in)
+	}
+}
+
+func TestParseInvalidRule(t *testing.T) {
+	_, err := Parse(DirectionIngress, 0, Spec{Action: "ALLOW", CidrBlock: "10.0.0.0/8"})
+	assert.Error(t, err)
+
+	_, err = Parse(DirectionIngress, 0, Spec{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "SCTP"})
+	assert.Error(t, err)
+
+	_, err = Parse(DirectionIngress, 0, Spec{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "TCP", Port: "80-"})
+	assert.Error(t, err)
+}
+
+func TestParseAll(t *testing.T) {
+	rules, findings := ParseAll(DirectionIngress, []Spec{
+		{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "TCP", Port: "22"},
+		{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "TCP", Port: "80-"},
+		{Action: "ACCEPT", CidrBlock: "10.0.0.0/16", Protocol: "TCP", Port: "22"},
+	})
+	if assert.Len(t, rules, 2) {
+		assert.Equal(t, 0, rules[0].Index)
+		assert.Equal(t, 2, rules[1].Index)
+	}
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingUnanalyzed, findings[0].Type)
+		assert.Equal(t, 1, findings[0].Index)
+		assert.Equal(t, "rule ingress.1 is not analyzed: ingress.1: invalid port \"80-\"", findings[0].Message)
+	}
+
+	// the other rules are still analyzed.
+	findings = Analyze(rules, nil)
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingShadowed, findings[0].Type)
+		assert.Equal(t, 2, findings[0].Index)
+	}
+}
+
+func TestAnalyzeUnresolvedDrop(t *testing.T) {
+	// an earlier drop of an unresolved address template may drop the world open traffic, which is
+	// still reported as it is never known to be dropped.
+	rules := mustParse(t, DirectionIngress,
+		Spec{Action: "DROP", AddressTemplateId: "ipm-12345678", Protocol: "TCP", Port: "22"},
+		Spec{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "22,80"},
+	)
+	if findings := Analyze(rules, []int{22}); assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingWorldOpen, findings[0].Type)
+		assert.Contains(t, findings[0].Message, "sensitive port 22 ")
+		assert.Contains(t, findings[0].Message, "unless dropped by the earlier rules which cannot be evaluated offline: port 22 by rule")
+	}
+	if findings := Analyze(rules, []int{22, 80}); assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingWorldOpen, findings[0].Type)
+		assert.Contains(t, findings[0].Message, "sensitive port 22,80 ")
+		assert.NotContains(t, findings[0].Message, "port 80 by rule")
+	}
+}
+
+func TestAnalyzeSecurityGroupDrop(t *testing.T) {
+	// a drop from a security group only drops the traffic of its members, never the world open one.
+	rules := mustParse(t, DirectionIngress,
+		Spec{Action: "DROP", SourceSecurityId: "sg-12345678", Protocol: "ALL"},
+		Spec{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "22"},
+	)
+	if findings := Analyze(rules, DefaultSensitivePorts); assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingWorldOpen, findings[0].Type)
+		assert.Equal(t, 1, findings[0].Index)
+		assert.Contains(t, findings[0].Message, "port 22 by rule")
+	}
+
+	// a later drop of the world does not help either.
+	rules = mustParse(t, DirectionIngress,
+		Spec{Action: "DROP", SourceSecurityId: "sg-12345678", Protocol: "ALL"},
+		Spec{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "22"},
+		Spec{Action: "DROP", CidrBlock: "0.0.0.0/0", Protocol: "ALL"},
+	)
+	findings := Analyze(rules, DefaultSensitivePorts)
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingWorldOpen, findings[0].Type)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction string
+		specs     []Spec
+		expected  []Finding
+	}{
+		{
+			name:      "no finding",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/24", Protocol: "TCP", Port: "22"},
+				{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "80,443"},
+				{Action: "DROP", CidrBlock: "0.0.0.0/0", Protocol: "ALL", Port: "ALL"},
+			},
+			expected: []Finding{},
+		},
+		{
+			name:      "duplicate",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/24", Protocol: "tcp", Port: "80,443"},
+				{Action: "accept", CidrBlock: "10.0.0.5/24", Protocol: "TCP", Port: "443,80"},
+			},
+			expected: []Finding{{Type: FindingDuplicate, Direction: DirectionIngress, Index: 1, Related: 0}},
+		},
+		{
+			name:      "shadowed by drop",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "DROP", CidrBlock: "10.0.0.0/8", Protocol: "ALL", Port: "ALL"},
+				{Action: "ACCEPT", CidrBlock: "10.1.0.0/16", Protocol: "TCP", Port: "8080"},
+			},
+			expected: []Finding{{Type: FindingShadowed, Direction: DirectionIngress, Index: 1, Related: 0}},
+		},
+		{
+			name:      "redundant by wider port range",
+			direction: DirectionEgress,
+			specs: []Spec{
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "UDP", Port: "1-1024"},
+				{Action: "ACCEPT", CidrBlock: "10.1.1.1", Protocol: "UDP", Port: "53"},
+			},
+			expected: []Finding{{Type: FindingShadowed, Direction: DirectionEgress, Index: 1, Related: 0}},
+		},
+		{
+			name:      "protocol mismatch is not shadowed",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "DROP", CidrBlock: "10.0.0.0/8", Protocol: "UDP", Port: "ALL"},
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", Protocol: "TCP", Port: "53"},
+			},
+			expected: []Finding{},
+		},
+		{
+			name:      "address family mismatch is not shadowed",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "DROP", CidrBlock: "0.0.0.0/0", Protocol: "ALL"},
+				{Action: "ACCEPT", Ipv6CidrBlock: "2001:db8::/32", Protocol: "TCP", Port: "443"},
+			},
+			expected: []Finding{},
+		},
+		{
+			name:      "references are never compared",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "ACCEPT", AddressTemplateId: "ipm-12345678", Protocol: "ALL"},
+				{Action: "ACCEPT", AddressTemplateId: "ipm-12345678", Protocol: "ALL"},
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", ServiceTemplateId: "ppm-12345678"},
+				{Action: "ACCEPT", CidrBlock: "10.0.0.0/8", ServiceTemplateId: "ppm-12345678"},
+			},
+			expected: []Finding{},
+		},
+		{
+			name:      "world open sensitive port",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "20-25"},
+				{Action: "ACCEPT", Ipv6CidrBlock: "::/0", Protocol: "ALL"},
+			},
+			expected: []Finding{
+				{Type: FindingWorldOpen, Direction: DirectionIngress, Index: 0, Related: -1},
+				{Type: FindingWorldOpen, Direction: DirectionIngress, Index: 1, Related: -1},
+			},
+		},
+		{
+			name:      "world open sensitive port dropped before",
+			direction: DirectionIngress,
+			specs: []Spec{
+				{Action: "DROP", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "22,3389"},
+				{Action: "DROP", CidrBlock: "0.0.0.0/0", Protocol: "UDP", Port: "3389"},
+				{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "UDP", Port: "22"},
+				{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "ALL"},
+			},
+			expected: []Finding{
+				{Type: FindingWorldOpen, Direction: DirectionIngress, Index: 2, Related: -1},
+				{Type: FindingWorldOpen, Direction: DirectionIngress, Index: 3, Related: -1},
+			},
+		},
+		{
+			name:      "world open egress is allowed",
+			direction: DirectionEgress,
+			specs: []Spec{
+				{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "ALL"},
+			},
+			expected: []Finding{},
+		},
+	}
+
+	for _, tt := range tests {
+		findings := Analyze(mustParse(t, tt.direction, tt.specs...), DefaultSensitivePorts)
+		for i := range findings {
+			assert.NotEmpty(t, findings[i].Message, tt.name)
+			findings[i].Message = ""
+		}
+		assert.Equal(t, tt.expected, findings, tt.name)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := mustParse(t, DirectionIngress,
+		Spec{Action: "ACCEPT", SourceSecurityId: "sg-12345678", Protocol: "ALL"},
+		Spec{Action: "ACCEPT", CidrBlock: "10.0.0.0/24", Protocol: "TCP", Port: "22"},
+		Spec{Action: "DROP", CidrBlock: "10.0.0.0/8", Protocol: "TCP", Port: "22"},
+		Spec{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "TCP", Port: "80,443"},
+		Spec{Action: "ACCEPT", CidrBlock: "0.0.0.0/0", Protocol: "ICMP"},
+	)
+
+	tests := []struct {
+		traffic Traffic
+		allowed bool
+		index   int
+	}{
+		{Traffic{netip.MustParseAddr("10.0.0.9"), "TCP", 22}, true, 1},
+		{Traffic{netip.MustParseAddr("10.0.1.9"), "tcp", 22}, false, 2},
+		{Traffic{netip.MustParseAddr("1.1.1.1"), "TCP", 443}, true, 3},
+		{Traffic{netip.MustParseAddr("1.1.1.1"), "UDP", 443}, false, -1},
+		{Traffic{netip.MustParseAddr("1.1.1.1"), "ICMP", 0}, true, 4},
+		{Traffic{netip.MustParseAddr("2001:db8::1"), "TCP", 443}, false, -1},
+	}
+
+	for _, tt := range tests {
+		decision := Evaluate(rules, tt.traffic)
+		assert.Equal(t, tt.allowed, decision.Allowed, tt.traffic)
+		if tt.index < 0 {
+			assert.Nil(t, decision.Rule, tt.traffic)
+		} else if assert.NotNil(t, decision.Rule, tt.traffic) {
+			assert.Equal(t, tt.index, decision.Rule.Index, tt.traffic)
+		}
+		if assert.Len(t, decision.Undetermined, 1) {
+			assert.Equal(t, "sg-12345678", decision.Undetermined[0].Reference)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	rules := mustParse(t, DirectionIngress,
+		Spec{Action: "ACCEPT", AddressTemplateGroup: "ipmg-12345678", Protocol: "TCP", Port: "22"},
+		Spec{Action: "ACCEPT", CidrBlock: "10.0.0.0/24", Protocol: "TCP", Port: "22"},
+	)
+
+	err := rules[0].Resolve(func(reference string) ([]string, error) {
+		assert.Equal(t, "ipmg-12345678", reference)
+		return []string{"10.0.0.0/16", "192.168.0.1-192.168.0.10"}, nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, rules[0].Opaque())
+
+	findings := Analyze(rules, nil)
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, FindingShadowed, findings[0].Type)
+	}
+
+	decision := Evaluate(rules, Traffic{Address: netip.MustParseAddr("192.168.0.5"), Protocol: "TCP", Port: 22})
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Rule.Index)
+	assert.Empty(t, decision.Undetermined)
+}
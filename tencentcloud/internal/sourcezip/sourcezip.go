@@ -0,0 +1,199 @@
+// Package sourcezip packages a source directory into a deterministic zip archive.
+//
+// The same files always produce the same archive: the entries are sorted by path, every entry
+// has a fixed modification time, and file modes are normalized to 0644, or 0755 for executables.
+// So the hash of the archive only changes when the content of the packaged files does.
+package sourcezip
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// modified is the time of every entry, the earliest time the zip format can hold.
+var modified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Build packages the files under dir which match any of includes, all files when includes is
+// empty, and none of excludes. Patterns are slash separated paths relative to dir, see Match.
+func Build(dir string, includes, excludes []string) ([]byte, error) {
+	for _, pattern := range append(append([]string{}, includes...), excludes...) {
+		if err := ValidatePattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := collect(dir, includes, excludes)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file to package in %s", dir)
+	}
+
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for _, name := range files {
+		if err := add(writer, dir, name); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Hash returns the base64 encoded SHA-256 of content.
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ValidatePattern reports whether pattern is well formed.
+func ValidatePattern(pattern string) error {
+	if pattern == "" || strings.HasPrefix(pattern, "/") {
+		return fmt.Errorf("pattern %q must be a path relative to the source directory", pattern)
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Match reports whether the slash separated name, or one of its parent directories, matches
+// pattern. Each segment of pattern follows path.Match, and a `**` segment matches any number of
+// directories, so `**/*.pyc` matches `a.pyc` and `a/b/c.pyc`, and `.git` matches `.git/config`.
+func Match(pattern, name string) bool {
+	patterns := strings.Split(pattern, "/")
+	names := strings.Split(name, "/")
+	for i := len(names); i > 0; i-- {
+		if matchSegments(patterns, names[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if matchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(names) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(patterns[0], names[0]); !ok {
+			return false
+		}
+		patterns, names = patterns[1:], names[1:]
+	}
+	return len(names) == 0
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// collect returns the sorted slash separated paths of the files to be packaged.
+func collect(dir string, includes, excludes []string) ([]string, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, current)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+
+		if matchAny(excludes, name) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		// symbolic links are packaged as the files they point to.
+		info, err := os.Stat(current)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if len(includes) > 0 && !matchAny(includes, name) {
+			return nil
+		}
+		files = append(files, name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func add(writer *zip.Writer, dir, name string) error {
+	file, err := os.Open(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	mode := fs.FileMode(0644)
+	if info.Mode()&0111 != 0 {
+		mode = 0755
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	}
+	header.SetMode(mode)
+
+	entry, err := writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(entry, file); err != nil {
+		return fmt.Errorf("read %s failed: %v", name, err)
+	}
+	return nil
+}
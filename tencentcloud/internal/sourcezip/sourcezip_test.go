@@ -0,0 +1,118 @@
+package sourcezip
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		expect  bool
+	}{
+		{"index.js", "index.js", true},
+		{"*.js", "index.js", true},
+		{"*.js", "lib/index.js", false},
+		{"**/*.js", "index.js", true},
+		{"**/*.js", "lib/util/index.js", true},
+		{"lib/**", "lib/util/index.js", true},
+		{"lib/**/index.js", "lib/index.js", true},
+		{"lib/**/index.js", "src/index.js", false},
+		{".git", ".git/objects/ab", true},
+		{"node_modules", "lib/node_modules/a.js", false},
+		{"**/node_modules", "lib/node_modules/a.js", true},
+		{"test_*.py", "test_main.py", true},
+		{"test_?.py", "test_main.py", false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expect, Match(c.pattern, c.name), "%s %s", c.pattern, c.name)
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	assert.NoError(t, ValidatePattern("**/*.py"))
+	assert.Error(t, ValidatePattern(""))
+	assert.Error(t, ValidatePattern("/abs/*.py"))
+	assert.Error(t, ValidatePattern("lib/[a-"))
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func entries(t *testing.T, content []byte) []string {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(reader.File))
+	for _, f := range reader.File {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestBuild(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"index.py":             "def main_handler(event, context): pass",
+		"lib/util.py":          "x = 1",
+		"lib/util.pyc":         "compiled",
+		"lib.txt":              "sorted before lib/",
+		".git/HEAD":            "ref: refs/heads/main",
+		"tests/test_index.py":  "",
+		"tests/data/fixture.1": "",
+	})
+	if err := os.Chmod(filepath.Join(dir, "index.py"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := Build(dir, nil, []string{".git", "tests", "**/*.pyc"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"index.py", "lib.txt", "lib/util.py"}, entries(t, first))
+
+	reader, _ := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	assert.Equal(t, os.FileMode(0755), reader.File[0].Mode())
+	assert.Equal(t, os.FileMode(0644), reader.File[1].Mode())
+
+	// touching the files changes nothing.
+	later := time.Now().Add(time.Hour)
+	_ = os.Chtimes(filepath.Join(dir, "lib/util.py"), later, later)
+	second, err := Build(dir, nil, []string{".git", "tests", "**/*.pyc"})
+	assert.NoError(t, err)
+	assert.Equal(t, Hash(first), Hash(second))
+
+	// changing the content does.
+	writeFiles(t, dir, map[string]string{"lib/util.py": "x = 2"})
+	third, err := Build(dir, nil, []string{".git", "tests", "**/*.pyc"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, Hash(first), Hash(third))
+
+	included, err := Build(dir, []string{"**/*.py"}, []string{"tests"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"index.py", "lib/util.py"}, entries(t, included))
+
+	_, err = Build(dir, []string{"*.go"}, nil)
+	assert.Error(t, err)
+
+	_, err = Build(dir, nil, []string{"[a-"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Package stateupgrade migrates the states of resources across breaking changes of their schemas.
+//
+// Within a resource, a change of the type or the name of an attribute bumps the SchemaVersion of
+// the resource, and the state of the prior version is upgraded by an Upgrader made of steps:
+//
+//	SchemaVersion: 1,
+//	StateUpgraders: []schema.StateUpgrader{
+//		stateupgrade.Upgrader(0, resourceTencentCloudFooV0(), stateupgrade.Rename("backup_model", "backup_method")),
+//	},
+//
+// where resourceTencentCloudFooV0 returns the resource with the schema of version 0.
+//
+// A resource which has been replaced by another one, such as `tencentcloud_ccn_attachment` by
+// `tencentcloud_ccn_attachment_v2`, declares a Move, by which the states are removed from the
+// former and imported to the latter.
+package stateupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Step changes the raw state of a resource in place.
+type Step func(ctx context.Context, rawState map[string]interface{}, meta interface{}) error
+
+// Upgrader returns the upgrader of the state of version, whose schema is the one of prior, by the
+// steps in order.
+func Upgrader(version int, prior *schema.Resource, steps ...Step) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    prior.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				rawState = map[string]interface{}{}
+			}
+			for _, step := range steps {
+				if err := step(ctx, rawState, meta); err != nil {
+					return nil, fmt.Errorf("upgrade state of version %d failed, %v", version, err)
+				}
+			}
+			return rawState, nil
+		},
+	}
+}
+
+// Rename moves the value of the attribute from to the attribute to.
+func Rename(from, to string) Step {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) error {
+		if value, ok := rawState[from]; ok {
+			rawState[to] = value
+			delete(rawState, from)
+		}
+		return nil
+	}
+}
+
+// Remove removes the attributes of names.
+func Remove(names ...string) Step {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) error {
+		for _, name := range names {
+			delete(rawState, name)
+		}
+		return nil
+	}
+}
+
+// Default sets the attribute of name to value when it is absent or null.
+func Default(name string, value interface{}) Step {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) error {
+		if rawState[name] == nil {
+			rawState[name] = value
+		}
+		return nil
+	}
+}
+
+// Convert replaces the value of the attribute of name by fn, a null value is left as it is.
+func Convert(name string, fn func(value interface{}) (interface{}, error)) Step {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) error {
+		value := rawState[name]
+		if value == nil {
+			return nil
+		}
+		converted, err := fn(value)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %v", name, err)
+		}
+		rawState[name] = converted
+		return nil
+	}
+}
+
+// ToList turns the attribute of name into a list of its value, such as a string attribute which
+// becomes a list of strings, or a nested block which has become a list block of MaxItems 1.
+func ToList(name string) Step {
+	return Convert(name, func(value interface{}) (interface{}, error) {
+		if list, ok := value.([]interface{}); ok {
+			return list, nil
+		}
+		return []interface{}{value}, nil
+	})
+}
+
+// FromList turns the list attribute of name, which has at most one element, into its element.
+func FromList(name string) Step {
+	return Convert(name, func(value interface{}) (interface{}, error) {
+		list, ok := value.([]interface{})
+		if !ok {
+			return value, nil
+		}
+		switch len(list) {
+		case 0:
+			return nil, nil
+		case 1:
+			return list[0], nil
+		}
+		return nil, fmt.Errorf("%d elements cannot be turned into one", len(list))
+	})
+}
+
+// ToString turns the number or bool attribute of name into a string.
+func ToString(name string) Step {
+	return Convert(name, func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case json.Number:
+			return v.String(), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+		return nil, fmt.Errorf("%T cannot be turned into a string", value)
+	})
+}
+
+// ToNumber turns the string attribute of name into a number, an empty string becomes null.
+func ToNumber(name string) Step {
+	return Convert(name, func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case float64, json.Number:
+			return v, nil
+		case string:
+			if v == "" {
+				return nil, nil
+			}
+			number, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", v)
+			}
+			return number, nil
+		}
+		return nil, fmt.Errorf("%T cannot be turned into a number", value)
+	})
+}
+
+// Move moves the states of the resource From to the resource To, which has replaced it. As they
+// are different resources, the states cannot be upgraded, but are removed from From and imported
+// to To, with the import ids returned by ImportIds for the attributes of an instance of From.
+// An instance of From may become several ones of To.
+type Move struct {
+	From      string
+	To        string
+	ImportIds func(attributes map[string]interface{}) ([]string, error)
+}
+
+// String returns the attribute of name of an instance, and an error when it is absent.
+func String(attributes map[string]interface{}, name string) (string, error) {
+	value, ok := attributes[name].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("attribute %s is not set", name)
+	}
+	return value, nil
+}
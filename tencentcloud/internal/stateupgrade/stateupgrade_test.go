@@ -0,0 +1,201 @@
+package stateupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSteps(t *testing.T) {
+	cases := []struct {
+		name    string
+		step    Step
+		state   map[string]interface{}
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "rename",
+			step:  Rename("backup_model", "backup_method"),
+			state: map[string]interface{}{"id": "cdb-1", "backup_model": "physical"},
+			want:  map[string]interface{}{"id": "cdb-1", "backup_method": "physical"},
+		},
+		{
+			name:  "rename absent",
+			step:  Rename("backup_model", "backup_method"),
+			state: map[string]interface{}{"id": "cdb-1"},
+			want:  map[string]interface{}{"id": "cdb-1"},
+		},
+		{
+			name:  "remove",
+			step:  Remove("a", "b"),
+			state: map[string]interface{}{"a": 1.0, "b": "x", "c": true},
+			want:  map[string]interface{}{"c": true},
+		},
+		{
+			name:  "default absent",
+			step:  Default("kube_type", 1.0),
+			state: map[string]interface{}{},
+			want:  map[string]interface{}{"kube_type": 1.0},
+		},
+		{
+			name:  "default null",
+			step:  Default("kube_type", 1.0),
+			state: map[string]interface{}{"kube_type": nil},
+			want:  map[string]interface{}{"kube_type": 1.0},
+		},
+		{
+			name:  "default set",
+			step:  Default("kube_type", 1.0),
+			state: map[string]interface{}{"kube_type": 2.0},
+			want:  map[string]interface{}{"kube_type": 2.0},
+		},
+		{
+			name:  "to list scalar",
+			step:  ToList("subnet_id"),
+			state: map[string]interface{}{"subnet_id": "subnet-1"},
+			want:  map[string]interface{}{"subnet_id": []interface{}{"subnet-1"}},
+		},
+		{
+			name:  "to list block",
+			step:  ToList("config"),
+			state: map[string]interface{}{"config": map[string]interface{}{"a": "b"}},
+			want:  map[string]interface{}{"config": []interface{}{map[string]interface{}{"a": "b"}}},
+		},
+		{
+			name:  "to list null",
+			step:  ToList("subnet_id"),
+			state: map[string]interface{}{"subnet_id": nil},
+			want:  map[string]interface{}{"subnet_id": nil},
+		},
+		{
+			name:  "from list",
+			step:  FromList("config"),
+			state: map[string]interface{}{"config": []interface{}{"x"}},
+			want:  map[string]interface{}{"config": "x"},
+		},
+		{
+			name:  "from empty list",
+			step:  FromList("config"),
+			state: map[string]interface{}{"config": []interface{}{}},
+			want:  map[string]interface{}{"config": nil},
+		},
+		{
+			name:    "from long list",
+			step:    FromList("config"),
+			state:   map[string]interface{}{"config": []interface{}{"x", "y"}},
+			wantErr: "attribute config: 2 elements cannot be turned into one",
+		},
+		{
+			name:  "to string",
+			step:  ToString("port"),
+			state: map[string]interface{}{"port": 8080.0},
+			want:  map[string]interface{}{"port": "8080"},
+		},
+		{
+			name:  "to string json number",
+			step:  ToString("port"),
+			state: map[string]interface{}{"port": json.Number("8080")},
+			want:  map[string]interface{}{"port": "8080"},
+		},
+		{
+			name:  "to string bool",
+			step:  ToString("enabled"),
+			state: map[string]interface{}{"enabled": true},
+			want:  map[string]interface{}{"enabled": "true"},
+		},
+		{
+			name:    "to string list",
+			step:    ToString("port"),
+			state:   map[string]interface{}{"port": []interface{}{}},
+			wantErr: "attribute port: []interface {} cannot be turned into a string",
+		},
+		{
+			name:  "to number",
+			step:  ToNumber("port"),
+			state: map[string]interface{}{"port": "80"},
+			want:  map[string]interface{}{"port": 80.0},
+		},
+		{
+			name:  "to number empty",
+			step:  ToNumber("port"),
+			state: map[string]interface{}{"port": ""},
+			want:  map[string]interface{}{"port": nil},
+		},
+		{
+			name:    "to number invalid",
+			step:    ToNumber("port"),
+			state:   map[string]interface{}{"port": "http"},
+			wantErr: `attribute port: "http" is not a number`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.step(context.TODO(), c.state, nil)
+			if c.wantErr != "" {
+				assert.EqualError(t, err, c.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.want, c.state)
+		})
+	}
+}
+
+func TestUpgrader(t *testing.T) {
+	prior := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"backup_model": {Type: schema.TypeString, Optional: true},
+			"port":         {Type: schema.TypeString, Optional: true},
+		},
+	}
+	upgrader := Upgrader(0, prior, Rename("backup_model", "backup_method"), ToNumber("port"))
+	assert.Equal(t, 0, upgrader.Version)
+	assert.True(t, upgrader.Type.HasAttribute("backup_model"))
+
+	state, err := upgrader.Upgrade(context.TODO(), map[string]interface{}{"id": "cdb-1", "backup_model": "physical", "port": "3306"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "cdb-1", "backup_method": "physical", "port": 3306.0}, state)
+
+	_, err = upgrader.Upgrade(context.TODO(), map[string]interface{}{"port": "x"}, nil)
+	assert.EqualError(t, err, `upgrade state of version 0 failed, attribute port: "x" is not a number`)
+
+	state, err = upgrader.Upgrade(context.TODO(), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, state)
+}
+
+func TestUpgraderValidate(t *testing.T) {
+	prior := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"backup_model": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	current := &schema.Resource{
+		Create: schema.Noop,
+		Read:   schema.Noop,
+		Delete: schema.Noop,
+		Schema: map[string]*schema.Schema{
+			"backup_method": {Type: schema.TypeString, Optional: true, ForceNew: true},
+		},
+		SchemaVersion:  1,
+		StateUpgraders: []schema.StateUpgrader{Upgrader(0, prior, Rename("backup_model", "backup_method"))},
+	}
+	assert.NoError(t, current.InternalValidate(nil, true))
+}
+
+func TestString(t *testing.T) {
+	attributes := map[string]interface{}{"ccn_id": "ccn-1", "empty": "", "count": 1.0}
+	value, err := String(attributes, "ccn_id")
+	assert.NoError(t, err)
+	assert.Equal(t, "ccn-1", value)
+
+	for _, name := range []string{"empty", "count", "absent"} {
+		_, err = String(attributes, name)
+		assert.EqualError(t, err, "attribute "+name+" is not set")
+	}
+}
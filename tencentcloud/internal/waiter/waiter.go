@@ -0,0 +1,219 @@
+// Package waiter polls the async tasks of the cloud APIs, such as the async requests of MySQL, the
+// tasks of Redis and the flows of SQL Server, until they finish.
+//
+// A Waiter fetches the status of the task by its Fetch, and maps the state of the status to the
+// end of the wait by Target, Pending and Failed. The status is polled at intervals growing from
+// MinInterval to MaxInterval with jitter, until the task finishes, Timeout is reached or ctx is
+// done, whichever comes first. The changes of the state and the progress are logged.
+//
+// Within a resource, the waits are bounded by the timeout of the operation, which users may set in
+// its `timeouts` block, by the context of WithResourceTimeout:
+//
+//	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+//	defer cancel()
+//	_, err := (&waiter.Waiter{
+//		Name:    "mysql async request " + asyncRequestId,
+//		Pending: []string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING},
+//		Target:  []string{MYSQL_TASK_STATUS_SUCCESS},
+//		Fetch:   fetch,
+//	}).Wait(ctx)
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+const (
+	// DefaultMinInterval is the first interval between the polls of the Waiters without MinInterval.
+	DefaultMinInterval = 2 * time.Second
+	// DefaultMaxInterval is the longest interval between the polls of the Waiters without MaxInterval.
+	DefaultMaxInterval = 20 * time.Second
+)
+
+// Status is the status of a task.
+type Status struct {
+	// State is mapped to the end of the wait by the Waiter.
+	State string
+	// Percent is the progress of the task in percent, 0 if unknown.
+	Percent int
+	// Step is the step the task is running, if known.
+	Step string
+	// Message explains the state, which is reported when the task fails.
+	Message string
+}
+
+// Fetcher returns the current status of a task.
+type Fetcher func(ctx context.Context) (*Status, error)
+
+// Waiter waits for a task to finish.
+type Waiter struct {
+	// Name names the task in the logs and the errors, such as `mysql async request xxx`.
+	Name string
+	// Fetch returns the current status of the task.
+	Fetch Fetcher
+	// Target are the states the task succeeds in.
+	Target []string
+	// Pending are the states the task is running in. The states of neither Target, Pending nor
+	// Failed are failures if Pending is set, and running otherwise.
+	Pending []string
+	// Failed are the states the task fails in.
+	Failed []string
+	// Timeout limits the wait besides the deadline of ctx, unlimited if not positive.
+	Timeout time.Duration
+	// Delay is waited before the first poll.
+	Delay time.Duration
+	// MinInterval and MaxInterval bound the intervals between the polls, which grow by half each
+	// time, DefaultMinInterval and DefaultMaxInterval if not positive.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	// Retryable reports whether an error of Fetch is temporary and the task is polled again,
+	// DefaultRetryable if nil.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryable retries the errors other than the ones of the cloud APIs, such as the network
+// errors, and the errors of the cloud APIs with retryable codes, such as RequestLimitExceeded.
+func DefaultRetryable(err error) bool {
+	if _, ok := errors.Cause(err).(*sdkErrors.TencentCloudSDKError); ok {
+		return tccommon.RetryError(err).Retryable
+	}
+	return true
+}
+
+// WithResourceTimeout returns ctx with the deadline of the timeout of d of key, such as
+// schema.TimeoutCreate, for the waits within the operation of the resource.
+func WithResourceTimeout(ctx context.Context, d *schema.ResourceData, key string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(key))
+}
+
+// Wait polls the task until it succeeds, and returns its last status.
+func (w *Waiter) Wait(ctx context.Context) (*Status, error) {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+	logId := tccommon.GetLogId(ctx)
+
+	minInterval, maxInterval := w.MinInterval, w.MaxInterval
+	if minInterval <= 0 {
+		minInterval = DefaultMinInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+	if maxInterval < minInterval {
+		maxInterval = minInterval
+	}
+	retryable := w.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+
+	var last *Status
+	var lastErr error
+	delay, interval := w.Delay, minInterval
+	for {
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return last, w.doneError(ctx, last, lastErr)
+			case <-timer.C:
+			}
+		}
+		delay = jitter(interval)
+		interval += interval / 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+
+		status, err := w.Fetch(ctx)
+		if err != nil {
+			if !retryable(err) {
+				return last, err
+			}
+			log.Printf("[WARN]%s wait for %s, fetch status failed and retry: %v", logId, w.Name, err)
+			lastErr = err
+			continue
+		}
+		if status == nil {
+			status = &Status{}
+		}
+		lastErr = nil
+		if last == nil || *last != *status {
+			log.Printf("[DEBUG]%s wait for %s, %s", logId, w.Name, describe(status))
+		}
+		last = status
+
+		switch {
+		case contains(w.Target, status.State):
+			return status, nil
+		case contains(w.Pending, status.State):
+		case contains(w.Failed, status.State) || len(w.Pending) > 0:
+			return status, w.failedError(status)
+		}
+	}
+}
+
+func (w *Waiter) failedError(status *Status) error {
+	if status.Message == "" {
+		return fmt.Errorf("%s is %s", w.Name, status.State)
+	}
+	return fmt.Errorf("%s is %s: %s", w.Name, status.State, status.Message)
+}
+
+func (w *Waiter) doneError(ctx context.Context, last *Status, lastErr error) error {
+	reason := "timeout"
+	if ctx.Err() == context.Canceled {
+		reason = "canceled"
+	}
+	switch {
+	case lastErr != nil:
+		return fmt.Errorf("%s while waiting for %s, last error: %v", reason, w.Name, lastErr)
+	case last != nil:
+		return fmt.Errorf("%s while waiting for %s, last %s", reason, w.Name, describe(last))
+	}
+	return fmt.Errorf("%s while waiting for %s", reason, w.Name)
+}
+
+func describe(status *Status) string {
+	result := "state " + status.State
+	if status.Percent > 0 {
+		result += fmt.Sprintf(", %d%%", status.Percent)
+	}
+	if status.Step != "" {
+		result += ", step " + status.Step
+	}
+	return result
+}
+
+// jitter returns interval randomized by up to a fifth, so that the waits started together do not
+// poll together.
+func jitter(interval time.Duration) time.Duration {
+	spread := int64(interval) / 5
+	if spread <= 0 {
+		return interval
+	}
+	return interval - time.Duration(spread) + time.Duration(rand.Int63n(2*spread+1))
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+// fetchAll returns the statuses and errors in order, and the last one from then on.
+func fetchAll(results ...interface{}) (Fetcher, *int) {
+	polls := 0
+	return func(ctx context.Context) (*Status, error) {
+		result := results[len(results)-1]
+		if polls < len(results) {
+			result = results[polls]
+		}
+		polls++
+		if err, ok := result.(error); ok {
+			return nil, err
+		}
+		return result.(*Status), nil
+	}, &polls
+}
+
+func testWaiter(fetch Fetcher) *Waiter {
+	return &Waiter{
+		Name:        "task 1",
+		Fetch:       fetch,
+		Pending:     []string{"INITIAL", "RUNNING"},
+		Target:      []string{"SUCCESS"},
+		MinInterval: time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+	}
+}
+
+func TestWait(t *testing.T) {
+	cases := []struct {
+		name    string
+		results []interface{}
+		polls   int
+		state   string
+		err     string
+	}{
+		{"succeeds at once", []interface{}{&Status{State: "SUCCESS"}}, 1, "SUCCESS", ""},
+		{"succeeds after running", []interface{}{&Status{State: "INITIAL"}, &Status{State: "RUNNING", Percent: 50, Step: "copy"}, &Status{State: "SUCCESS"}}, 3, "SUCCESS", ""},
+		{"fails", []interface{}{&Status{State: "RUNNING"}, &Status{State: "FAILED", Message: "disk is full"}}, 2, "FAILED", "task 1 is FAILED: disk is full"},
+		{"fails without message", []interface{}{&Status{State: "REMOVED"}}, 1, "REMOVED", "task 1 is REMOVED"},
+		{"retries network errors", []interface{}{fmt.Errorf("connection reset"), &Status{State: "SUCCESS"}}, 2, "SUCCESS", ""},
+		{"retries limited requests", []interface{}{sdkErrors.NewTencentCloudSDKError("RequestLimitExceeded", "too fast", "req-1"), &Status{State: "SUCCESS"}}, 2, "SUCCESS", ""},
+		{"stops on api errors", []interface{}{&Status{State: "RUNNING"}, sdkErrors.NewTencentCloudSDKError("ResourceNotFound", "no such task", "req-2")}, 2, "RUNNING", "[TencentCloudSDKError] Code=ResourceNotFound, Message=no such task, RequestId=req-2"},
+	}
+	for _, c := range cases {
+		fetch, polls := fetchAll(c.results...)
+		status, err := testWaiter(fetch).Wait(context.TODO())
+		if c.err == "" {
+			assert.NoError(t, err, c.name)
+		} else {
+			assert.EqualError(t, err, c.err, c.name)
+		}
+		assert.Equal(t, c.polls, *polls, c.name)
+		assert.Equal(t, c.state, status.State, c.name)
+	}
+}
+
+func TestWaitStates(t *testing.T) {
+	fetch, polls := fetchAll(&Status{State: "Creating"}, &Status{State: "Abnormal"}, &Status{State: "Running"})
+	w := testWaiter(fetch)
+	w.Pending, w.Target, w.Failed = nil, []string{"Running"}, []string{"Failed"}
+	status, err := w.Wait(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *polls)
+	assert.Equal(t, "Running", status.State)
+
+	fetch, _ = fetchAll(&Status{State: "Creating"}, &Status{State: "Failed"})
+	w.Fetch = fetch
+	_, err = w.Wait(context.TODO())
+	assert.EqualError(t, err, "task 1 is Failed")
+}
+
+func TestWaitTimeout(t *testing.T) {
+	fetch, _ := fetchAll(&Status{State: "RUNNING", Percent: 30, Step: "backup"})
+	w := testWaiter(fetch)
+	w.Timeout = 20 * time.Millisecond
+	start := time.Now()
+	status, err := w.Wait(context.TODO())
+	assert.EqualError(t, err, "timeout while waiting for task 1, last state RUNNING, 30%, step backup")
+	assert.Equal(t, 30, status.Percent)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	fetch, _ = fetchAll(fmt.Errorf("connection reset"))
+	w.Fetch = fetch
+	_, err = w.Wait(context.TODO())
+	assert.EqualError(t, err, "timeout while waiting for task 1, last error: connection reset")
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	fetch, polls := fetchAll(&Status{State: "RUNNING"})
+	w.Fetch = func(ctx context.Context) (*Status, error) {
+		cancel()
+		return fetch(ctx)
+	}
+	w.Timeout = 0
+	_, err = w.Wait(ctx)
+	assert.EqualError(t, err, "canceled while waiting for task 1, last state RUNNING")
+	assert.Equal(t, 1, *polls)
+}
+
+func TestWithResourceTimeout(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(time.Hour),
+		},
+	}
+	d := r.Data(nil)
+
+	ctx, cancel := WithResourceTimeout(context.Background(), d, schema.TimeoutUpdate)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Minute)
+
+	// the waits stop at the deadline, even if Timeout is longer.
+	ctx, cancel = WithResourceTimeout(context.Background(), d, schema.TimeoutUpdate)
+	cancel()
+	w := testWaiter(func(ctx context.Context) (*Status, error) {
+		return &Status{State: "RUNNING"}, nil
+	})
+	w.Timeout = 2 * time.Hour
+	_, err := w.Wait(ctx)
+	assert.EqualError(t, err, "canceled while waiting for task 1, last state RUNNING")
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := jitter(10 * time.Second)
+		assert.GreaterOrEqual(t, int64(d), int64(8*time.Second))
+		assert.LessOrEqual(t, int64(d), int64(12*time.Second))
+	}
+	assert.Equal(t, time.Duration(3), jitter(3))
+}
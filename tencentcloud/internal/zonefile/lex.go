@@ -0,0 +1,139 @@
+package zonefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// logicalLine is an entry of a zone file, parentheses join several lines into one.
+type logicalLine struct {
+	tokens []token
+	// indented lines inherit the owner of the previous record.
+	indented bool
+	comment  string
+	number   int
+}
+
+// split splits content into logical lines, dropping the empty ones.
+func split(content string) ([]logicalLine, error) {
+	var (
+		lines    []logicalLine
+		current  = logicalLine{number: 1}
+		comments []string
+		word     strings.Builder
+		inWord   bool
+		parens   int
+		number   = 1
+	)
+
+	flushWord := func() {
+		if inWord {
+			current.tokens = append(current.tokens, token{text: word.String()})
+			word.Reset()
+			inWord = false
+		}
+	}
+	flushLine := func() {
+		flushWord()
+		if len(current.tokens) > 0 {
+			current.comment = strings.Join(comments, " ")
+			lines = append(lines, current)
+		}
+		current = logicalLine{number: number}
+		comments = nil
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\n':
+			number++
+			if parens == 0 {
+				flushLine()
+				if i+1 < len(content) && (content[i+1] == ' ' || content[i+1] == '\t') {
+					current.indented = true
+				}
+			} else {
+				flushWord()
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			if i == 0 {
+				current.indented = true
+			}
+			flushWord()
+		case c == ';':
+			flushWord()
+			end := strings.IndexByte(content[i:], '\n')
+			if end < 0 {
+				end = len(content) - i
+			}
+			if comment := strings.TrimSpace(content[i+1 : i+end]); comment != "" {
+				comments = append(comments, comment)
+			}
+			i += end - 1
+		case c == '(':
+			flushWord()
+			parens++
+		case c == ')':
+			flushWord()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			parens--
+		case c == '"' && !inWord:
+			text, n, err := unquote(content[i:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", number, err)
+			}
+			current.tokens = append(current.tokens, token{text: text, quoted: true})
+			number += strings.Count(content[i:i+n], "\n")
+			i += n - 1
+		case c == '\\' && i+1 < len(content):
+			word.WriteByte(c)
+			word.WriteByte(content[i+1])
+			inWord = true
+			i++
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+
+	if parens != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	flushLine()
+	return lines, nil
+}
+
+// unquote decodes the quoted string at the beginning of s and returns it with the length consumed.
+func unquote(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+				n, _ := strconv.Atoi(s[i+1 : i+4])
+				if n > 255 {
+					return "", 0, fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+				}
+				b.WriteByte(byte(n))
+				i += 3
+			} else if i+1 < len(s) {
+				b.WriteByte(s[i+1])
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
@@ -0,0 +1,251 @@
+// Package zonefile parses DNS zone files in the RFC 1035 master file format.
+//
+// The $ORIGIN and $TTL directives, relative and `@` owner names, owners inherited from the
+// previous record, TTL and class in any order, parentheses spanning lines, quoted strings and
+// comments are supported. Names are returned fully qualified and in lower case.
+package zonefile
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Record is a resource record of a zone file.
+type Record struct {
+	// Name is the fully qualified owner name, e.g. `www.example.com.`.
+	Name  string
+	TTL   uint32
+	Class string
+	// Type is the record type in upper case, e.g. `MX`.
+	Type string
+	// Data are the fields of the record data. Names are fully qualified, and quoted strings are unquoted.
+	Data []string
+	// Comment is the comment on the line of the record, if any.
+	Comment string
+	// Line is the line number the record starts on.
+	Line int
+}
+
+// nameFields are the indexes of the fields holding a domain name, per record type.
+var nameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+}
+
+// Parse parses content with origin as the initial $ORIGIN. Records without a TTL and
+// not preceded by a $TTL directive or another record use defaultTTL.
+func Parse(content, origin string, defaultTTL uint32) ([]Record, error) {
+	lines, err := split(content)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = Fqdn(origin)
+	var (
+		records   []Record
+		owner     string
+		directTTL *uint32
+		lastTTL   *uint32
+	)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if !line.indented && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN takes exactly one domain name", line.number)
+				}
+				origin = qualify(tokens[1].text, origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL takes exactly one TTL", line.number)
+				}
+				ttl, err := ParseTTL(tokens[1].text)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v", line.number, err)
+				}
+				directTTL = &ttl
+			default:
+				return nil, fmt.Errorf("line %d: directive %s is not supported", line.number, tokens[0].text)
+			}
+			continue
+		}
+
+		if !line.indented {
+			owner = qualify(tokens[0].text, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name for the record", line.number)
+		}
+
+		record := Record{Name: owner, Class: "IN", Comment: line.comment, Line: line.number}
+
+		var ttl *uint32
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			text := strings.ToUpper(tokens[0].text)
+			if t, err := ParseTTL(text); ttl == nil && err == nil && isDigit(text[0]) {
+				ttl = &t
+			} else if text == "IN" || text == "CH" || text == "HS" || text == "CS" {
+				record.Class = text
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if record.Class != "IN" {
+			return nil, fmt.Errorf("line %d: class %s is not supported", line.number, record.Class)
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", line.number)
+		}
+		record.Type = strings.ToUpper(tokens[0].text)
+		for _, token := range tokens[1:] {
+			record.Data = append(record.Data, token.text)
+		}
+
+		if err := record.normalize(origin); err != nil {
+			return nil, fmt.Errorf("line %d: %s record of %s: %v", line.number, record.Type, record.Name, err)
+		}
+
+		switch {
+		case ttl != nil:
+			record.TTL = *ttl
+		case directTTL != nil:
+			record.TTL = *directTTL
+		case lastTTL != nil:
+			record.TTL = *lastTTL
+		default:
+			record.TTL = defaultTTL
+		}
+		lastTTL = &record.TTL
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// normalize validates the record data and qualifies the names in it.
+func (r *Record) normalize(origin string) error {
+	expected := map[string]int{
+		"A": 1, "AAAA": 1, "CNAME": 1, "DNAME": 1, "NS": 1, "PTR": 1,
+		"MX": 2, "SRV": 4, "CAA": 3, "SOA": 7,
+	}
+	if n, ok := expected[r.Type]; ok && len(r.Data) != n {
+		return fmt.Errorf("expected %d fields, got %d", n, len(r.Data))
+	}
+	if len(r.Data) == 0 {
+		return fmt.Errorf("missing record data")
+	}
+
+	switch r.Type {
+	case "A":
+		if addr, err := netip.ParseAddr(r.Data[0]); err != nil || !addr.Is4() {
+			return fmt.Errorf("invalid IPv4 address %q", r.Data[0])
+		}
+	case "AAAA":
+		if addr, err := netip.ParseAddr(r.Data[0]); err != nil || !addr.Is6() {
+			return fmt.Errorf("invalid IPv6 address %q", r.Data[0])
+		}
+	case "MX":
+		if _, err := strconv.ParseUint(r.Data[0], 10, 16); err != nil {
+			return fmt.Errorf("invalid preference %q", r.Data[0])
+		}
+	case "SRV":
+		for i, field := range []string{"priority", "weight", "port"} {
+			if _, err := strconv.ParseUint(r.Data[i], 10, 16); err != nil {
+				return fmt.Errorf("invalid %s %q", field, r.Data[i])
+			}
+		}
+	case "CAA":
+		if _, err := strconv.ParseUint(r.Data[0], 10, 8); err != nil {
+			return fmt.Errorf("invalid flags %q", r.Data[0])
+		}
+	case "SOA":
+		for _, field := range r.Data[2:] {
+			if _, err := ParseTTL(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, i := range nameFields[r.Type] {
+		r.Data[i] = qualify(r.Data[i], origin)
+	}
+	return nil
+}
+
+// Fqdn returns name in lower case with a trailing dot.
+func Fqdn(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func qualify(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	case origin == ".":
+		return strings.ToLower(name) + "."
+	}
+	return strings.ToLower(name) + "." + origin
+}
+
+// ParseTTL parses a TTL in seconds or in the BIND format with units, e.g. `1h30m` or `1W`.
+func ParseTTL(s string) (uint32, error) {
+	if s == "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		if n > math.MaxInt32 {
+			return 0, fmt.Errorf("TTL %q out of range", s)
+		}
+		return uint32(n), nil
+	}
+
+	units := map[byte]uint64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, n uint64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			n = n*10 + uint64(c-'0')
+			digits = true
+			if n > math.MaxInt32 {
+				return 0, fmt.Errorf("TTL %q out of range", s)
+			}
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += n * unit
+		n, digits = 0, false
+	}
+	if digits || total > math.MaxInt32 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return uint32(total), nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
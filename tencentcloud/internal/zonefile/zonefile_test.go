@@ -0,0 +1,150 @@
+package zonefile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	records, err := Parse(`
+$TTL 1h
+$ORIGIN example.com.
+@       IN  SOA   ns1 hostmaster (
+                  2024010101 ; serial
+                  1d 2h 4w 1h )
+        IN  NS    ns1
+        IN  NS    ns2.example.net.
+        300 IN MX 10 mail
+        IN  MX    20 mail.backup.example.net.
+        TXT       "v=spf1 include:_spf.example.net ~all"
+www     600 A     192.0.2.1
+        A         192.0.2.2 ; dnspod:line=电信
+WWW2    IN 60 CNAME www
+mail        A     192.0.2.10
+_sip._tcp   SRV   10 60 5060 sip
+*.dev       AAAA  2001:db8::1
+@           CAA   0 issue "letsencrypt.org"
+long        TXT   ( "part one "
+                    "part \"two\"" )
+$ORIGIN sub
+host        A     192.0.2.20
+`, "example.com", 600)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	type rr struct {
+		name    string
+		ttl     uint32
+		rrType  string
+		data    []string
+		comment string
+	}
+	expected := []rr{
+		{"example.com.", 3600, "SOA", []string{"ns1.example.com.", "hostmaster.example.com.", "2024010101", "1d", "2h", "4w", "1h"}, "serial"},
+		{"example.com.", 3600, "NS", []string{"ns1.example.com."}, ""},
+		{"example.com.", 3600, "NS", []string{"ns2.example.net."}, ""},
+		{"example.com.", 300, "MX", []string{"10", "mail.example.com."}, ""},
+		{"example.com.", 3600, "MX", []string{"20", "mail.backup.example.net."}, ""},
+		{"example.com.", 3600, "TXT", []string{"v=spf1 include:_spf.example.net ~all"}, ""},
+		{"www.example.com.", 600, "A", []string{"192.0.2.1"}, ""},
+		{"www.example.com.", 3600, "A", []string{"192.0.2.2"}, "dnspod:line=电信"},
+		{"www2.example.com.", 60, "CNAME", []string{"www.example.com."}, ""},
+		{"mail.example.com.", 3600, "A", []string{"192.0.2.10"}, ""},
+		{"_sip._tcp.example.com.", 3600, "SRV", []string{"10", "60", "5060", "sip.example.com."}, ""},
+		{"*.dev.example.com.", 3600, "AAAA", []string{"2001:db8::1"}, ""},
+		{"example.com.", 3600, "CAA", []string{"0", "issue", "letsencrypt.org"}, ""},
+		{"long.example.com.", 3600, "TXT", []string{"part one ", `part "two"`}, ""},
+		{"host.sub.example.com.", 3600, "A", []string{"192.0.2.20"}, ""},
+	}
+
+	if !assert.Len(t, records, len(expected)) {
+		return
+	}
+	for i, record := range records {
+		assert.Equal(t, expected[i].name, record.Name, "record %d", i)
+		assert.Equal(t, expected[i].ttl, record.TTL, "record %d", i)
+		assert.Equal(t, expected[i].rrType, record.Type, "record %d", i)
+		assert.Equal(t, expected[i].data, record.Data, "record %d", i)
+		assert.Equal(t, expected[i].comment, record.Comment, "record %d", i)
+		assert.Equal(t, "IN", record.Class)
+	}
+	assert.Equal(t, 4, records[0].Line)
+	assert.Equal(t, 13, records[7].Line)
+}
+
+func TestParseDefaultTTL(t *testing.T) {
+	records, err := Parse("a A 192.0.2.1\nb 30 A 192.0.2.2\nc A 192.0.2.3\n", "example.com.", 600)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, uint32(600), records[0].TTL)
+		assert.Equal(t, uint32(30), records[1].TTL)
+		// without $TTL the last explicit TTL is used.
+		assert.Equal(t, uint32(30), records[2].TTL)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"no owner", "  A 192.0.2.1", "line 1: no owner name for the record"},
+		{"missing type", "www 300 IN", "line 1: missing record type"},
+		{"bad ipv4", "www A 300.0.0.1", `line 1: A record of www.example.com.: invalid IPv4 address "300.0.0.1"`},
+		{"bad ipv6", "www AAAA 192.0.2.1", `invalid IPv6 address "192.0.2.1"`},
+		{"mx fields", "@ MX mail", "expected 2 fields, got 1"},
+		{"mx preference", "@ MX high mail", `invalid preference "high"`},
+		{"srv port", "_sip._tcp SRV 1 1 http sip", `invalid port "http"`},
+		{"no data", "www TXT", "missing record data"},
+		{"class", "www CH A 192.0.2.1", "class CH is not supported"},
+		{"include", "$INCLUDE other.zone", "directive $INCLUDE is not supported"},
+		{"bad ttl directive", "$TTL forever", `line 1: invalid TTL "forever"`},
+		{"unbalanced", "@ SOA ns1 hostmaster ( 1 2 3 4 5", "unbalanced parentheses"},
+		{"unbalanced close", "@ A 192.0.2.1 )", "line 1: unbalanced parentheses"},
+		{"unterminated", "@ TXT \"abc", "line 1: unterminated quoted string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.content, "example.com.", 600)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := []struct {
+		in      string
+		out     uint32
+		wantErr bool
+	}{
+		{in: "0", out: 0},
+		{in: "3600", out: 3600},
+		{in: "1h", out: 3600},
+		{in: "1H30M", out: 5400},
+		{in: "1w1d", out: 691200},
+		{in: "2147483647", out: 2147483647},
+		{in: "2147483648", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "h", wantErr: true},
+		{in: "1h30", wantErr: true},
+		{in: "1y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			ttl, err := ParseTTL(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, ttl)
+		})
+	}
+}
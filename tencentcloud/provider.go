@@ -20,6 +20,8 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sellable"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/antiddos"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/apigateway"
@@ -149,6 +151,7 @@ const (
 	PROVIDER_SHARED_CREDENTIALS_DIR             = "TENCENTCLOUD_SHARED_CREDENTIALS_DIR"
 	PROVIDER_PROFILE                            = "TENCENTCLOUD_PROFILE"
 	PROVIDER_CAM_ROLE_NAME                      = "TENCENTCLOUD_CAM_ROLE_NAME"
+	PROVIDER_QUOTA_CHECK                        = "TENCENTCLOUD_QUOTA_CHECK"
 	POD_OIDC_TKE_REGION                         = "TKE_REGION"
 	POD_OIDC_TKE_WEB_IDENTITY_TOKEN_FILE        = "TKE_WEB_IDENTITY_TOKEN_FILE"
 	POD_OIDC_TKE_PROVIDER_ID                    = "TKE_PROVIDER_ID"
@@ -176,7 +179,7 @@ func (meta *TencentCloudClient) GetAPIV3Conn() *connectivity.TencentCloudClient
 }
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
@@ -453,6 +456,13 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"allowed_account_ids", "assume_role_with_saml", "assume_role_with_web_identity"},
 				Description:   "List of forbidden TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `allowed_account_ids`, If use `assume_role_with_saml` or `assume_role_with_web_identity`, it is not supported.",
 			},
+			"quota_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_QUOTA_CHECK, nil),
+				ValidateFunc: tccommon.ValidateAllowedStringValue(quota.Modes),
+				Description:  "Whether to check at plan time that the instances, EIPs, security groups and subnets planned to be created fit in the remaining quotas of the account. Valid values: `warn` logs a warning and `error` fails the plan if a quota would be exceeded. It can also be sourced from the `TENCENTCLOUD_QUOTA_CHECK` environment variable. If not set, the quotas are not checked.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -493,6 +503,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_vpc_subnets":                                           vpc.DataSourceTencentCloudVpcSubnets(),
 			"tencentcloud_vpc_route_tables":                                      vpc.DataSourceTencentCloudVpcRouteTables(),
 			"tencentcloud_vpc":                                                   vpc.DataSourceTencentCloudVpc(),
+			"tencentcloud_vpc_cidr_allocation":                                   vpc.DataSourceTencentCloudVpcCidrAllocation(),
 			"tencentcloud_vpc_acls":                                              vpc.DataSourceTencentCloudVpcAcls(),
 			"tencentcloud_vpc_bandwidth_package_quota":                           vpc.DataSourceTencentCloudVpcBandwidthPackageQuota(),
 			"tencentcloud_vpc_bandwidth_package_bill_usage":                      vpc.DataSourceTencentCloudVpcBandwidthPackageBillUsage(),
@@ -563,6 +574,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_dc_gateway_ccn_routes":                                 dcg.DataSourceTencentCloudDcGatewayCCNRoutes(),
 			"tencentcloud_security_group":                                        vpc.DataSourceTencentCloudSecurityGroup(),
 			"tencentcloud_security_groups":                                       vpc.DataSourceTencentCloudSecurityGroups(),
+			"tencentcloud_security_group_traffic_check":                          vpc.DataSourceTencentCloudSecurityGroupTrafficCheck(),
 			"tencentcloud_kubernetes_clusters":                                   tke.DataSourceTencentCloudKubernetesClusters(),
 			"tencentcloud_kubernetes_charts":                                     tke.DataSourceTencentCloudKubernetesCharts(),
 			"tencentcloud_kubernetes_cluster_levels":                             tke.DataSourceTencentCloudKubernetesClusterLevels(),
@@ -709,6 +721,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_gaap_domain_error_page_infos":                          gaap.DataSourceTencentCloudGaapDomainErrorPageInfos(),
 			"tencentcloud_gaap_check_proxy_create":                               gaap.DataSourceTencentCloudGaapCheckProxyCreate(),
 			"tencentcloud_ssl_certificates":                                      ssl.DataSourceTencentCloudSslCertificates(),
+			"tencentcloud_ssl_expiring_certificates":                             ssl.DataSourceTencentCloudSslExpiringCertificates(),
 			"tencentcloud_ssl_describe_certificate":                              ssl.DataSourceTencentCloudSslDescribeCertificate(),
 			"tencentcloud_ssl_describe_companies":                                ssl.DataSourceTencentCloudSslDescribeCompanies(),
 			"tencentcloud_ssl_describe_host_api_gateway_instance_list":           ssl.DataSourceTencentCloudSslDescribeHostApiGatewayInstanceList(),
@@ -893,6 +906,8 @@ func Provider() *schema.Provider {
 			"tencentcloud_elastic_public_ipv6s":                                  vpc.DataSourceTencentCloudElasticPublicIpv6s(),
 			"tencentcloud_kms_keys":                                              kms.DataSourceTencentCloudKmsKeys(),
 			"tencentcloud_kms_public_key":                                        kms.DataSourceTencentCloudKmsPublicKey(),
+			"tencentcloud_kms_ciphertext":                                        kms.DataSourceTencentCloudKmsCiphertext(),
+			"tencentcloud_kms_secrets":                                           kms.DataSourceTencentCloudKmsSecrets(),
 			"tencentcloud_kms_get_parameters_for_import":                         kms.DataSourceTencentCloudKmsGetParametersForImport(),
 			"tencentcloud_kms_describe_keys":                                     kms.DataSourceTencentCloudKmsDescribeKeys(),
 			"tencentcloud_kms_white_box_key_details":                             kms.DataSourceTencentCloudKmsWhiteBoxKeyDetails(),
@@ -1254,6 +1269,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_mqtt_instance_detail":                                  mqtt.DataSourceTencentCloudMqttInstanceDetail(),
 			"tencentcloud_mqtt_topics":                                           mqtt.DataSourceTencentCloudMqttTopics(),
 			"tencentcloud_billing_budget_operation_log":                          billing.DataSourceTencentCloudBillingBudgetOperationLog(),
+			"tencentcloud_price_estimate":                                        billing.DataSourceTencentCloudPriceEstimate(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -1405,6 +1421,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_clb_instance_sla_config":                                                  clb.ResourceTencentCloudClbInstanceSlaConfig(),
 			"tencentcloud_clb_replace_cert_for_lbs":                                                 clb.ResourceTencentCloudClbReplaceCertForLbs(),
 			"tencentcloud_clb_security_group_attachment":                                            clb.ResourceTencentCloudClbSecurityGroupAttachment(),
+			"tencentcloud_clb_traffic_shift":                                                        clb.ResourceTencentCloudClbTrafficShift(),
 			"tencentcloud_gwlb_instance":                                                            gwlb.ResourceTencentCloudGwlbInstance(),
 			"tencentcloud_gwlb_target_group":                                                        gwlb.ResourceTencentCloudGwlbTargetGroup(),
 			"tencentcloud_gwlb_instance_associate_target_group":                                     gwlb.ResourceTencentCloudGwlbInstanceAssociateTargetGroup(),
@@ -1616,6 +1633,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_monitor_tmp_exporter_integration_v2":                                      tmp.ResourceTencentCloudMonitorTmpExporterIntegrationV2(),
 			"tencentcloud_monitor_tmp_alert_rule":                                                   tmp.ResourceTencentCloudMonitorTmpAlertRule(),
 			"tencentcloud_monitor_tmp_recording_rule":                                               tmp.ResourceTencentCloudMonitorTmpRecordingRule(),
+			"tencentcloud_monitor_tmp_rule_file":                                                    tmp.ResourceTencentCloudMonitorTmpRuleFile(),
 			"tencentcloud_monitor_tmp_multiple_writes":                                              tmp.ResourceTencentCloudMonitorTmpMultipleWrites(),
 			"tencentcloud_monitor_tmp_multiple_writes_list":                                         tmp.ResourceTencentCloudMonitorTmpMultipleWritesList(),
 			"tencentcloud_monitor_tmp_alert_group":                                                  tmp.ResourceTencentCloudMonitorTmpAlertGroup(),
@@ -1838,6 +1856,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_dnspod_domain_instance":                                                   dnspod.ResourceTencentCloudDnspodDomainInstance(),
 			"tencentcloud_dnspod_domain_alias":                                                      dnspod.ResourceTencentCloudDnspodDomainAlias(),
 			"tencentcloud_dnspod_record":                                                            dnspod.ResourceTencentCloudDnspodRecord(),
+			"tencentcloud_dnspod_zone":                                                              dnspod.ResourceTencentCloudDnspodZone(),
 			"tencentcloud_dnspod_record_group":                                                      dnspod.ResourceTencentCloudDnspodRecordGroup(),
 			"tencentcloud_dnspod_modify_domain_owner_operation":                                     dnspod.ResourceTencentCloudDnspodModifyDomainOwnerOperation(),
 			"tencentcloud_dnspod_modify_record_group_operation":                                     dnspod.ResourceTencentCloudDnspodModifyRecordGroupOperation(),
@@ -2390,6 +2409,12 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, dataSource := range provider.DataSourcesMap {
+		tccommon.WithResultOutput(dataSource)
+	}
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -2477,6 +2502,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Domain:    domain,
 		CosDomain: cosDomain,
 	}
+	tcClient.apiV3Conn.SellableCache = sellable.NewCache()
+
+	if v, ok := d.GetOk("quota_check"); ok {
+		tcClient.apiV3Conn.QuotaChecker = quota.NewChecker(v.(string))
+	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		for _, v := range v.(*schema.Set).List() {
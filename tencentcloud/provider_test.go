@@ -3,6 +3,8 @@ package tencentcloud
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -25,3 +27,221 @@ func TestProvider(t *testing.T) {
 func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
+
+// TestProviderResourcesImportable makes sure every stateful resource can be imported, operation
+// resources which only run an action are excluded by their `_operation` suffix.
+func TestProviderResourcesImportable(t *testing.T) {
+	missing := make([]string, 0)
+	for name, r := range Provider().ResourcesMap {
+		if strings.HasSuffix(name, "_operation") {
+			continue
+		}
+		_, action := resourcesWithoutImporter[name]
+		_, notImportable := resourcesNotImportable[name]
+		exempted := action || notImportable
+		if r.Importer == nil && !exempted {
+			missing = append(missing, name)
+		}
+		if r.Importer != nil && exempted {
+			t.Errorf("resource %s can be imported now, remove it from the exemptions", name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		t.Errorf("resource %s has no Importer, add one with the id format documented in its Import section", name)
+	}
+}
+
+// TestProviderOperations makes sure every operation resource is built by operation.Resource, so
+// that it can be run again by its triggers.
+func TestProviderOperations(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if !strings.HasSuffix(name, "_operation") {
+			continue
+		}
+		for _, attribute := range []string{"triggers", "last_run_at", "request_id", "task_id", "result"} {
+			if _, ok := r.Schema[attribute]; !ok {
+				t.Errorf("operation %s has no attribute %s, build it by operation.Resource", name, attribute)
+			}
+		}
+	}
+}
+
+// TestProviderStateUpgraders makes sure the state of every prior schema version of a resource is
+// upgraded, in order, up to its current version.
+func TestProviderStateUpgraders(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if len(r.StateUpgraders) != r.SchemaVersion {
+			t.Errorf("resource %s of schema version %d has %d state upgraders", name, r.SchemaVersion, len(r.StateUpgraders))
+			continue
+		}
+		for i, upgrader := range r.StateUpgraders {
+			if upgrader.Version != i || upgrader.Upgrade == nil {
+				t.Errorf("state upgrader %d of resource %s does not upgrade version %d", i, name, i)
+			}
+		}
+	}
+}
+
+func TestProviderStateMoves(t *testing.T) {
+	resources := Provider().ResourcesMap
+	moved := make(map[string]bool)
+	for _, move := range StateMoves() {
+		if _, ok := resources[move.From]; !ok {
+			t.Errorf("resource %s of the move to %s is not found", move.From, move.To)
+		}
+		if r, ok := resources[move.To]; !ok || r.Importer == nil {
+			t.Errorf("resource %s of the move from %s is not found or cannot be imported", move.To, move.From)
+		}
+		if moved[move.From] {
+			t.Errorf("resource %s is moved more than once", move.From)
+		}
+		moved[move.From] = true
+	}
+}
+
+// resourcesWithoutImporter are the existing resources that run an action without the `_operation`
+// suffix, there is nothing to import for them. Do not add new resources to it.
+var resourcesWithoutImporter = map[string]struct{}{
+	"tencentcloud_api_gateway_import_open_api":                  {},
+	"tencentcloud_api_gateway_update_api_app_key":               {},
+	"tencentcloud_api_gateway_update_service":                   {},
+	"tencentcloud_as_protect_instances":                         {},
+	"tencentcloud_as_remove_instances":                          {},
+	"tencentcloud_as_scale_in_instances":                        {},
+	"tencentcloud_as_start_instance_refresh":                    {},
+	"tencentcloud_as_start_instances":                           {},
+	"tencentcloud_as_stop_instances":                            {},
+	"tencentcloud_batch_apply_account_baselines":                {},
+	"tencentcloud_bi_embed_interval_apply":                      {},
+	"tencentcloud_bi_embed_token_apply":                         {},
+	"tencentcloud_ccn_instances_reset_attach":                   {},
+	"tencentcloud_cdn_url_purge":                                {},
+	"tencentcloud_cdn_url_push":                                 {},
+	"tencentcloud_cdwpg_restart_instance":                       {},
+	"tencentcloud_cfw_sync_asset":                               {},
+	"tencentcloud_cfw_sync_route":                               {},
+	"tencentcloud_ckafka_consumer_group_modify_offset":          {},
+	"tencentcloud_ckafka_renew_instance":                        {},
+	"tencentcloud_clb_replace_cert_for_lbs":                     {},
+	"tencentcloud_clickhouse_delete_backup_data":                {},
+	"tencentcloud_clickhouse_recover_backup_job":                {},
+	"tencentcloud_cvm_export_images":                            {},
+	"tencentcloud_cvm_reboot_instance":                          {},
+	"tencentcloud_cvm_renew_host":                               {},
+	"tencentcloud_cvm_renew_instance":                           {},
+	"tencentcloud_cvm_sync_image":                               {},
+	"tencentcloud_cynosdb_audit_log_file":                       {},
+	"tencentcloud_cynosdb_export_instance_error_logs":           {},
+	"tencentcloud_cynosdb_export_instance_slow_queries":         {},
+	"tencentcloud_cynosdb_isolate_instance":                     {},
+	"tencentcloud_cynosdb_read_only_instance_exclusive_access":  {},
+	"tencentcloud_cynosdb_upgrade_proxy_version":                {},
+	"tencentcloud_dasb_bind_device_account_password":            {},
+	"tencentcloud_dasb_bind_device_account_private_key":         {},
+	"tencentcloud_dasb_reset_user":                              {},
+	"tencentcloud_dbbrain_db_diag_report_task":                  {},
+	"tencentcloud_dbbrain_security_audit_log_export_task":       {},
+	"tencentcloud_dbbrain_tdsql_audit_log":                      {},
+	"tencentcloud_dlc_suspend_resume_data_engine":               {},
+	"tencentcloud_dnspod_domain_lock":                           {},
+	"tencentcloud_eb_put_events":                                {},
+	"tencentcloud_eip_address_transform":                        {},
+	"tencentcloud_eip_normal_address_return":                    {},
+	"tencentcloud_eip_public_address_adjust":                    {},
+	"tencentcloud_elasticsearch_diagnose_instance":              {},
+	"tencentcloud_kms_overwrite_white_box_device_fingerprints":  {},
+	"tencentcloud_lighthouse_apply_disk_backup":                 {},
+	"tencentcloud_lighthouse_apply_instance_snapshot":           {},
+	"tencentcloud_lighthouse_reboot_instance":                   {},
+	"tencentcloud_lighthouse_renew_disk":                        {},
+	"tencentcloud_lighthouse_start_instance":                    {},
+	"tencentcloud_lighthouse_stop_instance":                     {},
+	"tencentcloud_mariadb_cancel_dcn_job":                       {},
+	"tencentcloud_mariadb_flush_binlog":                         {},
+	"tencentcloud_mariadb_operate_hour_db_instance":             {},
+	"tencentcloud_mariadb_renew_instance":                       {},
+	"tencentcloud_mariadb_restart_instance":                     {},
+	"tencentcloud_mariadb_switch_ha":                            {},
+	"tencentcloud_mongodb_instance_backup":                      {},
+	"tencentcloud_monitor_alarm_policy_set_default":             {},
+	"tencentcloud_mysql_audit_log_file":                         {},
+	"tencentcloud_mysql_isolate_instance":                       {},
+	"tencentcloud_mysql_reload_balance_proxy_node":              {},
+	"tencentcloud_mysql_reset_root_account":                     {},
+	"tencentcloud_mysql_ro_start_replication":                   {},
+	"tencentcloud_mysql_ro_stop_replication":                    {},
+	"tencentcloud_mysql_rollback":                               {},
+	"tencentcloud_mysql_rollback_stop":                          {},
+	"tencentcloud_mysql_switch_for_upgrade":                     {},
+	"tencentcloud_mysql_switch_proxy":                           {},
+	"tencentcloud_mysql_verify_root_account":                    {},
+	"tencentcloud_oceanus_job_copy":                             {},
+	"tencentcloud_oceanus_run_job":                              {},
+	"tencentcloud_oceanus_stop_job":                             {},
+	"tencentcloud_oceanus_trigger_job_savepoint":                {},
+	"tencentcloud_postgresql_clone_db_instance":                 {},
+	"tencentcloud_pts_cron_job_abort":                           {},
+	"tencentcloud_pts_cron_job_restart":                         {},
+	"tencentcloud_pts_job_abort":                                {},
+	"tencentcloud_pts_tmp_key_generate":                         {},
+	"tencentcloud_redis_switch_master":                          {},
+	"tencentcloud_scf_invoke_function":                          {},
+	"tencentcloud_scf_sync_invoke_function":                     {},
+	"tencentcloud_scf_terminate_async_event":                    {},
+	"tencentcloud_sqlserver_complete_expansion":                 {},
+	"tencentcloud_sqlserver_start_backup_full_migration":        {},
+	"tencentcloud_sqlserver_start_backup_incremental_migration": {},
+	"tencentcloud_sqlserver_start_xevent":                       {},
+	"tencentcloud_ssm_rotate_product_secret":                    {},
+	"tencentcloud_subscribe_private_zone_service":               {},
+	"tencentcloud_tdmq_send_rocketmq_message":                   {},
+	"tencentcloud_teo_ownership_verify":                         {},
+	"tencentcloud_tse_waf_protection":                           {},
+	"tencentcloud_tsf_deploy_container_group":                   {},
+	"tencentcloud_tsf_deploy_vm_group":                          {},
+	"tencentcloud_tsf_operate_container_group":                  {},
+	"tencentcloud_tsf_operate_group":                            {},
+	"tencentcloud_tsf_release_api_group":                        {},
+	"tencentcloud_vpc_enable_end_point_connect":                 {},
+	"tencentcloud_vpc_resume_snapshot_instance":                 {},
+	"tencentcloud_vpn_connection_reset":                         {},
+}
+
+// resourcesNotImportable are the existing resources whose arguments cannot be read back, an imported
+// one would not match its configuration, and the deprecated ones. Do not add new resources to it.
+var resourcesNotImportable = map[string]struct{}{
+	"tencentcloud_container_cluster":                   {}, // deprecated
+	"tencentcloud_container_cluster_instance":          {}, // deprecated
+	"tencentcloud_cynosdb_instance_param":              {}, // only the configured parameters are read
+	"tencentcloud_cynosdb_param_template":              {}, // only the configured parameters are read
+	"tencentcloud_dayu_cc_policy_v2":                   {}, // `resource_id` and `business` are not read
+	"tencentcloud_dayu_eip":                            {}, // the bound resource is not read
+	"tencentcloud_dayu_l4_rule_v2":                     {}, // `business`, `vpn` and `virtual_port` are not read
+	"tencentcloud_dayu_l7_rule_v2":                     {}, // `resource_ip` and `rule` are not read
+	"tencentcloud_dbbrain_sql_filter":                  {}, // `session_token` and `duration` are not read
+	"tencentcloud_dlc_user_vpc_connection":             {}, // `user_subnet_id` is not read
+	"tencentcloud_dts_migrate_job_config":              {}, // the job config is not read
+	"tencentcloud_kubernetes_as_scaling_group":         {}, // deprecated
+	"tencentcloud_kubernetes_cluster_endpoint":         {}, // `cluster_internet` and `cluster_intranet` are not read
+	"tencentcloud_kubernetes_encryption_protection":    {}, // `kms_configuration` is not read
+	"tencentcloud_kubernetes_log_config":               {}, // `log_config` is not read
+	"tencentcloud_monitor_binding_object":              {}, // deprecated
+	"tencentcloud_monitor_tmp_tke_basic_config":        {}, // only the configured metrics are read
+	"tencentcloud_mysql_account_privilege":             {}, // deprecated
+	"tencentcloud_oceanus_job":                         {}, // `cluster_type` is not read
+	"tencentcloud_sms_sign":                            {}, // `sign_type`, `document_type`, `sign_purpose` and `proof_image` are not read
+	"tencentcloud_sqlserver_general_cloud_ro_instance": {}, // `read_only_group_type` is not read
+	"tencentcloud_ssm_product_secret":                  {}, // `user_name_prefix`, `domains` and `privileges_list` are not read
+	"tencentcloud_tcaplus_idl":                         {}, // the idl file content is not read
+	"tencentcloud_tcss_image_registry":                 {}, // deprecated
+	"tencentcloud_tdmq_rocketmq_vip_instance":          {}, // `time_span` is not read
+	"tencentcloud_tsf_instances_attachment":            {}, // the os and login settings are not read
+	"tencentcloud_vpc_ipv6_eni_address":                {}, // deprecated
+	"tencentcloud_waf_cc":                              {}, // `edition` is not read
+	"tencentcloud_waf_clb_instance":                    {}, // `goods_category` is not read
+	"tencentcloud_waf_saas_instance":                   {}, // `goods_category` is not read
+	"tencentcloud_wedata_datasource":                   {}, // `params` is not read
+	"tencentcloud_wedata_function":                     {}, // `resource_list` and `comment` are not read
+	"tencentcloud_wedata_integration_task_node":        {}, // `task_type`, `task_mode` and `config` are not read
+}
@@ -10,16 +10,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
+// apiGatewayAPIImportIdFormat is the import id of an API, the id of the resource is the API id.
+var apiGatewayAPIImportIdFormat = compositeid.New(compositeid.String("service_id"), compositeid.String("id"))
+
 func ResourceTencentCloudAPIGatewayAPI() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudAPIGatewayAPICreate,
 		Read:   resourceTencentCloudAPIGatewayAPIRead,
 		Update: resourceTencentCloudAPIGatewayAPIUpdate,
 		Delete: resourceTencentCloudAPIGatewayAPIDelete,
+		Importer: &schema.ResourceImporter{
+			State: apiGatewayAPIImportIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
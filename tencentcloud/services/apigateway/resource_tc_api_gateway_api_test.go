@@ -76,6 +76,12 @@ func TestAccTencentCloudAPIGateWayAPIResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAPIGatewayAPIResourceKey, "test_limit", "100"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_api_gateway_api.api",
+				ImportState:       true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_api_gateway_api.api", "service_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
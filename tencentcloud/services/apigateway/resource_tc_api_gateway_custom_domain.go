@@ -9,15 +9,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var apiGatewayCustomDomainIdFormat = compositeid.New(compositeid.String("service_id"), compositeid.String("sub_domain"))
+
 func ResourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudAPIGatewayCustomDomainCreate,
 		Read:   resourceTencentCloudAPIGatewayCustomDomainRead,
 		Update: resourceTencentCloudAPIGatewayCustomDomainUpdate,
 		Delete: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: apiGatewayCustomDomainIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -116,7 +122,7 @@ func resourceTencentCloudAPIGatewayCustomDomainCreate(d *schema.ResourceData, me
 		return err
 	}
 
-	d.SetId(strings.Join([]string{serviceId, subDomain}, tccommon.FILED_SP))
+	d.SetId(apiGatewayCustomDomainIdFormat.Join(serviceId, subDomain))
 
 	return resourceTencentCloudAPIGatewayCustomDomainRead(d, meta)
 }
@@ -133,12 +139,12 @@ func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta
 		err               error
 	)
 
-	results := strings.Split(id, tccommon.FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. id:  %s", id)
+	results, err := apiGatewayCustomDomainIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
-	serviceId := results[0]
-	subDomain := results[1]
+	serviceId := results.Get("service_id")
+	subDomain := results.Get("sub_domain")
 	resultList, err := apiGatewayService.DescribeServiceSubDomainsService(ctx, serviceId, subDomain)
 	if err != nil {
 		return err
@@ -166,6 +172,7 @@ func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta
 	_ = d.Set("protocol", resultInfo.Protocol)
 	_ = d.Set("net_type", resultInfo.NetType)
 	_ = d.Set("service_id", serviceId)
+	_ = d.Set("sub_domain", subDomain)
 	_ = d.Set("is_forced_https", resultInfo.IsForcedHttps)
 
 	return nil
@@ -189,11 +196,11 @@ func resourceTencentCloudAPIGatewayCustomDomainUpdate(d *schema.ResourceData, me
 		hasChange         bool
 	)
 
-	results := strings.Split(id, tccommon.FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. setId:  %s", id)
+	results, err := apiGatewayCustomDomainIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
-	serviceId := results[0]
+	serviceId := results.Get("service_id")
 
 	subDomain = d.Get("sub_domain").(string)
 	if d.HasChange("sub_domain") {
@@ -255,12 +262,12 @@ func resourceTencentCloudAPIGatewayCustomDomainDelete(d *schema.ResourceData, me
 		apigatewayService = APIGatewayService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	)
 
-	results := strings.Split(id, tccommon.FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. setId:  %s", id)
+	results, err := apiGatewayCustomDomainIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
-	serviceId := results[0]
-	subDomain := results[1]
+	serviceId := results.Get("service_id")
+	subDomain := results.Get("sub_domain")
 
 	return apigatewayService.UnBindSubDomainService(ctx, serviceId, subDomain)
 }
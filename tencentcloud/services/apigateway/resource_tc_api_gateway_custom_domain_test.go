@@ -48,6 +48,12 @@ func TestAccTencentCloudAPIGateWayCustomDomain_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_api_gateway_custom_domain.foo", "path_mappings.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_api_gateway_custom_domain.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_domain"},
+			},
 		},
 	})
 }
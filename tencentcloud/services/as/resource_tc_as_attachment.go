@@ -17,6 +17,9 @@ func ResourceTencentCloudAsAttachment() *schema.Resource {
 		Read:   resourceTencentCloudAsAttachmentRead,
 		Update: resourceTencentCloudAsAttachmentUpdate,
 		Delete: resourceTencentCloudAsAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -83,6 +86,7 @@ func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("scaling_group_id", scalingGroupId)
 	_ = d.Set("instance_ids", instanceIds)
 	return nil
 }
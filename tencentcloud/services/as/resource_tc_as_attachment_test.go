@@ -107,6 +107,11 @@ func TestAccTencentCloudAsAttachment(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_attachment.attachment", "instance_ids.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_as_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,126 @@
+package as_test
+
+import (
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	svcas "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/as"
+
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudAsNotification(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { tcacctest.AccPreCheck(t) },
+		Providers:    tcacctest.AccProviders,
+		CheckDestroy: testAccCheckAsNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsNotification(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsNotificationExists("tencentcloud_as_notification.notification"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_notification.notification", "scaling_group_id"),
+					resource.TestCheckResourceAttr("tencentcloud_as_notification.notification", "notification_types.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_as_notification.notification", "notification_user_group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_as_notification.notification",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAsNotificationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := tccommon.GetLogId(tccommon.ContextNil)
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("auto scaling notification %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("auto scaling notification id is not set")
+		}
+		asService := svcas.NewAsService(tcacctest.AccProvider.Meta().(tccommon.ProviderMeta).GetAPIV3Conn())
+		_, has, err := asService.DescribeNotificationById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has < 1 {
+			return fmt.Errorf("auto scaling notification not exists: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAsNotificationDestroy(s *terraform.State) error {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	asService := svcas.NewAsService(tcacctest.AccProvider.Meta().(tccommon.ProviderMeta).GetAPIV3Conn())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_as_notification" {
+			continue
+		}
+
+		_, has, err := asService.DescribeNotificationById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has > 0 {
+			return fmt.Errorf("auto scaling notification still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAsNotification() string {
+	return tcacctest.DefaultAsVariable + `
+resource "tencentcloud_vpc" "vpc" {
+  name       = "tf-as-vpc"
+  cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+  vpc_id            = tencentcloud_vpc.vpc.id
+  name              = "tf-as-subnet"
+  cidr_block        = "10.2.11.0/24"
+  availability_zone = var.availability_zone
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+  configuration_name = "tf-as-configuration-notification"
+  image_id           = "img-9qabwvbn"
+  instance_types     = [data.tencentcloud_instance_types.default.instance_types.0.instance_type]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+  scaling_group_name = "tf-as-scaling-group-notification"
+  configuration_id   = tencentcloud_as_scaling_config.launch_configuration.id
+  max_size           = 1
+  min_size           = 0
+  vpc_id             = tencentcloud_vpc.vpc.id
+  subnet_ids         = [tencentcloud_subnet.subnet.id]
+}
+
+resource "tencentcloud_cam_group" "group" {
+  name   = "tf-as-notification-group"
+  remark = "tf-as-notification"
+}
+
+resource "tencentcloud_as_notification" "notification" {
+  scaling_group_id            = tencentcloud_as_scaling_group.scaling_group.id
+  notification_types          = ["SCALE_OUT_FAILED", "SCALE_IN_FAILED"]
+  notification_user_group_ids = [tencentcloud_cam_group.group.id]
+}
+`
+}
@@ -320,7 +320,28 @@ func ResourceTencentCloudAsScalingConfig() *schema.Resource {
 				Description: "The time when the launch configuration was created.",
 			},
 		},
+
+		CustomizeDiff: svccvm.SellableCheckDiff(asScalingConfigSellable),
+	}
+}
+
+// asScalingConfigSellable returns the instance types of the launch configuration, checked in any
+// zone of the region as the zones are chosen by the scaling groups using it.
+func asScalingConfigSellable(d *schema.ResourceDiff) (string, []string, []string) {
+	if d.Id() != "" && !d.HasChanges("instance_types", "instance_charge_type") {
+		return "", nil, nil
+	}
+	if !d.NewValueKnown("instance_types") || !d.NewValueKnown("instance_charge_type") {
+		return "", nil, nil
+	}
+
+	instanceTypes := make([]string, 0)
+	for _, v := range d.Get("instance_types").([]interface{}) {
+		if v != nil && v.(string) != "" {
+			instanceTypes = append(instanceTypes, v.(string))
+		}
 	}
+	return d.Get("instance_charge_type").(string), nil, instanceTypes
 }
 
 func resourceTencentCloudAsScalingConfigCreate(d *schema.ResourceData, meta interface{}) error {
@@ -54,6 +54,11 @@ func TestAccTencentCloudAsScalingPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "cooldown", "300"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_as_scaling_policy.scaling_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
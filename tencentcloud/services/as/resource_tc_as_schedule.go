@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -20,6 +21,9 @@ func ResourceTencentCloudAsSchedule() *schema.Resource {
 		Read:   resourceTencentCloudAsScheduleRead,
 		Update: resourceTencentCloudAsScheduleUpdate,
 		Delete: resourceTencentCloudAsScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -50,6 +50,11 @@ func TestAccTencentCloudAsSchedule(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_schedule.schedule", "recurrence", "1 1 */1 * *"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_as_schedule.schedule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
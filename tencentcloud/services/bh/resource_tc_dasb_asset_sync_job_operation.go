@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudDasbAssetSyncJobOperationOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDasbAssetSyncJobOperationCreate,
 		Read:   resourceTencentCloudDasbAssetSyncJobOperationRead,
 		Delete: resourceTencentCloudDasbAssetSyncJobOperationDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudDasbAssetSyncJobOperationOperation() *schema.Resource {
 				Description: "Synchronize asset categories, 1- Host assets, 2- Database assets.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDasbAssetSyncJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -54,6 +55,7 @@ func resourceTencentCloudDasbAssetSyncJobOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -67,7 +69,7 @@ func resourceTencentCloudDasbAssetSyncJobOperationCreate(d *schema.ResourceData,
 	d.SetId(category)
 
 	// wait
-	err = resource.Retry(4*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseDasbClient().DescribeAssetSyncStatus(waitReq)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -19,6 +19,9 @@ func ResourceTencentCloudDasbBindDeviceResource() *schema.Resource {
 		Read:   resourceTencentCloudDasbBindDeviceResourceRead,
 		Update: resourceTencentCloudDasbBindDeviceResourceUpdate,
 		Delete: resourceTencentCloudDasbBindDeviceResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"device_id_set": {
@@ -24,6 +24,11 @@ func TestAccTencentCloudNeedFixDasbBindDeviceResourceResource_basic(t *testing.T
 					resource.TestCheckResourceAttr("tencentcloud_dasb_bind_device_resource.example", "resource_id", "bh-saas-ocmzo6lgxiv"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dasb_bind_device_resource.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
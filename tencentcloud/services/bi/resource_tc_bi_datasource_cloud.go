@@ -22,6 +22,9 @@ func ResourceTencentCloudBiDatasourceCloud() *schema.Resource {
 		Read:   resourceTencentCloudBiDatasourceCloudRead,
 		Update: resourceTencentCloudBiDatasourceCloudUpdate,
 		Delete: resourceTencentCloudBiDatasourceCloudDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_type": {
@@ -22,6 +22,12 @@ func TestAccTencentCloudNeedFixBiDatasourceCloudResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_bi_datasource_cloud.datasource_cloud", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_bi_datasource_cloud.datasource_cloud",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"db_pwd"},
+			},
 		},
 	})
 }
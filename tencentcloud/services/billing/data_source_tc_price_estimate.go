@@ -0,0 +1,860 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/price"
+)
+
+const (
+	PRICE_CHARGE_TYPE_PREPAID          = "PREPAID"
+	PRICE_CHARGE_TYPE_POSTPAID         = "POSTPAID"
+	PRICE_CHARGE_TYPE_POSTPAID_BY_HOUR = "POSTPAID_BY_HOUR"
+
+	PRICE_EIP_CHARGE_TYPE_PREPAID = "BANDWIDTH_PREPAID_BY_MONTH"
+
+	PRICE_CURRENCY_CNY = "CNY"
+	PRICE_CURRENCY_USD = "USD"
+	// PRICE_INTL_DOMAIN_PREFIX is the prefix of the root domains of the international site, such as
+	// `intl.tencentcloudapi.com`.
+	PRICE_INTL_DOMAIN_PREFIX = "intl."
+)
+
+var PRICE_COS_STORAGE_CLASSES = []string{
+	"STANDARD",
+	"STANDARD_IA",
+	"MAZ_STANDARD",
+	"MAZ_STANDARD_IA",
+	"INTELLIGENT_TIERING",
+	"MAZ_INTELLIGENT_TIERING",
+	"ARCHIVE",
+	"DEEP_ARCHIVE",
+}
+
+func DataSourceTencentCloudPriceEstimate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTencentCloudPriceEstimateReadContext,
+		Schema: map[string]*schema.Schema{
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateIntegerInRange(1, 36),
+				Description:  "The period in months to estimate the prices over. The prepaid items are priced for a subscription of `period` months, and the items postpaid by hour for `period` months of 730 hours. Default is `1`.",
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency of the prices: `CNY` on the China site and `USD` on the international site. It is the currency returned by the MySQL price API if any MySQL instance is estimated, or the one of the site of the provider otherwise, which is the international site if `domain` of the provider starts with `intl.`, such as `intl.tencentcloudapi.com`.",
+			},
+			"cvm_instances": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The CVM instances to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("cvm_instances"),
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The availability zone of the instances.",
+						},
+						"image_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The image of the instances.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the instances.",
+						},
+						"instance_charge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      PRICE_CHARGE_TYPE_POSTPAID_BY_HOUR,
+							ValidateFunc: tccommon.ValidateAllowedStringValue([]string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID_BY_HOUR}),
+							Description:  "The charge type of the instances. Valid values: `PREPAID`, `POSTPAID_BY_HOUR`. Default is `POSTPAID_BY_HOUR`.",
+						},
+						"system_disk_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the system disk, such as `CLOUD_PREMIUM`.",
+						},
+						"system_disk_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The size of the system disk in GB.",
+						},
+						"data_disks": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The data disks of the instances.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_disk_type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of the data disk, such as `CLOUD_PREMIUM`.",
+									},
+									"data_disk_size": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "The size of the data disk in GB.",
+									},
+								},
+							},
+						},
+						"internet_charge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The internet charge type of the instances, such as `TRAFFIC_POSTPAID_BY_HOUR`.",
+						},
+						"internet_max_bandwidth_out": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum outgoing bandwidth of the instances in Mbps.",
+						},
+						"instance_count": priceEstimateCountSchema("instances"),
+					},
+				},
+			},
+			"cbs_disks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The CBS disks to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("cbs_disks"),
+						"disk_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the disks, such as `CLOUD_PREMIUM`.",
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The size of the disks in GB.",
+						},
+						"charge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      PRICE_CHARGE_TYPE_POSTPAID_BY_HOUR,
+							ValidateFunc: tccommon.ValidateAllowedStringValue([]string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID_BY_HOUR}),
+							Description:  "The charge type of the disks. Valid values: `PREPAID`, `POSTPAID_BY_HOUR`. Default is `POSTPAID_BY_HOUR`.",
+						},
+						"throughput_performance": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The extra throughput performance of the disks in MB/s.",
+						},
+						"disk_count": priceEstimateCountSchema("disks"),
+					},
+				},
+			},
+			"mysql_instances": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The MySQL instances to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("mysql_instances"),
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The availability zone of the instances.",
+						},
+						"mem_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The memory size of the instances in MB.",
+						},
+						"volume_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The disk size of the instances in GB.",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The CPU cores of the instances, defaulting to the ones of `mem_size`.",
+						},
+						"device_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The device type of the instances, such as `UNIVERSAL` and `EXCLUSIVE`.",
+						},
+						"charge_type":    priceEstimateChargeTypeSchema("instances"),
+						"instance_count": priceEstimateCountSchema("instances"),
+					},
+				},
+			},
+			"redis_instances": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Redis instances to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("redis_instances"),
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The availability zone of the instances.",
+						},
+						"type_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The type of the instances, such as `8` for Redis 5.0 standard architecture.",
+						},
+						"mem_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The memory size of the instances, or of each shard of the cluster architecture, in MB.",
+						},
+						"redis_shard_num": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The number of the shards of the instances of the cluster architecture.",
+						},
+						"redis_replicas_num": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The number of the replicas of the instances.",
+						},
+						"charge_type":    priceEstimateChargeTypeSchema("instances"),
+						"instance_count": priceEstimateCountSchema("instances"),
+					},
+				},
+			},
+			"clb_instances": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The CLB instances to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("clb_instances"),
+						"network_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tccommon.ValidateAllowedStringValue([]string{"OPEN", "INTERNAL"}),
+							Description:  "The network type of the instances. Valid values: `OPEN`, `INTERNAL`.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The availability zone of the instances.",
+						},
+						"sla_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The specification of the instances, such as `clb.c2.medium`.",
+						},
+						"internet_charge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The internet charge type of the instances, such as `TRAFFIC_POSTPAID_BY_HOUR`.",
+						},
+						"internet_bandwidth_max_out": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum outgoing bandwidth of the instances in Mbps.",
+						},
+						"charge_type":    priceEstimateChargeTypeSchema("instances"),
+						"instance_count": priceEstimateCountSchema("instances"),
+					},
+				},
+			},
+			"eips": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The EIPs to estimate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": priceEstimateItemNameSchema("eips"),
+						"internet_charge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "TRAFFIC_POSTPAID_BY_HOUR",
+							Description: "The internet charge type of the EIPs, such as `BANDWIDTH_PREPAID_BY_MONTH`. Default is `TRAFFIC_POSTPAID_BY_HOUR`.",
+						},
+						"internet_max_bandwidth_out": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum outgoing bandwidth of the EIPs in Mbps.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the EIPs, such as `EIP` and `AnycastEIP`.",
+						},
+						"address_count": priceEstimateCountSchema("EIPs"),
+					},
+				},
+			},
+			"cos_buckets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The COS buckets to estimate. COS has no price inquiry API, so the buckets are not estimated but reported as warnings, and none of their prices is in `items`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the bucket in the warning. Default is `cos_buckets.<index>`.",
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      PRICE_COS_STORAGE_CLASSES[0],
+							ValidateFunc: tccommon.ValidateAllowedStringValue(PRICE_COS_STORAGE_CLASSES),
+							Description:  "The storage class of the objects of the bucket, such as `STANDARD_IA` and `ARCHIVE`. Default is `STANDARD`.",
+						},
+						"storage_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: tccommon.ValidateIntegerMin(1),
+							Description:  "The size of the objects stored in the bucket in GB.",
+						},
+					},
+				},
+			},
+
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The prices of the items estimated.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the item.",
+						},
+						"product": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product of the item: `cvm`, `cbs`, `mysql`, `redis`, `clb` or `eip`.",
+						},
+						"component": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The component of the item priced separately, such as `instance` and `bandwidth`.",
+						},
+						"charge_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charge type of the item.",
+						},
+						"charge_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charge unit of the unit prices of a postpaid item, such as `HOUR`. The items postpaid by other charge units than `HOUR`, such as by `GB` of traffic, are priced by usage and estimated at `0`.",
+						},
+						"unit_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The original unit price of a postpaid item.",
+						},
+						"unit_price_discount": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The discounted unit price of a postpaid item.",
+						},
+						"original_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The original price of the item over `period`.",
+						},
+						"discount_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The discounted price of the item over `period`.",
+						},
+					},
+				},
+			},
+			"total_original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total original price of the items over `period`.",
+			},
+			"total_discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total discounted price of the items over `period`.",
+			},
+
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func priceEstimateItemNameSchema(block string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("The name of the item in `items`. Default is `%s.<index>`.", block),
+	}
+}
+
+func priceEstimateChargeTypeSchema(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      PRICE_CHARGE_TYPE_POSTPAID,
+		ValidateFunc: tccommon.ValidateAllowedStringValue([]string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID}),
+		Description:  fmt.Sprintf("The charge type of the %s. Valid values: `PREPAID`, `POSTPAID`. Default is `POSTPAID`.", what),
+	}
+}
+
+func priceEstimateCountSchema(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: tccommon.ValidateIntegerMin(1),
+		Description:  fmt.Sprintf("The number of the %s. Default is `1`.", what),
+	}
+}
+
+// priceEstimateItem is an item of a price estimate.
+type priceEstimateItem struct {
+	name       string
+	product    string
+	component  string
+	chargeType string
+	price      price.Price
+}
+
+func dataSourceTencentCloudPriceEstimateReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourceTencentCloudPriceEstimateRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// COS has no price inquiry API, and its buckets, charged by usage, are reported instead of
+	// being left out silently.
+	var diags diag.Diagnostics
+	for i, v := range d.Get("cos_buckets").([]interface{}) {
+		m := v.(map[string]interface{})
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("COS bucket %s is not estimated", priceEstimateItemName(m, "cos_buckets", i)),
+			Detail:   fmt.Sprintf("COS has no price inquiry API. The %d GB of %s storage, the requests and the traffic of the bucket are charged by usage, and are not in the items or the total prices.", m["storage_size"].(int), m["storage_class"].(string)),
+		})
+	}
+	return diags
+}
+
+func dataSourceTencentCloudPriceEstimateRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_price_estimate.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId    = tccommon.GetLogId(tccommon.ContextNil)
+		ctx      = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service  = BillingService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		period   = d.Get("period").(int)
+		currency string
+		items    []priceEstimateItem
+	)
+
+	for i, v := range d.Get("cvm_instances").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "cvm_instances", i)
+		chargeType := m["instance_charge_type"].(string)
+
+		request := cvm.NewInquiryPriceRunInstancesRequest()
+		request.Placement = &cvm.Placement{Zone: helper.String(m["availability_zone"].(string))}
+		request.ImageId = helper.String(m["image_id"].(string))
+		request.InstanceType = helper.String(m["instance_type"].(string))
+		request.InstanceChargeType = helper.String(chargeType)
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.InstanceChargePrepaid = &cvm.InstanceChargePrepaid{Period: helper.IntInt64(period)}
+		}
+		if v := m["system_disk_type"].(string); v != "" || m["system_disk_size"].(int) > 0 {
+			request.SystemDisk = &cvm.SystemDisk{}
+			if v != "" {
+				request.SystemDisk.DiskType = helper.String(v)
+			}
+			if v := m["system_disk_size"].(int); v > 0 {
+				request.SystemDisk.DiskSize = helper.IntInt64(v)
+			}
+		}
+		for _, disk := range m["data_disks"].([]interface{}) {
+			diskMap := disk.(map[string]interface{})
+			request.DataDisks = append(request.DataDisks, &cvm.DataDisk{
+				DiskType: helper.String(diskMap["data_disk_type"].(string)),
+				DiskSize: helper.IntInt64(diskMap["data_disk_size"].(int)),
+			})
+		}
+		if v := m["internet_charge_type"].(string); v != "" || m["internet_max_bandwidth_out"].(int) > 0 {
+			request.InternetAccessible = &cvm.InternetAccessible{}
+			if v != "" {
+				request.InternetAccessible.InternetChargeType = helper.String(v)
+			}
+			if v := m["internet_max_bandwidth_out"].(int); v > 0 {
+				request.InternetAccessible.InternetMaxBandwidthOut = helper.IntInt64(v)
+			}
+		}
+		request.InstanceCount = helper.IntInt64(m["instance_count"].(int))
+
+		var result *cvm.Price
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.InquiryPriceRunInstances(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		prepaid := chargeType == PRICE_CHARGE_TYPE_PREPAID
+		if result.InstancePrice != nil {
+			items = append(items, priceEstimateItem{name, "cvm", "instance", chargeType, cvmItemPrice(prepaid, result.InstancePrice)})
+		}
+		if result.BandwidthPrice != nil {
+			items = append(items, priceEstimateItem{name, "cvm", "bandwidth", chargeType, cvmItemPrice(prepaid, result.BandwidthPrice)})
+		}
+	}
+
+	for i, v := range d.Get("cbs_disks").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "cbs_disks", i)
+		chargeType := m["charge_type"].(string)
+
+		request := cbs.NewInquiryPriceCreateDisksRequest()
+		request.DiskType = helper.String(m["disk_type"].(string))
+		request.DiskSize = helper.IntUint64(m["disk_size"].(int))
+		request.DiskChargeType = helper.String(chargeType)
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period)}
+		}
+		if v := m["throughput_performance"].(int); v > 0 {
+			request.ThroughputPerformance = helper.IntUint64(v)
+		}
+		request.DiskCount = helper.IntUint64(m["disk_count"].(int))
+
+		var result *cbs.Price
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.InquiryPriceCreateDisks(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		items = append(items, priceEstimateItem{name, "cbs", "disk", chargeType, price.Price{
+			Prepaid:           chargeType == PRICE_CHARGE_TYPE_PREPAID,
+			OriginalPrice:     helper.PFloat64(result.OriginalPrice),
+			DiscountPrice:     helper.PFloat64(result.DiscountPrice),
+			UnitPrice:         helper.PFloat64(result.UnitPrice),
+			UnitPriceDiscount: helper.PFloat64(result.UnitPriceDiscount),
+			ChargeUnit:        helper.PString(result.ChargeUnit),
+		}})
+	}
+
+	for i, v := range d.Get("mysql_instances").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "mysql_instances", i)
+		chargeType := m["charge_type"].(string)
+
+		request := cdb.NewDescribeDBPriceRequest()
+		request.Zone = helper.String(m["availability_zone"].(string))
+		request.Memory = helper.IntInt64(m["mem_size"].(int))
+		request.Volume = helper.IntInt64(m["volume_size"].(int))
+		if v := m["cpu"].(int); v > 0 {
+			request.Cpu = helper.IntInt64(v)
+		}
+		if v := m["device_type"].(string); v != "" {
+			request.DeviceType = helper.String(v)
+		}
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.PayType = helper.String("PRE_PAID")
+			request.Period = helper.IntInt64(period)
+		} else {
+			request.PayType = helper.String("HOUR_PAID")
+		}
+		request.GoodsNum = helper.IntInt64(m["instance_count"].(int))
+
+		var result *cdb.DescribeDBPriceResponseParams
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.DescribeDBPrice(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.Currency != nil && currency == "" {
+			currency = *result.Currency
+		}
+		originalPrice, discountPrice := price.FromCents(float64(helper.PInt64(result.OriginalPrice))), price.FromCents(float64(helper.PInt64(result.Price)))
+		items = append(items, priceEstimateItem{name, "mysql", "instance", chargeType, priceOfPrepaidOrHour(chargeType == PRICE_CHARGE_TYPE_PREPAID, originalPrice, discountPrice)})
+	}
+
+	for i, v := range d.Get("redis_instances").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "redis_instances", i)
+		chargeType := m["charge_type"].(string)
+
+		request := redis.NewInquiryPriceCreateInstanceRequest()
+		request.ZoneName = helper.String(m["availability_zone"].(string))
+		request.TypeId = helper.IntUint64(m["type_id"].(int))
+		request.MemSize = helper.IntUint64(m["mem_size"].(int))
+		if v := m["redis_shard_num"].(int); v > 0 {
+			request.RedisShardNum = helper.IntInt64(v)
+		}
+		if v := m["redis_replicas_num"].(int); v > 0 {
+			request.RedisReplicasNum = helper.IntInt64(v)
+		}
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.BillingMode = helper.IntInt64(1)
+			request.Period = helper.IntUint64(period)
+		} else {
+			request.BillingMode = helper.IntInt64(0)
+			request.Period = helper.IntUint64(1)
+		}
+		request.GoodsNum = helper.IntUint64(m["instance_count"].(int))
+
+		var result *redis.InquiryPriceCreateInstanceResponseParams
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.InquiryPriceCreateRedisInstance(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// the Redis price API returns a single price, which is both the original and the discounted one.
+		p := price.FromCents(helper.PFloat64(result.Price))
+		items = append(items, priceEstimateItem{name, "redis", "instance", chargeType, priceOfPrepaidOrHour(chargeType == PRICE_CHARGE_TYPE_PREPAID, p, p)})
+	}
+
+	for i, v := range d.Get("clb_instances").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "clb_instances", i)
+		chargeType := m["charge_type"].(string)
+
+		request := clb.NewInquiryPriceCreateLoadBalancerRequest()
+		request.LoadBalancerType = helper.String(m["network_type"].(string))
+		request.LoadBalancerChargeType = helper.String(chargeType)
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.LoadBalancerChargePrepaid = &clb.LBChargePrepaid{Period: helper.IntInt64(period)}
+		}
+		if v := m["availability_zone"].(string); v != "" {
+			request.ZoneId = helper.String(v)
+		}
+		if v := m["sla_type"].(string); v != "" {
+			request.SlaType = helper.String(v)
+		}
+		if v := m["internet_charge_type"].(string); v != "" || m["internet_bandwidth_max_out"].(int) > 0 {
+			request.InternetAccessible = &clb.InternetAccessible{}
+			if v != "" {
+				request.InternetAccessible.InternetChargeType = helper.String(v)
+			}
+			if v := m["internet_bandwidth_max_out"].(int); v > 0 {
+				request.InternetAccessible.InternetMaxBandwidthOut = helper.IntInt64(v)
+			}
+		}
+		request.GoodsNum = helper.IntUint64(m["instance_count"].(int))
+
+		var result *clb.Price
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.InquiryPriceCreateLoadBalancer(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		prepaid := chargeType == PRICE_CHARGE_TYPE_PREPAID
+		for _, component := range []struct {
+			name  string
+			price *clb.ItemPrice
+		}{{"instance", result.InstancePrice}, {"bandwidth", result.BandwidthPrice}, {"lcu", result.LcuPrice}} {
+			if component.price != nil {
+				items = append(items, priceEstimateItem{name, "clb", component.name, chargeType, clbItemPrice(prepaid, component.price)})
+			}
+		}
+	}
+
+	for i, v := range d.Get("eips").([]interface{}) {
+		m := v.(map[string]interface{})
+		name := priceEstimateItemName(m, "eips", i)
+		chargeType := m["internet_charge_type"].(string)
+
+		request := vpc.NewInquiryPriceAllocateAddressesRequest()
+		request.InternetChargeType = helper.String(chargeType)
+		if chargeType == PRICE_EIP_CHARGE_TYPE_PREPAID {
+			request.AddressChargePrepaid = &vpc.AddressChargePrepaid{Period: helper.IntInt64(period)}
+		}
+		if v := m["internet_max_bandwidth_out"].(int); v > 0 {
+			request.InternetMaxBandwidthOut = helper.IntInt64(v)
+		}
+		if v := m["type"].(string); v != "" {
+			request.AddressType = helper.String(v)
+		}
+
+		var result *vpc.InternetPrice
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			response, e := service.InquiryPriceAllocateAddresses(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			result = response
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if result.AddressPrice == nil {
+			continue
+		}
+
+		// the EIP price API prices a single EIP, with no discounted unit price.
+		count := float64(m["address_count"].(int))
+		items = append(items, priceEstimateItem{name, "eip", "address", chargeType, price.Price{
+			Prepaid:           chargeType == PRICE_EIP_CHARGE_TYPE_PREPAID,
+			OriginalPrice:     helper.PFloat64(result.AddressPrice.OriginalPrice) * count,
+			DiscountPrice:     helper.PFloat64(result.AddressPrice.DiscountPrice) * count,
+			UnitPrice:         helper.PFloat64(result.AddressPrice.UnitPrice) * count,
+			UnitPriceDiscount: helper.PFloat64(result.AddressPrice.UnitPrice) * count,
+			ChargeUnit:        helper.PString(result.AddressPrice.ChargeUnit),
+		}})
+	}
+
+	var (
+		itemList           = make([]map[string]interface{}, 0, len(items))
+		ids                = make([]string, 0, len(items))
+		totalOriginalPrice float64
+		totalDiscountPrice float64
+	)
+	for _, item := range items {
+		originalPrice, discountPrice := item.price.Estimate(period)
+		totalOriginalPrice += originalPrice
+		totalDiscountPrice += discountPrice
+		itemList = append(itemList, map[string]interface{}{
+			"name":                item.name,
+			"product":             item.product,
+			"component":           item.component,
+			"charge_type":         item.chargeType,
+			"charge_unit":         item.price.ChargeUnit,
+			"unit_price":          item.price.UnitPrice,
+			"unit_price_discount": item.price.UnitPriceDiscount,
+			"original_price":      originalPrice,
+			"discount_price":      discountPrice,
+		})
+		ids = append(ids, item.name+"/"+item.component)
+	}
+
+	if currency == "" {
+		currency = priceSiteCurrency(service.client.Domain)
+	}
+	_ = d.Set("currency", currency)
+	_ = d.Set("items", itemList)
+	_ = d.Set("total_original_price", price.Round(totalOriginalPrice))
+	_ = d.Set("total_discount_price", price.Round(totalDiscountPrice))
+
+	d.SetId(helper.DataResourceIdsHash(append(ids, fmt.Sprintf("%d", period))))
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), itemList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// priceSiteCurrency returns the currency of the site of the root domain of the provider.
+func priceSiteCurrency(domain string) string {
+	if strings.HasPrefix(domain, PRICE_INTL_DOMAIN_PREFIX) {
+		return PRICE_CURRENCY_USD
+	}
+	return PRICE_CURRENCY_CNY
+}
+
+// priceEstimateItemName returns the name of the item of m, the index-th of block.
+func priceEstimateItemName(m map[string]interface{}, block string, index int) string {
+	if v := m["name"].(string); v != "" {
+		return v
+	}
+	return fmt.Sprintf("%s.%d", block, index)
+}
+
+// priceOfPrepaidOrHour returns the price of an item priced for its subscription if prepaid, and
+// per hour if postpaid.
+func priceOfPrepaidOrHour(prepaid bool, originalPrice, discountPrice float64) price.Price {
+	if prepaid {
+		return price.Price{Prepaid: true, OriginalPrice: originalPrice, DiscountPrice: discountPrice}
+	}
+	return price.Price{UnitPrice: originalPrice, UnitPriceDiscount: discountPrice, ChargeUnit: price.ChargeUnitHour}
+}
+
+func cvmItemPrice(prepaid bool, itemPrice *cvm.ItemPrice) price.Price {
+	p := price.Price{
+		Prepaid:           prepaid,
+		OriginalPrice:     helper.PFloat64(itemPrice.OriginalPrice),
+		DiscountPrice:     helper.PFloat64(itemPrice.DiscountPrice),
+		UnitPrice:         helper.PFloat64(itemPrice.UnitPrice),
+		UnitPriceDiscount: helper.PFloat64(itemPrice.UnitPriceDiscount),
+		ChargeUnit:        helper.PString(itemPrice.ChargeUnit),
+	}
+	if itemPrice.UnitPriceSecondStep != nil && itemPrice.UnitPriceDiscountSecondStep != nil {
+		p.UnitPriceSteps = []float64{*itemPrice.UnitPriceSecondStep}
+		p.UnitPriceDiscountSteps = []float64{*itemPrice.UnitPriceDiscountSecondStep}
+		if itemPrice.UnitPriceThirdStep != nil && itemPrice.UnitPriceDiscountThirdStep != nil {
+			p.UnitPriceSteps = append(p.UnitPriceSteps, *itemPrice.UnitPriceThirdStep)
+			p.UnitPriceDiscountSteps = append(p.UnitPriceDiscountSteps, *itemPrice.UnitPriceDiscountThirdStep)
+		}
+	}
+	return p
+}
+
+func clbItemPrice(prepaid bool, itemPrice *clb.ItemPrice) price.Price {
+	return price.Price{
+		Prepaid:           prepaid,
+		OriginalPrice:     helper.PFloat64(itemPrice.OriginalPrice),
+		DiscountPrice:     helper.PFloat64(itemPrice.DiscountPrice),
+		UnitPrice:         helper.PFloat64(itemPrice.UnitPrice),
+		UnitPriceDiscount: helper.PFloat64(itemPrice.UnitPriceDiscount),
+		ChargeUnit:        helper.PString(itemPrice.ChargeUnit),
+	}
+}
@@ -0,0 +1,56 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+)
+
+func TestAccTencentCloudPriceEstimateDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{{
+			Config: testAccPriceEstimateDataSource,
+			Check: resource.ComposeTestCheckFunc(
+				tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_price_estimate.example"),
+				resource.TestCheckResourceAttr("data.tencentcloud_price_estimate.example", "currency", "CNY"),
+				resource.TestCheckResourceAttr("data.tencentcloud_price_estimate.example", "items.0.name", "web"),
+				resource.TestCheckResourceAttr("data.tencentcloud_price_estimate.example", "items.0.product", "cvm"),
+				resource.TestCheckResourceAttrSet("data.tencentcloud_price_estimate.example", "total_original_price"),
+				resource.TestCheckResourceAttrSet("data.tencentcloud_price_estimate.example", "total_discount_price"),
+			),
+		}},
+	})
+}
+
+const testAccPriceEstimateDataSource = tcacctest.DefaultInstanceVariable + `
+data "tencentcloud_price_estimate" "example" {
+  cvm_instances {
+    name              = "web"
+    availability_zone = var.availability_cvm_zone
+    image_id          = data.tencentcloud_images.default.images.0.image_id
+    instance_type     = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+  }
+
+  cbs_disks {
+    disk_type = "CLOUD_PREMIUM"
+    disk_size = 100
+  }
+
+  eips {
+    internet_charge_type       = "BANDWIDTH_POSTPAID_BY_HOUR"
+    internet_max_bandwidth_out = 10
+  }
+
+  cos_buckets {
+    storage_class = "STANDARD_IA"
+    storage_size  = 100
+  }
+}
+`
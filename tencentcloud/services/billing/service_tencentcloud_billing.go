@@ -7,6 +7,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	billingv20180709 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/billing/v20180709"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -175,3 +181,171 @@ func (me *BillingService) DescribeBillingBudgetOperationLogByFilter(ctx context.
 	}
 	return
 }
+
+// InquiryPriceRunInstances returns the price of the CVM instances of request.
+func (me *BillingService) InquiryPriceRunInstances(ctx context.Context, request *cvm.InquiryPriceRunInstancesRequest) (price *cvm.Price, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClient().InquiryPriceRunInstances(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.Price == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response.Price
+	return
+}
+
+// InquiryPriceCreateDisks returns the price of the CBS disks of request.
+func (me *BillingService) InquiryPriceCreateDisks(ctx context.Context, request *cbs.InquiryPriceCreateDisksRequest) (price *cbs.Price, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClient().InquiryPriceCreateDisks(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.DiskPrice == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response.DiskPrice
+	return
+}
+
+// DescribeDBPrice returns the price of the MySQL instances of request.
+func (me *BillingService) DescribeDBPrice(ctx context.Context, request *cdb.DescribeDBPriceRequest) (price *cdb.DescribeDBPriceResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMysqlClient().DescribeDBPrice(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response
+	return
+}
+
+// InquiryPriceCreateRedisInstance returns the price of the Redis instances of request.
+func (me *BillingService) InquiryPriceCreateRedisInstance(ctx context.Context, request *redis.InquiryPriceCreateInstanceRequest) (price *redis.InquiryPriceCreateInstanceResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseRedisClient().InquiryPriceCreateInstance(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response
+	return
+}
+
+// InquiryPriceCreateLoadBalancer returns the price of the CLB instances of request.
+func (me *BillingService) InquiryPriceCreateLoadBalancer(ctx context.Context, request *clb.InquiryPriceCreateLoadBalancerRequest) (price *clb.Price, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseClbClient().InquiryPriceCreateLoadBalancer(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.Price == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response.Price
+	return
+}
+
+// InquiryPriceAllocateAddresses returns the price of an EIP of request.
+func (me *BillingService) InquiryPriceAllocateAddresses(ctx context.Context, request *vpc.InquiryPriceAllocateAddressesRequest) (price *vpc.InternetPrice, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseVpcClient().InquiryPriceAllocateAddresses(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.Price == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	price = response.Response.Price
+	return
+}
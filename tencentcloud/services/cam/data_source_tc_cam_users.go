@@ -14,7 +14,7 @@ import (
 )
 
 func DataSourceTencentCloudCamUsers() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithOrganizationFanOut("user_list", false, &schema.Resource{
 		Read: dataSourceTencentCloudCamUsersRead,
 
 		Schema: map[string]*schema.Schema{
@@ -118,7 +118,7 @@ func DataSourceTencentCloudCamUsers() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudCamUsersRead(d *schema.ResourceData, meta interface{}) error {
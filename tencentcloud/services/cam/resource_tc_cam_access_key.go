@@ -214,6 +214,9 @@ func resourceTencentCloudCamAccessKeyDelete(d *schema.ResourceData, meta interfa
 
 	service := CamService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
+	if len(idSplit) != 2 {
+		return fmt.Errorf("id is broken,%s", idSplit)
+	}
 	uin := idSplit[0]
 	accessKey := idSplit[1]
 
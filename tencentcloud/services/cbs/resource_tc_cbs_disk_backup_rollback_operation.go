@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCbsDiskBackupRollbackOperationCreate,
 		Read:   resourceTencentCloudCbsDiskBackupRollbackOperationRead,
 		Delete: resourceTencentCloudCbsDiskBackupRollbackOperationDelete,
@@ -38,7 +39,7 @@ func ResourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
 				Description: "Whether the rollback is completed. `true` meaing rollback completed, `false` meaning still rollbacking.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(d *schema.ResourceData, meta interface{}) error {
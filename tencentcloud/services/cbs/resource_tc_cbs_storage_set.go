@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -24,6 +25,9 @@ func ResourceTencentCloudCbsStorageSet() *schema.Resource {
 		Read:   resourceTencentCloudCbsStorageSetRead,
 		Update: resourceTencentCloudCbsStorageSetUpdate,
 		Delete: resourceTencentCloudCbsStorageSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCbsStorageSetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -231,13 +235,20 @@ func resourceTencentCloudCbsStorageSetRead(d *schema.ResourceData, meta interfac
 	}
 
 	storage := storageSet[0]
+	diskIds, errRet := helper.StrToStrList(storageId)
+	if errRet != nil {
+		return errRet
+	}
 
+	_ = d.Set("disk_ids", diskIds)
 	_ = d.Set("disk_count", len(storageSet))
 	_ = d.Set("storage_type", storage.DiskType)
 	_ = d.Set("storage_size", storage.DiskSize)
 	_ = d.Set("availability_zone", storage.Placement.Zone)
 	_ = d.Set("dedicated_cluster_id", storage.Placement.DedicatedClusterId)
-	_ = d.Set("storage_name", d.Get("storage_name"))
+	if _, ok := d.GetOk("storage_name"); !ok {
+		_ = d.Set("storage_name", storage.DiskName)
+	}
 	_ = d.Set("project_id", storage.Placement.ProjectId)
 	_ = d.Set("encrypt", storage.Encrypt)
 	_ = d.Set("storage_status", storage.DiskState)
@@ -252,6 +263,20 @@ func resourceTencentCloudCbsStorageSetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceTencentCloudCbsStorageSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	diskIds := compositeid.Split(d.Id())
+	storageIds := make([]*string, 0, len(diskIds))
+	for _, diskId := range diskIds {
+		if diskId == "" {
+			return nil, fmt.Errorf("import id %s is broken, it should be in the format of <disk_id>#<disk_id>...", d.Id())
+		}
+		storageIds = append(storageIds, helper.String(diskId))
+	}
+	d.SetId(helper.StrListToStr(storageIds))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCbsStorageSetUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_storage_set.update")()
 
@@ -0,0 +1,101 @@
+package cbs_test
+
+import (
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	localcbs "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cbs"
+
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudCbsStorageSetResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { tcacctest.AccPreCheck(t) },
+		Providers:    tcacctest.AccProviders,
+		CheckDestroy: testAccCheckCbsStorageSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsStorageSet_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCbsStorageSetExists("tencentcloud_cbs_storage_set.storage_set"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage_set.storage_set", "disk_count", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage_set.storage_set", "disk_ids.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage_set.storage_set", "storage_name", "tf-storage-set"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage_set.storage_set", "storage_type", "CLOUD_PREMIUM"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage_set.storage_set", "storage_size", "50"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cbs_storage_set.storage_set",
+				ImportState:             true,
+				ImportStateIdFunc:       tcacctest.AccImportStateIdFunc("tencentcloud_cbs_storage_set.storage_set", "disk_ids.0", "disk_ids.1"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snapshot_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckCbsStorageSetDestroy(s *terraform.State) error {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	cbsService := localcbs.NewCbsService(tcacctest.AccProvider.Meta().(tccommon.ProviderMeta).GetAPIV3Conn())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cbs_storage_set" {
+			continue
+		}
+
+		storages, err := cbsService.DescribeDiskSetByIds(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(storages) > 0 {
+			return fmt.Errorf("cbs storage set still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckCbsStorageSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := tccommon.GetLogId(tccommon.ContextNil)
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cbs storage set %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cbs storage set id is not set")
+		}
+
+		cbsService := localcbs.NewCbsService(tcacctest.AccProvider.Meta().(tccommon.ProviderMeta).GetAPIV3Conn())
+		storages, err := cbsService.DescribeDiskSetByIds(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(storages) == 0 {
+			return fmt.Errorf("cbs storage set is not exist")
+		}
+		return nil
+	}
+}
+
+const testAccCbsStorageSet_basic = `
+resource "tencentcloud_cbs_storage_set" "storage_set" {
+  disk_count        = 2
+  storage_name      = "tf-storage-set"
+  storage_type      = "CLOUD_PREMIUM"
+  storage_size      = 50
+  availability_zone = "ap-guangzhou-3"
+  project_id        = 0
+  encrypt           = false
+}
+`
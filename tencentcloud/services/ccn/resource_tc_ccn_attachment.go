@@ -11,12 +11,21 @@ import (
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ccnAttachmentImportIdFormat is the import id of an attachment, whose id is the hash of the segments.
+var ccnAttachmentImportIdFormat = compositeid.New(
+	compositeid.String("ccn_id"),
+	compositeid.String("instance_type"),
+	compositeid.String("instance_region"),
+	compositeid.String("instance_id"),
+)
+
 func ResourceTencentCloudCcnAttachment() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.81.198. Please use 'tencentcloud_ccn_attachment_v2' instead.",
@@ -24,6 +33,9 @@ func ResourceTencentCloudCcnAttachment() *schema.Resource {
 		Read:               resourceTencentCloudCcnAttachmentRead,
 		Update:             resourceTencentCloudCcnAttachmentUpdate,
 		Delete:             resourceTencentCloudCcnAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCcnAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -350,3 +362,30 @@ func resourceTencentCloudCcnAttachmentDelete(d *schema.ResourceData, meta interf
 		return resource.RetryableError(fmt.Errorf("delete fail"))
 	})
 }
+
+func resourceTencentCloudCcnAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := ccnAttachmentImportIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ccnId          = id.Get("ccn_id")
+		instanceType   = id.Get("instance_type")
+		instanceRegion = id.Get("instance_region")
+		instanceId     = id.Get("instance_id")
+	)
+
+	_ = d.Set("ccn_id", ccnId)
+	_ = d.Set("instance_type", instanceType)
+	_ = d.Set("instance_region", instanceRegion)
+	_ = d.Set("instance_id", instanceId)
+
+	m := md5.New()
+	if _, err := m.Write([]byte(ccnId + instanceType + instanceRegion + instanceId)); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -49,6 +49,12 @@ func TestAccTencentCloudCcnAttachmentResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(keyNameVpngw, "route_ids.#"),
 				),
 			},
+			{
+				ResourceName:      keyNameVpngw,
+				ImportState:       true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc(keyNameVpngw, "ccn_id", "instance_type", "instance_region", "instance_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
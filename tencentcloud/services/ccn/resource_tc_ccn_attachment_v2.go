@@ -8,6 +8,7 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
@@ -15,6 +16,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// CcnAttachmentMove moves tencentcloud_ccn_attachment to tencentcloud_ccn_attachment_v2, whose id is
+// made of the arguments of the attachment instead of the hash of them.
+var CcnAttachmentMove = stateupgrade.Move{
+	From: "tencentcloud_ccn_attachment",
+	To:   "tencentcloud_ccn_attachment_v2",
+	ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+		values := make([]string, 0, 4)
+		for _, name := range []string{"ccn_id", "instance_type", "instance_region", "instance_id"} {
+			value, err := stateupgrade.String(attributes, name)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return []string{ccnAttachmentImportIdFormat.Join(values...)}, nil
+	},
+}
+
 func ResourceTencentCloudCcnAttachmentV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCcnAttachmentV2Create,
@@ -8,6 +8,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
+)
+
+var (
+	ccnBandwidthLimitIdFormat = compositeid.New(compositeid.String("ccn_id"), compositeid.String("region"))
+	// ccnBandwidthLimitImportIdFormat also takes the destination region of the `INTER_REGION_LIMIT` type.
+	ccnBandwidthLimitImportIdFormat = compositeid.New(
+		compositeid.String("ccn_id"),
+		compositeid.String("region"),
+		compositeid.Optional(compositeid.String("dst_region")),
+	)
 )
 
 func ResourceTencentCloudCcnBandwidthLimit() *schema.Resource {
@@ -16,6 +28,9 @@ func ResourceTencentCloudCcnBandwidthLimit() *schema.Resource {
 		Read:   resourceTencentCloudCcnBandwidthLimitRead,
 		Update: resourceTencentCloudCcnBandwidthLimitUpdate,
 		Delete: resourceTencentCloudCcnBandwidthLimitDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCcnBandwidthLimitImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -67,7 +82,7 @@ func resourceTencentCloudCcnBandwidthLimitCreate(d *schema.ResourceData, meta in
 		return fmt.Errorf("ccn[%s] doesn't exist", ccnId)
 	}
 
-	id := fmt.Sprintf("%s#%s", ccnId, region)
+	id := ccnBandwidthLimitIdFormat.Join(ccnId, region)
 
 	var (
 		dstRegion string
@@ -148,6 +163,22 @@ func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceTencentCloudCcnBandwidthLimitImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := ccnBandwidthLimitImportIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("ccn_id", id.Get("ccn_id"))
+	_ = d.Set("region", id.Get("region"))
+	if id.Has("dst_region") {
+		_ = d.Set("dst_region", id.Get("dst_region"))
+	}
+	d.SetId(ccnBandwidthLimitIdFormat.Join(id.Get("ccn_id"), id.Get("region")))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCcnBandwidthLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ccn_bandwidth_limit.update")()
 
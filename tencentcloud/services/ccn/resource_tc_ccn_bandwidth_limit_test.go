@@ -40,6 +40,11 @@ func TestAccTencentCloudCcnV3BandwidthLimitOuter(t *testing.T) {
 					resource.TestCheckResourceAttr(keyNameLimit1, "bandwidth_limit", "100"),
 				),
 			},
+			{
+				ResourceName:      keyNameLimit1,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -71,6 +76,12 @@ func TestAccTencentCloudCcnV3BandwidthLimitInter(t *testing.T) {
 					resource.TestCheckResourceAttr(keyNameLimit1, "bandwidth_limit", "100"),
 				),
 			},
+			{
+				ResourceName:      keyNameLimit1,
+				ImportState:       true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc(keyNameLimit1, "ccn_id", "region", "dst_region"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func DataSourceTencentCloudMysqlInstance() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithResultFilter("instance_list", &schema.Resource{
 		Read: dataSourceTencentCloudMysqlInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -284,7 +284,7 @@ func DataSourceTencentCloudMysqlInstance() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -137,3 +137,19 @@ var MYSQL_LOG_TO_CLS_TYPE = []string{
 	MYSQL_LOG_TO_CLS_TYPE_ERROR,
 	MYSQL_LOG_TO_CLS_TYPE_SLOW,
 }
+
+// Engine upgrade, from https://cloud.tencent.com/document/api/236/15866
+const (
+	// instance task status without any running task
+	MYSQL_INSTANCE_TASK_STATUS_NONE = 0
+	// instance task status while the upgraded instance is waiting for switching
+	MYSQL_INSTANCE_TASK_STATUS_WAIT_SWITCH = 15
+
+	MYSQL_JOB_STATUS_INITIAL = "INITIAL"
+	MYSQL_JOB_STATUS_RUNNING = "RUNNING"
+	MYSQL_JOB_STATUS_WAITING = "WAITING"
+	MYSQL_JOB_STATUS_SUCCEED = "SUCCEED"
+
+	// maintenance time windows of mysql are in Beijing time
+	MYSQL_UPGRADE_WINDOW_UTC_OFFSET = 8 * 3600
+)
@@ -4,13 +4,22 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
+)
+
+// mysqlAccountIdFormat is the id of an account, the host is left out when it is the default `%`.
+var mysqlAccountIdFormat = compositeid.New(
+	compositeid.String("mysql_id"),
+	compositeid.String("account_name"),
+	compositeid.Optional(compositeid.String("account_host")),
 )
 
 func ResourceTencentCloudMysqlAccount() *schema.Resource {
@@ -72,6 +81,8 @@ func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
@@ -88,33 +99,17 @@ func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create account %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-		}
-		err = fmt.Errorf("%s create account task status is %s,we won't wait for it finish ,it show message:%s", mysqlId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql account fail, reason:%s\n ", logId, err.Error())
 		return err
 	}
 
-	resourceId := fmt.Sprintf("%s%s%s", mysqlId, tccommon.FILED_SP, accountName)
-
-	if accountHost != MYSQL_DEFAULT_ACCOUNT_HOST {
-		resourceId += tccommon.FILED_SP + accountHost
+	if accountHost == MYSQL_DEFAULT_ACCOUNT_HOST {
+		accountHost = ""
 	}
-
-	d.SetId(resourceId)
+	d.SetId(mysqlAccountIdFormat.Join(mysqlId, accountName, accountHost))
 
 	return resourceTencentCloudMysqlAccountRead(d, meta)
 }
@@ -128,21 +123,24 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
+	id, err := mysqlAccountIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
-		mysqlId                      = items[0]
-		accountName                  = items[1]
+		mysqlId                      = id.Get("mysql_id")
+		accountName                  = id.Get("account_name")
 		accountHost                  = MYSQL_DEFAULT_ACCOUNT_HOST
 		accountInfo *cdb.AccountInfo = nil
 	)
 
-	if len(items) == 3 {
-		accountHost = items[2]
+	if id.Has("account_host") {
+		accountHost = id.Get("account_host")
 	}
 
 	var onlineHas = true
-	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		allAccounts, e := mysqlService.DescribeAccounts(ctx, mysqlId)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -188,19 +186,24 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
+	id, err := mysqlAccountIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
-		mysqlId     = items[0]
-		accountName = items[1]
+		mysqlId     = id.Get("mysql_id")
+		accountName = id.Get("account_name")
 		accountHost = MYSQL_DEFAULT_ACCOUNT_HOST
 	)
 
-	if len(items) == 3 {
-		accountHost = items[2]
+	if id.Has("account_host") {
+		accountHost = id.Get("account_host")
 	}
 
 	d.Partial(true)
@@ -212,20 +215,7 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 			return err
 		}
 
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify account  description %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account description task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account description fail, reason:%s\n ", logId, err.Error())
@@ -241,21 +231,7 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 			return err
 		}
 
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify mysql account password %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account password task status is %s,we won't wait for it finish ,it show message:%s", taskStatus,
-				message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account password fail, reason:%s\n ", logId, err.Error())
@@ -271,20 +247,7 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 			return err
 		}
 
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify mysql account maxUserConnections %s task  status is %s", mysqlId, accountName, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account maxUserConnections task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account maxUserConnections fail, reason:%s\n ", logId, err.Error())
@@ -303,18 +266,24 @@ func resourceTencentCloudMysqlAccountDelete(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
+	id, err := mysqlAccountIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
-		mysqlId     = items[0]
-		accountName = items[1]
+		mysqlId     = id.Get("mysql_id")
+		accountName = id.Get("account_name")
 		accountHost = MYSQL_DEFAULT_ACCOUNT_HOST
 	)
-	if len(items) == 3 {
-		accountHost = items[2]
+
+	if id.Has("account_host") {
+		accountHost = id.Get("account_host")
 	}
 
 	asyncRequestId, err := mysqlService.DeleteAccount(ctx, mysqlId, accountName, accountHost)
@@ -322,21 +291,7 @@ func resourceTencentCloudMysqlAccountDelete(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s delete mysql account %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-		}
-		err = fmt.Errorf("delete mysql account  task status is %s,we won't wait for it finish ,it show message:%s", taskStatus,
-			message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		return err
@@ -3,7 +3,6 @@ package cdb
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -16,6 +15,7 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 type ResourceTencentCloudMysqlAccountPrivilegeId struct {
@@ -219,6 +219,8 @@ func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, met
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
@@ -262,23 +264,7 @@ func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("modify account privilege   task  status is %s", taskStatus))
-			}
-			if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-				return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-			}
-			err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify account privilege fail, reason:%s\n ", logId, err.Error())
@@ -297,6 +283,8 @@ func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, met
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
@@ -329,20 +317,7 @@ func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("delete account privilege   task  status is %s", taskStatus))
-		}
-		err = fmt.Errorf("delete account privilege  task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete account privilege fail, reason:%s\n ", logId, err.Error())
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,9 @@ func ResourceTencentCloudMysqlBackupPolicy() *schema.Resource {
 		Read:   resourceTencentCloudMysqlBackupPolicyRead,
 		Update: resourceTencentCloudMysqlBackupPolicyUpdate,
 		Delete: resourceTencentCloudMysqlBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
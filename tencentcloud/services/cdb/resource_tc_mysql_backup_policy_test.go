@@ -48,6 +48,11 @@ func TestAccTencentCloudMysqlBackupPolicyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_mysql_backup_policy.mysql_backup_policy", "binlog_standby_days", "31"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_backup_policy.mysql_backup_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -24,17 +24,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// MysqlBackupPolicyMove moves tencentcloud_mysql_backup_policy to tencentcloud_mysql_backup_policy_v2,
+// both of which are identified by the mysql id.
+var MysqlBackupPolicyMove = stateupgrade.Move{
+	From: "tencentcloud_mysql_backup_policy",
+	To:   "tencentcloud_mysql_backup_policy_v2",
+	ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+		mysqlId, err := stateupgrade.String(attributes, "mysql_id")
+		if err != nil {
+			return nil, err
+		}
+		return []string{mysqlId}, nil
+	},
+}
+
 func ResourceTencentCloudMysqlBackupPolicyV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTencentCloudMysqlBackupPolicyV2Create,
 		ReadContext:   resourceTencentCloudMysqlBackupPolicyV2Read,
 		UpdateContext: resourceTencentCloudMysqlBackupPolicyV2Update,
 		DeleteContext: resourceTencentCloudMysqlBackupPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
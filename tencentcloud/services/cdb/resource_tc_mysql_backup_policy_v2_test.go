@@ -0,0 +1,50 @@
+package cdb_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// go test -i; go test -test.run TestAccTencentCloudMysqlBackupPolicyV2Resource_basic -v
+func TestAccTencentCloudMysqlBackupPolicyV2Resource_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlBackupPolicyV2(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_mysql_backup_policy_v2.mysql_backup_policy", "mysql_id"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_backup_policy_v2.mysql_backup_policy", "retention_period", "56"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_backup_policy_v2.mysql_backup_policy", "binlog_period", "35"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_backup_policy_v2.mysql_backup_policy", "enable_binlog_standby", "on"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_backup_policy_v2.mysql_backup_policy", "binlog_standby_days", "33"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_mysql_backup_policy_v2.mysql_backup_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_backup_period_save_date"},
+			},
+		},
+	})
+}
+
+func testAccMysqlBackupPolicyV2() string {
+	return fmt.Sprintf(`
+%s
+resource "tencentcloud_mysql_backup_policy_v2" "mysql_backup_policy" {
+  mysql_id              = local.mysql_id
+  retention_period      = 56
+  binlog_period         = 35
+  enable_binlog_standby = "on"
+  binlog_standby_days   = 33
+}`, tcacctest.CommonPresetMysql)
+}
@@ -2,9 +2,7 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -12,14 +10,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// mysqlClsLogAttachmentIdFormat is the id of the log shipping of an instance, which is also its import id.
+var mysqlClsLogAttachmentIdFormat = compositeid.New(compositeid.String("instance_id"), compositeid.String("log_type"))
+
 func ResourceTencentCloudMysqlClsLogAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlClsLogAttachmentCreate,
 		Read:   resourceTencentCloudMysqlClsLogAttachmentRead,
 		Delete: resourceTencentCloudMysqlClsLogAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: mysqlClsLogAttachmentIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -162,7 +167,7 @@ func resourceTencentCloudMysqlClsLogAttachmentCreate(d *schema.ResourceData, met
 		return err
 	}
 
-	d.SetId(strings.Join([]string{instanceId, logType}, tccommon.FILED_SP))
+	d.SetId(mysqlClsLogAttachmentIdFormat.Join(instanceId, logType))
 
 	return resourceTencentCloudMysqlClsLogAttachmentRead(d, meta)
 }
@@ -177,13 +182,13 @@ func resourceTencentCloudMysqlClsLogAttachmentRead(d *schema.ResourceData, meta
 		service = MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := mysqlClsLogAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	instanceId := idSplit[0]
-	logType := idSplit[1]
+	instanceId := id.Get("instance_id")
+	logType := id.Get("log_type")
 
 	logToCLSResponseParam, err := service.DescribeMysqlInstanceLogToCLSById(ctx, instanceId)
 	if err != nil {
@@ -233,6 +238,14 @@ func resourceTencentCloudMysqlClsLogAttachmentRead(d *schema.ResourceData, meta
 		}
 	}
 
+	// without `create_log_set` and `create_log_topic`, the log set and topic are given by id, as on import.
+	if _, ok := d.GetOk("log_set"); !ok {
+		_ = d.Set("log_set", d.Get("log_set_id"))
+	}
+	if _, ok := d.GetOk("log_topic"); !ok {
+		_ = d.Set("log_topic", d.Get("log_topic_id"))
+	}
+
 	return nil
 }
 
@@ -246,13 +259,13 @@ func resourceTencentCloudMysqlClsLogAttachmentDelete(d *schema.ResourceData, met
 		service = MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := mysqlClsLogAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	instanceId := idSplit[0]
-	logType := idSplit[1]
+	instanceId := id.Get("instance_id")
+	logType := id.Get("log_type")
 
 	if err := service.DeleteMysqlInstanceLogToCLSById(ctx, instanceId, logType); err != nil {
 		return err
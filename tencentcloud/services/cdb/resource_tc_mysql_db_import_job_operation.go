@@ -12,10 +12,12 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMysqlDbImportJobOperationCreate,
 		Read:   resourceTencentCloudMysqlDbImportJobOperationRead,
 		Delete: resourceTencentCloudMysqlDbImportJobOperationDelete,
@@ -69,7 +71,7 @@ func ResourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 				Description: "The request ID of the asynchronous task.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -78,6 +80,8 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	var (
 		request    = mysql.NewCreateDBImportJobRequest()
@@ -115,6 +119,7 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -126,20 +131,7 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create dbImportJob  status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create dbImportJob status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbImportJob fail, reason:%s\n ", logId, err.Error())
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
@@ -14,6 +15,7 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlDrInstance() *schema.Resource {
@@ -28,6 +30,9 @@ func ResourceTencentCloudMysqlDrInstance() *schema.Resource {
 				"force_delete":   false,
 			}),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(6 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"master_instance_id": {
 				Type:        schema.TypeString,
@@ -433,6 +438,8 @@ func resourceTencentCloudMysqlDrInstanceUpdate(d *schema.ResourceData, meta inte
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	mysqlID := d.Id()
 	payType := getPayType(d).(int)
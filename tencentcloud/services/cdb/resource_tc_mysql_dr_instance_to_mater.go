@@ -2,10 +2,10 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,6 +81,8 @@ func resourceTencentCloudMysqlDrInstanceToMaterUpdate(d *schema.ResourceData, me
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := mysql.NewSwitchDrInstanceToMasterRequest()
 	response := mysql.NewSwitchDrInstanceToMasterResponse()
@@ -105,20 +107,7 @@ func resourceTencentCloudMysqlDrInstanceToMaterUpdate(d *schema.ResourceData, me
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s DrInstanceToMaster status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s update mysql drInstanceToMater status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql drInstanceToMater fail, reason:%s\n ", logId, err.Error())
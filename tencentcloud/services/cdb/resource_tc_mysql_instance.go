@@ -15,6 +15,8 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 //internal version: replace import begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -289,7 +291,7 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 			Optional:     true,
 			ValidateFunc: tccommon.ValidateAllowedStringValue(MYSQL_SUPPORTS_ENGINE),
 			Default:      MYSQL_SUPPORTS_ENGINE[len(MYSQL_SUPPORTS_ENGINE)-2],
-			Description:  "The version number of the database engine to use. Supported versions include 5.5/5.6/5.7/8.0, and default is 5.7. Upgrade the instance engine version to support 5.6/5.7 and switch immediately.",
+			Description:  "The version number of the database engine to use. Supported versions include 5.5/5.6/5.7/8.0, and default is 5.7. Upgrading the engine version is supported one major version at a time, e.g. 5.6 to 5.7, see `upgrade_check`, `upgrade_migrate_parameters`, `upgrade_window` and `switch_at_maintenance`.",
 		},
 		"engine_type": {
 			Type:        schema.TypeString,
@@ -307,6 +309,31 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 			Optional:    true,
 			Description: "Latency threshold. Value range 1~10. Only need to fill in when upgrading kernel subversion and engine version.",
 		},
+		"upgrade_check": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to run the upgrade check before upgrading `engine_version`. The upgrade is aborted if the check fails, and the failure of the latest check is reported at plan time. Default is `true`.",
+		},
+		"upgrade_migrate_parameters": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to carry over the custom parameters of `param_template_id` and of the instance when upgrading `engine_version`. Parameters not supported by the new version are dropped. Default is `true`.",
+		},
+		"upgrade_window": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validateMysqlUpgradeWindow,
+			ConflictsWith: []string{"switch_at_maintenance"},
+			Description:   "The time window to switch to the upgraded engine version, in the format of `HH:mm-HH:mm` in UTC+8, e.g. `02:00-04:00`. The apply waits for the window to open before switching, within the update timeout, which is `6h` by default.",
+		},
+		"switch_at_maintenance": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to switch to the upgraded engine version in the maintenance time window of the instance instead of immediately. Default is `false`.",
+		},
 
 		"availability_zone": {
 			Type:        schema.TypeString,
@@ -370,12 +397,14 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 	for k, v := range basic {
 		specialInfo[k] = v
 	}
-	return &schema.Resource{
-		Create: resourceTencentCloudMysqlInstanceCreate,
-		Read:   resourceTencentCloudMysqlInstanceRead,
-		Update: resourceTencentCloudMysqlInstanceUpdate,
-		Delete: resourceTencentCloudMysqlInstanceDelete,
-		Schema: specialInfo,
+	resource := &schema.Resource{
+		Create:        resourceTencentCloudMysqlInstanceCreate,
+		Read:          resourceTencentCloudMysqlInstanceRead,
+		Update:        resourceTencentCloudMysqlInstanceUpdate,
+		Delete:        resourceTencentCloudMysqlInstanceDelete,
+		Schema:        specialInfo,
+		CustomizeDiff: resourceTencentCloudMysqlInstanceCustomizeDiff,
+		SchemaVersion: 1,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				importMysqlFlag = true
@@ -390,6 +419,10 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 					"slave_deploy_mode": 0,
 					"slave_sync_mode":   0,
 					"project_id":        0,
+
+					"upgrade_check":              true,
+					"upgrade_migrate_parameters": true,
+					"switch_at_maintenance":      false,
 				}
 
 				for k, v := range defaultValues {
@@ -400,9 +433,18 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Hour),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{
+		stateupgrade.Upgrader(0, resourceTencentCloudMysqlInstanceV0(resource),
+			stateupgrade.Default("upgrade_check", true),
+			stateupgrade.Default("upgrade_migrate_parameters", true),
+			stateupgrade.Default("switch_at_maintenance", false),
+		),
+	}
+	return resource
 }
 
 /*
@@ -799,6 +841,8 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	//internal version: replace mysqlServer begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
@@ -863,25 +907,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("create account task status is %s", taskStatus))
-			}
-			err = fmt.Errorf("open internet service task status is %s,we won't wait for it finish ,it show message:%s", ",",
-				message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s open internet service fail, reason:%s\n ", logId, err.Error())
@@ -1236,27 +1262,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 
 			if waitSwitch != InWindow {
-				err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-					taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-					if err != nil {
-						if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-							return resource.RetryableError(err)
-						} else {
-							return resource.NonRetryableError(err)
-						}
-					}
-
-					if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-						return nil
-					}
-					if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-						return resource.RetryableError(fmt.Errorf("update mysql mem_size/volume_size status is %s", taskStatus))
-					}
-					err = fmt.Errorf("update mysql mem_size/volume_size task status is %s, we won't wait for it finish, it show message:%s",
-						taskStatus, message)
-					return resource.NonRetryableError(err)
-				})
+				err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 				if err != nil {
 					log.Printf("[CRITAL]%s update mysql mem_size/volume_size fail, reason:%s\n", logId, err.Error())
@@ -1345,27 +1351,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 
 			if waitSwitch != InWindow {
-				err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-					taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-					if err != nil {
-						if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-							return resource.RetryableError(err)
-						} else {
-							return resource.NonRetryableError(err)
-						}
-					}
-
-					if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-						return nil
-					}
-					if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-						return resource.RetryableError(fmt.Errorf("update mysql mem_size/volume_size status is %s", taskStatus))
-					}
-					err = fmt.Errorf("update mysql mem_size/volume_size task status is %s, we won't wait for it finish, it show message:%s",
-						taskStatus, message)
-					return resource.NonRetryableError(err)
-				})
+				err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 				if err != nil {
 					log.Printf("[CRITAL]%s update mysql mem_size/volume_size fail, reason:%s\n ", logId, err.Error())
@@ -1429,79 +1415,9 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if d.HasChange("engine_version") || d.HasChange("upgrade_subversion") || d.HasChange("max_deay_time") {
-		engineVersion := ""
-		var upgradeSubversion int64
-		var maxDelayTime int64
-		var waitSwitch int64 = 0
-		if v, ok := d.GetOk("engine_version"); ok {
-			engineVersion = v.(string)
-		}
-		if v, ok := d.GetOk("upgrade_subversion"); ok {
-			upgradeSubversion = int64(v.(int))
-		}
-		if v, ok := d.GetOk("max_deay_time"); ok {
-			maxDelayTime = int64(v.(int))
-		}
-		if v, ok := d.GetOkExists("wait_switch"); ok {
-			waitSwitch = int64(v.(int))
-		}
-
-		asyncRequestId, err := mysqlService.UpgradeDBInstanceEngineVersion(ctx, d.Id(), engineVersion, upgradeSubversion, maxDelayTime, waitSwitch)
-		if err != nil {
+		if err := mysqlUpgradeEngineVersion(ctx, d, meta); err != nil {
 			return err
 		}
-
-		if waitSwitch != InWindow {
-			err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql engineVersion status is %s", taskStatus))
-				}
-				err = fmt.Errorf("update mysql engineVersion task status is %s, we won't wait for it finish, it show message:%s",
-					taskStatus, message)
-				return resource.NonRetryableError(err)
-			})
-
-			if err != nil {
-				log.Printf("[CRITAL]%s update mysql engineVersion fail, reason:%s\n", logId, err.Error())
-				return err
-			}
-		} else {
-			err = resource.Retry(tccommon.ReadRetryTimeout*5, func() *resource.RetryError {
-				mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
-
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-
-				if *mysqlInfo.TaskStatus == 15 {
-					return nil
-				}
-				return resource.RetryableError(fmt.Errorf("update mysql engineVersion task status is %v", mysqlInfo.TaskStatus))
-			})
-
-			if err != nil {
-				log.Printf("[CRITAL]%s update mysql engineVersion fail, reason:%s\n", logId, err.Error())
-				return err
-			}
-
-		}
 	}
 
 	if d.HasChange("cluster_topology") {
@@ -1599,27 +1515,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		asyncRequestId = *response.Response.AsyncRequestId
 		if waitSwitch != InWindow {
-			err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql cluster topology status is %s", taskStatus))
-				}
-
-				err = fmt.Errorf("update mysql cluster topology task status is %s, we won't wait for it finish, it show message:%s", taskStatus, message)
-				return resource.NonRetryableError(err)
-			})
+			err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update mysql cluster topology fail, reason:%s\n ", logId, err.Error())
@@ -1675,9 +1571,6 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 		return fmt.Errorf("argument `availability_zone` cannot be modified for now")
 	}
 
-	if d.HasChange("engine_version") {
-		return fmt.Errorf("argument `engine_version` cannot be modified for now")
-	}
 	return nil
 }
 
@@ -1756,25 +1649,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 				log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 				return err
 			}
-			err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-				}
-				err = fmt.Errorf("update mysql   task status is %s,we won't wait for it finish ,it show message:%s",
-					tag, message)
-				return resource.NonRetryableError(err)
-			})
+			err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 			if err != nil {
 				log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 				return err
@@ -1801,25 +1676,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 			return err
 		}
-		err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-			}
-			err = fmt.Errorf("update mysql task status is %s,we won't wait for it finish ,it show message:%s",
-				tag, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 			return err
@@ -1840,25 +1697,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 
-		err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("change root password status is %s", taskStatus))
-			}
-			err = fmt.Errorf("change root password task status is %s,we won't wait for it finish ,it show message:%s",
-				taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 		if err != nil {
 			log.Printf("[CRITAL]%s change root password   fail, reason:%s\n ", logId, err.Error())
 			return err
@@ -1906,6 +1745,8 @@ func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interf
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	payType := getPayType(d).(int)
 
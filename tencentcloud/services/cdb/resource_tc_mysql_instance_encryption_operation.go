@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMysqlInstanceEncryptionOperationCreate,
 		Read:   resourceTencentCloudMysqlInstanceEncryptionOperationRead,
 		Delete: resourceTencentCloudMysqlInstanceEncryptionOperationDelete,
@@ -42,7 +43,7 @@ func ResourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
 				Description: "Custom storage region, such as ap-guangzhou. When `KeyId` is not empty, this parameter is required.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -75,6 +76,7 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.Resour
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -0,0 +1,419 @@
+package cdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/engineupgrade"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
+)
+
+// resourceTencentCloudMysqlInstanceV0 returns the instance of schema version 0, which had none of
+// the arguments of the engine upgrade. As they were absent from its state, the upgrade sets their
+// defaults, which would otherwise show in the first plan.
+func resourceTencentCloudMysqlInstanceV0(current *schema.Resource) *schema.Resource {
+	added := map[string]bool{
+		"upgrade_check":              true,
+		"upgrade_migrate_parameters": true,
+		"upgrade_window":             true,
+		"switch_at_maintenance":      true,
+	}
+	prior := make(map[string]*schema.Schema, len(current.Schema))
+	for name, s := range current.Schema {
+		if !added[name] {
+			prior[name] = s
+		}
+	}
+	return &schema.Resource{Schema: prior}
+}
+
+func validateMysqlUpgradeWindow(v interface{}, k string) (ws []string, errs []error) {
+	if !engineupgrade.WindowRegexp.MatchString(v.(string)) {
+		errs = append(errs, fmt.Errorf("%s must be in the format of `HH:mm-HH:mm`, got %q", k, v.(string)))
+	}
+	return
+}
+
+// resourceTencentCloudMysqlInstanceCustomizeDiff surfaces the incompatibilities of an engine upgrade at plan time.
+func resourceTencentCloudMysqlInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("engine_version") {
+		return nil
+	}
+
+	oldVersion, newVersion := d.GetChange("engine_version")
+	if err := checkMysqlEngineUpgradePath(oldVersion.(string), newVersion.(string)); err != nil {
+		return err
+	}
+
+	var (
+		logId        = tccommon.GetLogId(tccommon.ContextNil)
+		diffCtx      = context.WithValue(ctx, tccommon.LogIdKey, logId)
+		mysqlService = MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	)
+
+	if parameters, ok := d.Get("parameters").(map[string]interface{}); ok && len(parameters) > 0 && d.NewValueKnown("parameters") {
+		var defaults []*cdb.ParameterDetail
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := mysqlService.DescribeDefaultParameters(diffCtx, newVersion.(string))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			defaults = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		supported := make(map[string]bool, len(defaults))
+		for _, parameter := range defaults {
+			if parameter.Name != nil {
+				supported[*parameter.Name] = true
+			}
+		}
+		unsupported := make([]string, 0)
+		for name := range parameters {
+			if !supported[name] {
+				unsupported = append(unsupported, name)
+			}
+		}
+		if len(unsupported) > 0 {
+			sort.Strings(unsupported)
+			return fmt.Errorf("`parameters` %s are not supported by MySQL %s, remove them before upgrading", strings.Join(unsupported, ","), newVersion)
+		}
+	}
+
+	if !d.Get("upgrade_check").(bool) {
+		return nil
+	}
+
+	// only the result of an existing check job is looked up, a new one is submitted on apply.
+	var (
+		jobId int64
+		exist bool
+		task  *cdb.TaskDetail
+	)
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var e error
+		jobId, exist, e = mysqlService.DescribeInstanceUpgradeCheckJob(diffCtx, d.Id(), newVersion.(string))
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		if exist {
+			task, e = mysqlService.DescribeMysqlTaskByJobId(diffCtx, d.Id(), jobId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if task != nil && isMysqlJobFailed(task) {
+		return fmt.Errorf("the latest upgrade check of mysql %s to %s failed: %s. Fix the incompatibilities, or set `upgrade_check` to false to skip the check",
+			d.Id(), newVersion, helper.PString(task.Message))
+	}
+
+	return nil
+}
+
+// checkMysqlEngineUpgradePath rejects downgrades and upgrades skipping a major version.
+func checkMysqlEngineUpgradePath(oldVersion, newVersion string) error {
+	return engineupgrade.CheckPath(MYSQL_SUPPORTS_ENGINE, oldVersion, newVersion)
+}
+
+func isMysqlJobFailed(task *cdb.TaskDetail) bool {
+	switch helper.PString(task.TaskStatus) {
+	case MYSQL_JOB_STATUS_INITIAL, MYSQL_JOB_STATUS_RUNNING, MYSQL_JOB_STATUS_WAITING, MYSQL_JOB_STATUS_SUCCEED:
+		return false
+	}
+	return true
+}
+
+// mysqlUpgradeEngineVersion upgrades the engine version or the kernel subversion of the instance.
+// An engine version upgrade runs the upgrade check, carries over the custom parameters, and switches
+// immediately, in `upgrade_window`, or in the maintenance time window of the instance.
+func mysqlUpgradeEngineVersion(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	var (
+		logId             = tccommon.GetLogId(ctx)
+		mysqlService      = MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		instanceId        = d.Id()
+		engineVersion     = d.Get("engine_version").(string)
+		upgradeSubversion int64
+		maxDelayTime      int64
+		waitSwitch        int64
+		upgradeWindow     = d.Get("upgrade_window").(string)
+		params            map[string]string
+	)
+
+	if v, ok := d.GetOk("upgrade_subversion"); ok {
+		upgradeSubversion = int64(v.(int))
+	}
+	if v, ok := d.GetOk("max_deay_time"); ok {
+		maxDelayTime = int64(v.(int))
+	}
+	if v, ok := d.GetOkExists("wait_switch"); ok {
+		waitSwitch = int64(v.(int))
+	}
+	if d.Get("switch_at_maintenance").(bool) || upgradeWindow != "" {
+		waitSwitch = InWindow
+	}
+
+	if d.HasChange("engine_version") {
+		oldVersion, _ := d.GetChange("engine_version")
+		if err := checkMysqlEngineUpgradePath(oldVersion.(string), engineVersion); err != nil {
+			return err
+		}
+		upgradeSubversion = 0
+
+		if d.Get("upgrade_check").(bool) {
+			if err := mysqlRunUpgradeCheck(ctx, &mysqlService, instanceId, engineVersion); err != nil {
+				return err
+			}
+		}
+
+		if d.Get("upgrade_migrate_parameters").(bool) {
+			var err error
+			params, err = mysqlEngineUpgradeParameters(ctx, &mysqlService, d, engineVersion)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// the upgrade request is not idempotent: it is not sent while a task of the instance runs, and it is
+	// only sent again if it was rejected, not if its result is unknown.
+	var asyncRequestId string
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		mysqlInfo, e := mysqlService.DescribeDBInstanceById(ctx, instanceId)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		if mysqlInfo != nil && helper.PInt64(mysqlInfo.TaskStatus) != MYSQL_INSTANCE_TASK_STATUS_NONE {
+			return resource.NonRetryableError(fmt.Errorf("mysql %s has a running task, task status %d, upgrade it after the task finishes", instanceId, *mysqlInfo.TaskStatus))
+		}
+
+		result, e := mysqlService.UpgradeDBInstanceEngineVersion(ctx, instanceId, engineVersion, upgradeSubversion, maxDelayTime, waitSwitch, params)
+		if e != nil {
+			if sdkErr, ok := e.(*errors.TencentCloudSDKError); ok && strings.HasPrefix(sdkErr.Code, "ClientError.") {
+				return resource.NonRetryableError(fmt.Errorf("upgrade request of mysql %s may have been sent, check the tasks of the instance before applying again: %w", instanceId, e))
+			}
+			return tccommon.RetryError(e)
+		}
+		asyncRequestId = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if waitSwitch != InWindow {
+		return mysqlWaitUpgradeTask(ctx, &mysqlService, instanceId, asyncRequestId, false)
+	}
+
+	if err := mysqlWaitUpgradeTask(ctx, &mysqlService, instanceId, asyncRequestId, true); err != nil {
+		return err
+	}
+	if upgradeWindow == "" {
+		log.Printf("[DEBUG]%s mysql %s upgraded, it will switch to the new version in the maintenance time window", logId, instanceId)
+		return nil
+	}
+
+	wait, err := mysqlUpgradeWindowWait(upgradeWindow, time.Now())
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("upgrade window %s of mysql %s opens in %s, after the update timeout, raise `timeouts.update` to switch in it", upgradeWindow, instanceId, wait)
+		}
+		log.Printf("[DEBUG]%s mysql %s upgraded, waiting %s for upgrade window %s to switch", logId, instanceId, wait, upgradeWindow)
+		time.Sleep(wait)
+	}
+
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		if e := mysqlService.SwitchForUpgrade(ctx, instanceId); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return mysqlWaitUpgradeTask(ctx, &mysqlService, instanceId, asyncRequestId, false)
+}
+
+// mysqlRunUpgradeCheck submits an upgrade check job and waits for its result.
+func mysqlRunUpgradeCheck(ctx context.Context, mysqlService *MysqlService, instanceId, engineVersion string) error {
+	logId := tccommon.GetLogId(ctx)
+
+	var jobId int64
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := mysqlService.SubmitInstanceUpgradeCheckJob(ctx, instanceId, engineVersion)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		jobId = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		task, e := mysqlService.DescribeMysqlTaskByJobId(ctx, instanceId, jobId)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		if task == nil {
+			return resource.RetryableError(fmt.Errorf("upgrade check job %d of mysql %s is not listed yet", jobId, instanceId))
+		}
+
+		status := helper.PString(task.TaskStatus)
+		if status == MYSQL_JOB_STATUS_SUCCEED {
+			log.Printf("[DEBUG]%s upgrade check of mysql %s to %s passed", logId, instanceId, engineVersion)
+			return nil
+		}
+		if isMysqlJobFailed(task) {
+			return resource.NonRetryableError(fmt.Errorf("upgrade check of mysql %s to %s failed: %s", instanceId, engineVersion, helper.PString(task.Message)))
+		}
+		return resource.RetryableError(fmt.Errorf("upgrade check job %d of mysql %s is %s, progress %d%%", jobId, instanceId, status, helper.PInt64(task.Progress)))
+	})
+}
+
+// mysqlEngineUpgradeParameters returns the custom parameters of the param template and of the instance,
+// which are supported by the new version and differ from its defaults.
+func mysqlEngineUpgradeParameters(ctx context.Context, mysqlService *MysqlService, d *schema.ResourceData, engineVersion string) (params map[string]string, errRet error) {
+	var (
+		logId     = tccommon.GetLogId(ctx)
+		custom    = make(map[string]string)
+		defaults  []*cdb.ParameterDetail
+		templates []*cdb.ParameterDetail
+		instances []*cdb.ParameterDetail
+	)
+
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var e error
+		if v, ok := d.GetOk("param_template_id"); ok && templates == nil {
+			template, e := mysqlService.DescribeMysqlParamTemplateById(ctx, strconv.Itoa(v.(int)))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			templates = template.Items
+		}
+		if instances, e = mysqlService.DescribeInstanceParameters(ctx, d.Id()); e != nil {
+			return tccommon.RetryError(e)
+		}
+		if defaults, e = mysqlService.DescribeDefaultParameters(ctx, engineVersion); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	// parameters of the instance override those of the template.
+	for _, items := range [][]*cdb.ParameterDetail{templates, instances} {
+		for _, item := range items {
+			if item.Name == nil || item.CurrentValue == nil || helper.PString(item.CurrentValue) == helper.PString(item.Default) {
+				continue
+			}
+			custom[*item.Name] = *item.CurrentValue
+		}
+	}
+
+	supported := make(map[string]*cdb.ParameterDetail, len(defaults))
+	for _, item := range defaults {
+		if item.Name != nil {
+			supported[*item.Name] = item
+		}
+	}
+
+	params = make(map[string]string)
+	dropped := make([]string, 0)
+	for name, value := range custom {
+		item, ok := supported[name]
+		if !ok || (item.IsNotSupportEdit != nil && *item.IsNotSupportEdit) {
+			dropped = append(dropped, name)
+			continue
+		}
+		if helper.PString(item.Default) != value {
+			params[name] = value
+		}
+	}
+
+	if len(dropped) > 0 {
+		sort.Strings(dropped)
+		log.Printf("[WARN]%s custom parameters %s of mysql %s are not supported by %s and will not be carried over",
+			logId, strings.Join(dropped, ","), d.Id(), engineVersion)
+	}
+	log.Printf("[DEBUG]%s carry over parameters %v of mysql %s to %s", logId, params, d.Id(), engineVersion)
+	return
+}
+
+// mysqlUpgradeStateWaitSwitch is the state of an upgrade task whose instance is ready for switching.
+const mysqlUpgradeStateWaitSwitch = "WAIT_SWITCH"
+
+// mysqlWaitUpgradeTask waits for the upgrade task to finish, or, when waitForSwitch is true,
+// for the upgraded instance to be ready for switching, until ctx is done. The progress of the task
+// is logged.
+func mysqlWaitUpgradeTask(ctx context.Context, mysqlService *MysqlService, instanceId, asyncRequestId string, waitForSwitch bool) error {
+	logId := tccommon.GetLogId(ctx)
+
+	_, err := (&waiter.Waiter{
+		Name:    "engine version upgrade of mysql " + instanceId,
+		Pending: []string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING},
+		Target:  []string{MYSQL_TASK_STATUS_SUCCESS, mysqlUpgradeStateWaitSwitch},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			if waitForSwitch {
+				mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, instanceId)
+				if err != nil {
+					return nil, err
+				}
+				if mysqlInfo != nil && helper.PInt64(mysqlInfo.TaskStatus) == MYSQL_INSTANCE_TASK_STATUS_WAIT_SWITCH {
+					return &waiter.Status{State: mysqlUpgradeStateWaitSwitch}, nil
+				}
+			}
+
+			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+			if err != nil {
+				return nil, err
+			}
+			status := &waiter.Status{State: taskStatus, Message: message}
+			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
+				if task, e := mysqlService.DescribeMysqlTaskByAsyncRequestId(ctx, instanceId, asyncRequestId); e == nil && task != nil {
+					status.Percent = int(helper.PInt64(task.Progress))
+				}
+			}
+			return status, nil
+		},
+	}).Wait(ctx)
+	if err != nil {
+		log.Printf("[CRITAL]%s update mysql engineVersion fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+	return nil
+}
+
+// mysqlUpgradeWindowWait returns how long to wait from now until the upgrade window `HH:mm-HH:mm` in Beijing time opens.
+func mysqlUpgradeWindowWait(window string, now time.Time) (time.Duration, error) {
+	return engineupgrade.WindowWait(window, time.FixedZone("UTC+8", MYSQL_UPGRADE_WINDOW_UTC_OFFSET), now)
+}
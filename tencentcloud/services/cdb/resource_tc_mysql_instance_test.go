@@ -120,6 +120,26 @@ func testSweepMySQLInstance(region string) error {
 }
 
 // go test -i; go test -test.run TestAccTencentCloudMysqlInstanceResource_prepaid -v
+func TestUnitMysqlInstanceStateUpgradeV0(t *testing.T) {
+	t.Parallel()
+	r := localcdb.ResourceTencentCloudMysqlInstance()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("expected one upgrader to schema version 1, got version %d and %d upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	upgrader := r.StateUpgraders[0]
+	if upgrader.Type.HasAttribute("upgrade_check") || !upgrader.Type.HasAttribute("engine_version") {
+		t.Fatalf("expected the schema of version 0 without upgrade_check, got %s", upgrader.Type.FriendlyName())
+	}
+
+	state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"instance_name": "foo", "engine_version": "5.7"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["upgrade_check"] != true || state["upgrade_migrate_parameters"] != true || state["switch_at_maintenance"] != false || state["instance_name"] != "foo" {
+		t.Fatalf("unexpected upgraded state %v", state)
+	}
+}
+
 func TestAccTencentCloudMysqlInstanceResource_prepaid(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { tcacctest.AccPreCheckCommon(t, tcacctest.ACCOUNT_TYPE_PREPAY) },
@@ -141,7 +161,7 @@ func TestAccTencentCloudMysqlInstanceResource_prepaid(t *testing.T) {
 				ResourceName:            "tencentcloud_mysql_instance.prepaid",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "force_delete", "param_template_id", "fast_upgrade", "parameters"},
+				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "force_delete", "param_template_id", "fast_upgrade", "parameters", "upgrade_check", "upgrade_migrate_parameters", "switch_at_maintenance"},
 			},
 		},
 	})
@@ -166,7 +186,7 @@ func TestAccTencentCloudMysqlInstanceResource_DeviceType(t *testing.T) {
 				ResourceName:            "tencentcloud_mysql_instance.mysql_exclusive",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "force_delete", "param_template_id", "fast_upgrade", "parameters"},
+				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "force_delete", "param_template_id", "fast_upgrade", "parameters", "upgrade_check", "upgrade_migrate_parameters", "switch_at_maintenance"},
 			},
 			{
 				Config: testAccMySQLDeviceTypeUpdate,
@@ -246,7 +266,7 @@ func TestAccTencentCloudMysqlInstanceResource_MasterInstance_basic_and_update(t
 				ResourceName:            "tencentcloud_mysql_instance.mysql_master",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "first_slave_zone", "force_delete", "parameters"},
+				ImportStateVerifyIgnore: []string{"root_password", "prepaid_period", "first_slave_zone", "force_delete", "parameters", "upgrade_check", "upgrade_migrate_parameters", "switch_at_maintenance"},
 			},
 			// add tag
 			{
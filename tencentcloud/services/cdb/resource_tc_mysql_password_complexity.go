@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -12,6 +11,7 @@ import (
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
@@ -20,6 +20,9 @@ func ResourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
 		Read:   resourceTencentCloudMysqlPasswordComplexityRead,
 		Update: resourceTencentCloudMysqlPasswordComplexityUpdate,
 		Delete: resourceTencentCloudMysqlPasswordComplexityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -98,6 +101,8 @@ func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, m
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := mysql.NewModifyInstancePasswordComplexityRequest()
 	response := mysql.NewModifyInstancePasswordComplexityResponse()
@@ -137,20 +142,7 @@ func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, m
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s update mysql passwordComplexity status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s update mysql passwordComplexity status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql passwordComplexity fail, reason:%s\n ", logId, err.Error())
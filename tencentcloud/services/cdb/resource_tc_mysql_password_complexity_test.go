@@ -23,6 +23,12 @@ func TestAccTencentCloudMysqlPasswordComplexityResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_mysql_password_complexity.password_complexity", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_mysql_password_complexity.password_complexity",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"param_list"},
+			},
 		},
 	})
 }
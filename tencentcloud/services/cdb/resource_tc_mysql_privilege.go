@@ -16,6 +16,7 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -31,6 +32,9 @@ func ResourceTencentCloudMysqlPrivilege() *schema.Resource {
 		Read:   resourceTencentCloudMysqlPrivilegeRead,
 		Update: resourceTencentCloudMysqlPrivilegeUpdate,
 		Delete: resourceTencentCloudMysqlPrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMysqlPrivilegeImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -256,24 +260,7 @@ func (me *ResourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 	asyncRequestId := *response.Response.AsyncRequestId
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return tccommon.RetryError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("modify account privilege  task  status is %s", taskStatus))
-		}
-
-		if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-			return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-		}
-		err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId)
 	return err
 }
 
@@ -292,6 +279,8 @@ func resourceTencentCloudMysqlPrivilegeCreate(d *schema.ResourceData, meta inter
 			AccountName: accountName,
 			AccountHost: accountHost}
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 	privilegeIdStr, err := json.Marshal(privilegeId)
 	if err != nil {
 		return errors.New("json encode to id fail," + err.Error())
@@ -304,6 +293,26 @@ func resourceTencentCloudMysqlPrivilegeCreate(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTencentCloudMysqlPrivilegeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := mysqlAccountIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	privilegeId := ResourceTencentCloudMysqlPrivilegeId{
+		MysqlId:     id.Get("mysql_id"),
+		AccountName: id.Get("account_name"),
+		AccountHost: id.Get("account_host"),
+	}
+	privilegeIdStr, err := json.Marshal(privilegeId)
+	if err != nil {
+		return nil, errors.New("json encode to id fail," + err.Error())
+	}
+	d.SetId(string(privilegeIdStr))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_privilege.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -461,6 +470,8 @@ func resourceTencentCloudMysqlPrivilegeUpdate(d *schema.ResourceData, meta inter
 		ctx         = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 		privilegeId = ResourceTencentCloudMysqlPrivilegeId{}
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
@@ -487,6 +498,8 @@ func resourceTencentCloudMysqlPrivilegeDelete(d *schema.ResourceData, meta inter
 		ctx         = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 		privilegeId = ResourceTencentCloudMysqlPrivilegeId{}
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
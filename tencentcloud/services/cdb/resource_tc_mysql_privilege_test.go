@@ -54,6 +54,12 @@ func TestAccTencentCloudMysqlPrivilegeResource(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(testAccTencentCloudMysqlPrivilegeName, "global.*", "SELECT"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_privilege.privilege",
+				ImportState:       true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_mysql_privilege.privilege", "mysql_id", "account_name", "account_host"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -13,6 +13,7 @@ import (
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlProxy() *schema.Resource {
@@ -147,6 +148,8 @@ func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	var (
 		request    = mysql.NewCreateCdbProxyRequest()
@@ -226,20 +229,7 @@ func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql proxy status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql proxy fail, reason:%s\n ", logId, err.Error())
@@ -378,6 +368,8 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	items := strings.Split(d.Id(), tccommon.FILED_SP)
 	if len(items) != 3 {
@@ -453,20 +445,7 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		}
 
 		asyncRequestId := *response.Response.AsyncRequestId
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s update mysql proxy status is %s", instanceId, taskStatus))
-			}
-			err = fmt.Errorf("%s update mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql proxy fail, reason:%s\n ", logId, err.Error())
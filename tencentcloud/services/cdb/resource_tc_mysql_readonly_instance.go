@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
@@ -14,6 +15,7 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
@@ -68,6 +70,9 @@ func ResourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 				"force_delete":   false,
 			}),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(6 * time.Hour),
+		},
 		Schema: readonlyInstanceInfo,
 	}
 }
@@ -418,6 +423,8 @@ func resourceTencentCloudMysqlReadonlyInstanceUpdate(d *schema.ResourceData, met
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	payType := getPayType(d).(int)
 
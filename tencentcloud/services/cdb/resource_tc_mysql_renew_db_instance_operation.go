@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudMysqlRenewDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMysqlRenewDbInstanceOperationCreate,
 		Read:   resourceTencentCloudMysqlRenewDbInstanceOperationRead,
 		Delete: resourceTencentCloudMysqlRenewDbInstanceOperationDelete,
@@ -54,7 +55,7 @@ func ResourceTencentCloudMysqlRenewDbInstanceOperation() *schema.Resource {
 				Description: "Instance expiration time.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -87,6 +88,7 @@ func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
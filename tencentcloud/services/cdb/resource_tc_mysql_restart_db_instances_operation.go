@@ -2,10 +2,11 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
 		Read:   resourceTencentCloudMysqlRestartDbInstancesOperationRead,
 		Delete: resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
@@ -32,7 +33,7 @@ func ResourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 				Description: "Instance status.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -41,6 +42,8 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	var (
 		request    = mysql.NewRestartDBInstancesRequest()
@@ -58,6 +61,7 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -71,20 +75,7 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s operate mysql restartDbInstancesOperation status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s operate mysql restartDbInstancesOperation is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql restartDbInstancesOperation fail, reason:%s\n ", logId, err.Error())
@@ -13,6 +13,7 @@ import (
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudMysqlRoGroup() *schema.Resource {
@@ -21,6 +22,9 @@ func ResourceTencentCloudMysqlRoGroup() *schema.Resource {
 		Read:   resourceTencentCloudMysqlRoGroupRead,
 		Update: resourceTencentCloudMysqlRoGroupUpdate,
 		Delete: resourceTencentCloudMysqlRoGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -212,6 +216,8 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := mysql.NewModifyRoGroupInfoRequest()
 	response := mysql.NewModifyRoGroupInfoResponse()
@@ -220,7 +226,6 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 	if len(idSplit) != 2 {
 		return fmt.Errorf("id is broken,%s", d.Id())
 	}
-	instanceId := idSplit[0]
 	roGroupId := idSplit[1]
 
 	request.RoGroupId = &roGroupId
@@ -289,20 +294,7 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
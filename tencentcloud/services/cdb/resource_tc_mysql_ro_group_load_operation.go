@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMysqlRoGroupLoadOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMysqlRoGroupLoadOperationCreate,
 		Read:   resourceTencentCloudMysqlRoGroupLoadOperationRead,
 		Delete: resourceTencentCloudMysqlRoGroupLoadOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudMysqlRoGroupLoadOperation() *schema.Resource {
 				Description: "The ID of the RO group, in the format: cdbrg-c1nl9rpv.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMysqlRoGroupLoadOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -50,6 +51,7 @@ func resourceTencentCloudMysqlRoGroupLoadOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
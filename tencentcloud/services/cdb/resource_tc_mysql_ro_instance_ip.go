@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -18,6 +19,9 @@ func ResourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 		Create: resourceTencentCloudMysqlRoInstanceIpCreate,
 		Read:   resourceTencentCloudMysqlRoInstanceIpRead,
 		Delete: resourceTencentCloudMysqlRoInstanceIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -27,6 +27,11 @@ func TestAccTencentCloudMysqlRoInstanceIpResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_mysql_ro_instance_ip.ro_instance_ip", "ro_vport"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_ro_instance_ip.ro_instance_ip",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
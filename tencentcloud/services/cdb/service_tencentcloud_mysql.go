@@ -16,6 +16,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -510,7 +511,7 @@ func (me *MysqlService) ModifyAccountMaxUserConnections(ctx context.Context, mys
 	return
 }
 
-func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysqlId, engineVersion string, upgradeSubversion, maxDelayTime, waitSwitch int64) (asyncRequestId string, errRet error) {
+func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysqlId, engineVersion string, upgradeSubversion, maxDelayTime, waitSwitch int64, params map[string]string) (asyncRequestId string, errRet error) {
 
 	logId := tccommon.GetLogId(ctx)
 
@@ -522,6 +523,12 @@ func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysq
 	request.WaitSwitch = &waitSwitch
 	request.UpgradeSubversion = &upgradeSubversion
 	request.MaxDelayTime = &maxDelayTime
+	for name, value := range params {
+		request.ParamList = append(request.ParamList, &cdb.UpgradeEngineVersionParams{
+			Name:  helper.String(name),
+			Value: helper.String(value),
+		})
+	}
 
 	defer func() {
 		if errRet != nil {
@@ -725,6 +732,24 @@ func (me *MysqlService) DescribeAsyncRequestInfo(ctx context.Context, asyncReque
 	return
 }
 
+// WaitForAsyncRequest waits for the async request of asyncRequestId to succeed until ctx is done,
+// such as by the timeout of the resource given by waiter.WithResourceTimeout.
+func (me *MysqlService) WaitForAsyncRequest(ctx context.Context, asyncRequestId string) error {
+	_, err := (&waiter.Waiter{
+		Name:    "mysql async request " + asyncRequestId,
+		Pending: []string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING},
+		Target:  []string{MYSQL_TASK_STATUS_SUCCESS},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			status, message, err := me.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+			if err != nil {
+				return nil, err
+			}
+			return &waiter.Status{State: status, Message: message}, nil
+		},
+	}).Wait(ctx)
+	return err
+}
+
 func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId string,
 	accountName, accountHost string, databaseNames []string, privileges []string) (asyncRequestId string, errRet error) {
 
@@ -3569,3 +3594,126 @@ func (me *MysqlService) DescribeMysqlAuditInstanceListById(ctx context.Context,
 	ret = response.Response.Items[0]
 	return
 }
+
+func (me *MysqlService) SubmitInstanceUpgradeCheckJob(ctx context.Context, instanceId, dstVersion string) (jobId int64, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := cdb.NewSubmitInstanceUpgradeCheckJobRequest()
+	request.InstanceId = &instanceId
+	request.DstMysqlVersion = &dstVersion
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMysqlClient().SubmitInstanceUpgradeCheckJob(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.JobId == nil {
+		errRet = fmt.Errorf("SubmitInstanceUpgradeCheckJob response is nil")
+		return
+	}
+	jobId = *response.Response.JobId
+	return
+}
+
+// DescribeInstanceUpgradeCheckJob returns the upgrade check job submitted in the last 24 hours, exist is false if there is none.
+func (me *MysqlService) DescribeInstanceUpgradeCheckJob(ctx context.Context, instanceId, dstVersion string) (jobId int64, exist bool, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := cdb.NewDescribeInstanceUpgradeCheckJobRequest()
+	request.InstanceId = &instanceId
+	request.DstMysqlVersion = &dstVersion
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMysqlClient().DescribeInstanceUpgradeCheckJob(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		return
+	}
+	if response.Response.ExistUpgradeCheckJob != nil && *response.Response.ExistUpgradeCheckJob && response.Response.JobId != nil {
+		jobId = *response.Response.JobId
+		exist = true
+	}
+	return
+}
+
+// DescribeMysqlTaskByJobId returns the task of the instance with jobId, nil if it is not listed yet.
+func (me *MysqlService) DescribeMysqlTaskByJobId(ctx context.Context, instanceId string, jobId int64) (task *cdb.TaskDetail, errRet error) {
+	tasks, err := me.DescribeMysqlUserTaskByFilter(ctx, map[string]interface{}{
+		"InstanceId": &instanceId,
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, item := range tasks {
+		if item.JobId != nil && *item.JobId == jobId {
+			task = item
+			return
+		}
+	}
+	return
+}
+
+// DescribeMysqlTaskByAsyncRequestId returns the task of the instance started by asyncRequestId, nil if it is not listed yet.
+func (me *MysqlService) DescribeMysqlTaskByAsyncRequestId(ctx context.Context, instanceId, asyncRequestId string) (task *cdb.TaskDetail, errRet error) {
+	tasks, err := me.DescribeMysqlUserTaskByFilter(ctx, map[string]interface{}{
+		"InstanceId":     &instanceId,
+		"AsyncRequestId": &asyncRequestId,
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	if len(tasks) > 0 {
+		task = tasks[0]
+	}
+	return
+}
+
+func (me *MysqlService) SwitchForUpgrade(ctx context.Context, instanceId string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := cdb.NewSwitchForUpgradeRequest()
+	request.InstanceId = &instanceId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMysqlClient().SwitchForUpgrade(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
@@ -20,6 +20,9 @@ func ResourceTencentCloudCdwdorisInstance() *schema.Resource {
 		Read:   resourceTencentCloudCdwdorisInstanceRead,
 		Update: resourceTencentCloudCdwdorisInstanceUpdate,
 		Delete: resourceTencentCloudCdwdorisInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:        schema.TypeString,
@@ -33,6 +33,12 @@ func TestAccTencentCloudNeedFixCdwdorisInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cdwdoris_instance.example", "enable_multi_zones"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cdwdoris_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"doris_user_pwd", "charge_properties"},
+			},
 		},
 	})
 }
@@ -20,6 +20,9 @@ func ResourceTencentCloudCdwpgDbconfig() *schema.Resource {
 		Read:   resourceTencentCloudCdwpgDbconfigRead,
 		Update: resourceTencentCloudCdwpgDbconfigUpdate,
 		Delete: resourceTencentCloudCdwpgDbconfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
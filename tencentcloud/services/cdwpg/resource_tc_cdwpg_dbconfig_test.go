@@ -20,6 +20,11 @@ func TestAccTencentCloudCdwpgDbconfigResource_basic(t *testing.T) {
 				Config: testAccCdwpgDbconfig,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_cdwpg_dbconfig.cdwpg_dbconfig", "id")),
 			},
+			{
+				ResourceName:      "tencentcloud_cdwpg_dbconfig.cdwpg_dbconfig",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -13,16 +13,23 @@ import (
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
+// cfsAccessRuleImportIdFormat is the import id of an access rule, the id of the resource is the rule id.
+var cfsAccessRuleImportIdFormat = compositeid.New(compositeid.String("access_group_id"), compositeid.String("id"))
+
 func ResourceTencentCloudCfsAccessRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCfsAccessRuleCreate,
 		Read:   resourceTencentCloudCfsAccessRuleRead,
 		Update: resourceTencentCloudCfsAccessRuleUpdate,
 		Delete: resourceTencentCloudCfsAccessRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: cfsAccessRuleImportIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -29,6 +29,12 @@ func TestAccTencentCloudCfsAccessRuleResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cfs_access_rule.foo", "access_group_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cfs_access_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_cfs_access_rule.foo", "access_group_id", "id"),
+			},
 		},
 	})
 }
@@ -20,6 +20,9 @@ func ResourceTencentCloudCfwEdgeFirewallSwitch() *schema.Resource {
 		Read:   resourceTencentCloudCfwEdgeFirewallSwitchRead,
 		Update: resourceTencentCloudCfwEdgeFirewallSwitchUpdate,
 		Delete: resourceTencentCloudCfwEdgeFirewallSwitchDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"public_ip": {
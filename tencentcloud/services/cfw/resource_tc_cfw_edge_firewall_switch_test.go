@@ -35,6 +35,11 @@ func TestAccTencentCloudNeedFixCfwEdgeFirewallSwitchResource_basic(t *testing.T)
 					resource.TestCheckResourceAttrSet("tencentcloud_cfw_edge_firewall_switch.example", "enable"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cfw_edge_firewall_switch.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -13,18 +12,22 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tencentyun/cos-go-sdk-v5"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// ciMediaAnimationTemplateIdFormat is the id of a template, which is also its import id.
+var ciMediaAnimationTemplateIdFormat = compositeid.New(compositeid.String("bucket"), compositeid.String("template_id"))
+
 func ResourceTencentCloudCiMediaAnimationTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCiMediaAnimationTemplateCreate,
 		Read:   resourceTencentCloudCiMediaAnimationTemplateRead,
 		Update: resourceTencentCloudCiMediaAnimationTemplateUpdate,
 		Delete: resourceTencentCloudCiMediaAnimationTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: ciMediaAnimationTemplateIdFormat.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -219,7 +222,7 @@ func resourceTencentCloudCiMediaAnimationTemplateCreate(d *schema.ResourceData,
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + tccommon.FILED_SP + templateId)
+	d.SetId(ciMediaAnimationTemplateIdFormat.Join(bucket, templateId))
 
 	return resourceTencentCloudCiMediaAnimationTemplateRead(d, meta)
 }
@@ -234,12 +237,12 @@ func resourceTencentCloudCiMediaAnimationTemplateRead(d *schema.ResourceData, me
 
 	service := CiService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := ciMediaAnimationTemplateIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	bucket := idSplit[0]
-	templateId := idSplit[1]
+	bucket := id.Get("bucket")
+	templateId := id.Get("template_id")
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
@@ -398,14 +401,14 @@ func resourceTencentCloudCiMediaAnimationTemplateUpdate(d *schema.ResourceData,
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := ciMediaAnimationTemplateIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	bucket := idSplit[0]
-	templateId := idSplit[1]
+	bucket := id.Get("bucket")
+	templateId := id.Get("template_id")
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, _, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCiClient(bucket).CI.UpdateMediaAnimationTemplate(ctx, &request, templateId)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -430,12 +433,12 @@ func resourceTencentCloudCiMediaAnimationTemplateDelete(d *schema.ResourceData,
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	service := CiService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := ciMediaAnimationTemplateIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	bucket := idSplit[0]
-	templateId := idSplit[1]
+	bucket := id.Get("bucket")
+	templateId := id.Get("template_id")
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
 		return err
@@ -43,11 +43,11 @@ func TestAccTencentCloudCiMediaAnimationTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_ci_media_animation_template.media_animation_template", "time_interval.0.duration", "60"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_ci_media_animation_template.media_animation_template",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_ci_media_animation_template.media_animation_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
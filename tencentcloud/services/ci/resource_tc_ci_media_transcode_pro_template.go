@@ -22,9 +22,9 @@ func ResourceTencentCloudCiMediaTranscodeProTemplate() *schema.Resource {
 		Read:   resourceTencentCloudCiMediaTranscodeProTemplateRead,
 		Update: resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
 		Delete: resourceTencentCloudCiMediaTranscodeProTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -392,6 +392,7 @@ func resourceTencentCloudCiMediaTranscodeProTemplateRead(d *schema.ResourceData,
 		return fmt.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
+	_ = d.Set("bucket", bucket)
 	if template.Name != "" {
 		_ = d.Set("name", template.Name)
 	}
@@ -55,11 +55,11 @@ func TestAccTencentCloudNeedFixCiMediaTranscodeProTemplateResource_basic(t *test
 					resource.TestCheckResourceAttr("tencentcloud_ci_media_transcode_pro_template.media_transcode_pro_template", "trans_config.0.is_hdr2_sdr", "false"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_ci_media_transcode_pro_template.media_transcode_pro_template",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_ci_media_transcode_pro_template.media_transcode_pro_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
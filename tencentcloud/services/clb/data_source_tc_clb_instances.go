@@ -14,7 +14,7 @@ import (
 )
 
 func DataSourceTencentCloudClbInstances() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithResultFilter("clb_list", &schema.Resource{
 		Read: dataSourceTencentCloudClbInstancesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -186,7 +186,7 @@ func DataSourceTencentCloudClbInstances() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interface{}) error {
@@ -150,3 +150,14 @@ const (
 	CLB_SESSION_TYPE_NORMAL = "NORMAL"
 	CLB_SESSION_TYPE_QUIC   = "QUIC_CID"
 )
+
+const (
+	CLB_TRAFFIC_SHIFT_STATUS_COMPLETED   = "COMPLETED"
+	CLB_TRAFFIC_SHIFT_STATUS_ROLLED_BACK = "ROLLED_BACK"
+
+	CLB_TRAFFIC_SHIFT_SIDE_BLUE  = "blue"
+	CLB_TRAFFIC_SHIFT_SIDE_GREEN = "green"
+
+	CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_TIMEOUT  = 300
+	CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_INTERVAL = 10
+)
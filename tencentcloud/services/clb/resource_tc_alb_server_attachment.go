@@ -22,6 +22,9 @@ func ResourceTencentCloudAlbServerAttachment() *schema.Resource {
 		Read:               resourceTencentCloudAlbServerAttachmentRead,
 		Delete:             resourceTencentCloudAlbServerAttachmentDelete,
 		Update:             resourceTencentCloudAlbServerAttachmentUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"loadbalancer_id": {
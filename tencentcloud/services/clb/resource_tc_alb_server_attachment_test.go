@@ -0,0 +1,77 @@
+package clb_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudAlbServerAttachmentResource_http(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccAlbServerAttachment_http, tcacctest.DefaultSshCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_alb_server_attachment.alb_attachment_http", "loadbalancer_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_alb_server_attachment.alb_attachment_http", "listener_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_alb_server_attachment.alb_attachment_http", "location_id"),
+					resource.TestCheckResourceAttr("tencentcloud_alb_server_attachment.alb_attachment_http", "protocol_type", "HTTPS"),
+					resource.TestCheckResourceAttr("tencentcloud_alb_server_attachment.alb_attachment_http", "backends.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_alb_server_attachment.alb_attachment_http",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAlbServerAttachment_http = tcacctest.InstanceCommonTestCase + tcacctest.PresetCVM + `
+resource "tencentcloud_clb_instance" "clb_instance_http" {
+  network_type = "OPEN"
+  clb_name     = "tf-alb-attach-http-test"
+  vpc_id       = var.cvm_vpc_id
+}
+
+resource "tencentcloud_clb_listener" "clb_listener_http" {
+  clb_id               = tencentcloud_clb_instance.clb_instance_http.id
+  listener_name        = "tf-alb-attach-http-test"
+  port                 = 78
+  protocol             = "HTTPS"
+  certificate_ssl_mode = "UNIDIRECTIONAL"
+  certificate_id       = "%s"
+}
+
+resource "tencentcloud_clb_listener_rule" "clb_listener_rule_http" {
+  clb_id              = tencentcloud_clb_instance.clb_instance_http.id
+  listener_id         = tencentcloud_clb_listener.clb_listener_http.listener_id
+  domain              = "abc.com"
+  url                 = "/"
+  session_expire_time = 30
+  scheduler           = "WRR"
+}
+
+resource "tencentcloud_alb_server_attachment" "alb_attachment_http" {
+  loadbalancer_id = tencentcloud_clb_instance.clb_instance_http.id
+  listener_id     = tencentcloud_clb_listener.clb_listener_http.listener_id
+  location_id     = tencentcloud_clb_listener_rule.clb_listener_rule_http.rule_id
+
+  backends {
+    instance_id = tencentcloud_instance.default.id
+    port        = 23
+    weight      = 10
+  }
+  backends {
+    instance_id = tencentcloud_instance.default.id
+    port        = 22
+    weight      = 10
+  }
+}
+`
@@ -223,6 +223,10 @@ func resourceTencentCloudClbServerAttachmentRead(d *schema.ResourceData, meta in
 	)
 
 	items := strings.Split(d.Id(), "#")
+	if len(items) < 3 {
+		return fmt.Errorf("[CHECK][CLB attachment][Read] check: id %s of resource.tencentcloud_clb_attachment is not match loc-xxx#lbl-xxx#lb-xxx", d.Id())
+	}
+
 	locationIdOrDomainUrl := items[0]
 	listenerId := items[1]
 	clbId := items[2]
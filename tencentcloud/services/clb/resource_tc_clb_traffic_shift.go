@@ -0,0 +1,722 @@
+package clb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// clbTrafficShiftImportIdFormat is the import id of a shift, the rule id is left out for a layer-4 listener.
+var clbTrafficShiftImportIdFormat = compositeid.New(compositeid.String("clb_id"), compositeid.String("listener_id"), compositeid.Optional(compositeid.String("rule_id")))
+
+func ResourceTencentCloudClbTrafficShift() *schema.Resource {
+	backendsSchema := func(side string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("Backends of the %s side. Exactly one of `target_group_id` and `targets` must be specified.", side),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"target_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of a target group bound to the listener or rule, the weights of all its instances are shifted.",
+					},
+					"targets": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Backends bound to the listener or rule, e.g. with `tencentcloud_clb_attachment`.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"instance_id": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "CVM Instance Id of the backend server, conflict with `eni_ip` but must specify one of them.",
+								},
+								"eni_ip": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Eni IP address of the backend server, conflict with `instance_id` but must specify one of them.",
+								},
+								"port": {
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: tccommon.ValidateIntegerInRange(0, 65535),
+									Description:  "Port of the backend server.",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceTencentCloudClbTrafficShiftCreate,
+		Read:   resourceTencentCloudClbTrafficShiftRead,
+		Update: resourceTencentCloudClbTrafficShiftUpdate,
+		Delete: resourceTencentCloudClbTrafficShiftDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudClbTrafficShiftImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"clb_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CLB.",
+			},
+			"listener_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CLB listener.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the CLB listener rule. Only supports listeners of `HTTPS` and `HTTP` protocol.",
+			},
+			"blue":  backendsSchema("blue (current)"),
+			"green": backendsSchema("green (new)"),
+			"max_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: tccommon.ValidateIntegerInRange(1, 100),
+				Description:  "Max weight of a backend. In each step, the backends of the side receiving the larger share of the traffic per backend are set to it, and those of the other side to the weight giving the shares of the step. Valid value ranges: (1~100). Default is `100`.",
+			},
+			"steps": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Ordered steps of the shift. The last step is the final state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"green_percent": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: tccommon.ValidateIntegerInRange(0, 100),
+							Description:  "Percentage of the traffic forwarded to the green side in this step, converted to the weights of the backends according to the number of backends of both sides. Valid value ranges: (0~100).",
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: tccommon.ValidateIntegerInRange(0, 86400),
+							Description:  "Seconds to hold this step before the next one, during which the health of the green side is watched. Default is `0`.",
+						},
+					},
+				},
+			},
+			"health_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Health check gating of the shift. The green side must be healthy before each step sending traffic to it, and stay healthy during `interval` of the step, otherwise the shift is aborted and the weights are rolled back. Enabled by default.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to gate the steps by the health check results of the listener. Default is `true`.",
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_TIMEOUT,
+							ValidateFunc: tccommon.ValidateIntegerInRange(1, 3600),
+							Description:  "Seconds to wait for the green side to become healthy before a step. Default is `300`.",
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_INTERVAL,
+							ValidateFunc: tccommon.ValidateIntegerInRange(1, 600),
+							Description:  "Seconds between two health queries. Default is `10`.",
+						},
+					},
+				},
+			},
+			// Computed values.
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Result of the last shift. Valid values: `COMPLETED`, `ROLLED_BACK`.",
+			},
+			"green_percent": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Current percentage of the traffic forwarded to the green side, calculated from the total weights of the backends of both sides.",
+			},
+			"last_shifted_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time when the last shift finished.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudClbTrafficShiftCreate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_clb_traffic_shift.create")()
+
+	var (
+		clbId      = d.Get("clb_id").(string)
+		listenerId = d.Get("listener_id").(string)
+		locationId = d.Get("rule_id").(string)
+	)
+
+	if err := ListenerIdCheck(listenerId); err != nil {
+		return err
+	}
+	if locationId != "" {
+		if err := RuleIdCheck(locationId); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strings.Join([]string{clbId, listenerId, locationId}, tccommon.FILED_SP))
+
+	// a failed or rolled back shift taints the resource, so that it runs again in the next apply.
+	if _, err := resourceTencentCloudClbTrafficShiftRun(d, meta); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudClbTrafficShiftRead(d, meta)
+}
+
+func resourceTencentCloudClbTrafficShiftImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := clbTrafficShiftImportIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	clbId, listenerId, locationId := id.Get("clb_id"), id.Get("listener_id"), id.Get("rule_id")
+	_ = d.Set("clb_id", clbId)
+	_ = d.Set("listener_id", listenerId)
+	_ = d.Set("rule_id", locationId)
+	d.SetId(strings.Join([]string{clbId, listenerId, locationId}, tccommon.FILED_SP))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTencentCloudClbTrafficShiftRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_clb_traffic_shift.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId = tccommon.GetLogId(tccommon.ContextNil)
+		ctx   = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		shift = newClbTrafficShift(d, meta)
+	)
+
+	backends, found, err := shift.backends(ctx, false)
+	if err != nil {
+		return err
+	}
+	if !found {
+		log.Printf("[WARN]%s listener %s of CLB %s is not found, remove traffic shift from state", logId, shift.listenerId, shift.clbId)
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("green_percent", clbTrafficShiftGreenPercent(backends))
+
+	return nil
+}
+
+func resourceTencentCloudClbTrafficShiftUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_clb_traffic_shift.update")()
+
+	// keep the shift in the state as before unless it completes, so that it runs again in the next apply.
+	d.Partial(true)
+	if d.HasChanges("blue", "green", "max_weight", "steps") {
+		rolledBack, err := resourceTencentCloudClbTrafficShiftRun(d, meta)
+		if err != nil {
+			if rolledBack {
+				// the weights are as before the shift, record the rollback with the arguments of the shift before.
+				for _, key := range []string{"blue", "green", "max_weight", "steps", "health_check"} {
+					old, _ := d.GetChange(key)
+					_ = d.Set(key, old)
+				}
+				d.Partial(false)
+			}
+			return err
+		}
+	}
+	d.Partial(false)
+
+	return resourceTencentCloudClbTrafficShiftRead(d, meta)
+}
+
+func resourceTencentCloudClbTrafficShiftDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_clb_traffic_shift.delete")()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	log.Printf("[DEBUG]%s traffic shift %s is removed from state, the weights of the backends are kept", logId, d.Id())
+
+	return nil
+}
+
+// resourceTencentCloudClbTrafficShiftRun runs the shift, rolledBack reports whether the weights are rolled back after a failure.
+func resourceTencentCloudClbTrafficShiftRun(d *schema.ResourceData, meta interface{}) (rolledBack bool, err error) {
+	var (
+		logId = tccommon.GetLogId(tccommon.ContextNil)
+		ctx   = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		shift = newClbTrafficShift(d, meta)
+		steps = make([]clbTrafficShiftStep, 0)
+	)
+
+	for _, item := range d.Get("steps").([]interface{}) {
+		step := item.(map[string]interface{})
+		steps = append(steps, clbTrafficShiftStep{
+			greenPercent: step["green_percent"].(int),
+			interval:     time.Duration(step["interval"].(int)) * time.Second,
+		})
+	}
+
+	rolledBack, err = shift.run(ctx, steps)
+	_ = d.Set("last_shifted_at", time.Now().Format(time.RFC3339))
+	if rolledBack {
+		_ = d.Set("status", CLB_TRAFFIC_SHIFT_STATUS_ROLLED_BACK)
+	} else if err == nil {
+		_ = d.Set("status", CLB_TRAFFIC_SHIFT_STATUS_COMPLETED)
+	}
+	return
+}
+
+type clbTrafficShiftStep struct {
+	greenPercent int
+	interval     time.Duration
+}
+
+type clbTrafficShiftBackend struct {
+	side          string
+	targetGroupId string
+	instanceId    string
+	// ip is the ENI IP of a listener backend, or the bind IP of a target group instance.
+	ip     string
+	port   int64
+	weight int64
+}
+
+func (b *clbTrafficShiftBackend) String() string {
+	id := b.instanceId
+	if id == "" {
+		id = b.ip
+	}
+	if b.targetGroupId != "" {
+		return fmt.Sprintf("%s/%s:%d", b.targetGroupId, id, b.port)
+	}
+	return fmt.Sprintf("%s:%d", id, b.port)
+}
+
+type clbTrafficShift struct {
+	service        ClbService
+	clbId          string
+	listenerId     string
+	locationId     string
+	sides          map[string]map[string]interface{}
+	maxWeight      int64
+	healthCheck    bool
+	healthTimeout  time.Duration
+	healthInterval time.Duration
+}
+
+func newClbTrafficShift(d *schema.ResourceData, meta interface{}) *clbTrafficShift {
+	shift := &clbTrafficShift{
+		service:        ClbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()},
+		clbId:          d.Get("clb_id").(string),
+		listenerId:     d.Get("listener_id").(string),
+		locationId:     d.Get("rule_id").(string),
+		sides:          make(map[string]map[string]interface{}),
+		maxWeight:      int64(d.Get("max_weight").(int)),
+		healthCheck:    true,
+		healthTimeout:  CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_TIMEOUT * time.Second,
+		healthInterval: CLB_TRAFFIC_SHIFT_DEFAULT_HEALTH_INTERVAL * time.Second,
+	}
+
+	for _, side := range []string{CLB_TRAFFIC_SHIFT_SIDE_BLUE, CLB_TRAFFIC_SHIFT_SIDE_GREEN} {
+		shift.sides[side] = map[string]interface{}{}
+		if v := d.Get(side).([]interface{}); len(v) > 0 && v[0] != nil {
+			shift.sides[side] = v[0].(map[string]interface{})
+		}
+	}
+
+	if v := d.Get("health_check").([]interface{}); len(v) > 0 && v[0] != nil {
+		healthCheck := v[0].(map[string]interface{})
+		shift.healthCheck = healthCheck["enabled"].(bool)
+		shift.healthTimeout = time.Duration(healthCheck["timeout"].(int)) * time.Second
+		shift.healthInterval = time.Duration(healthCheck["interval"].(int)) * time.Second
+	}
+
+	return shift
+}
+
+// backends returns the backends of both sides with their current weights, found is false when the listener does not exist.
+// In strict mode, a configured backend which is not bound to the listener or rule is an error, otherwise it is skipped.
+func (s *clbTrafficShift) backends(ctx context.Context, strict bool) (backends []*clbTrafficShiftBackend, found bool, errRet error) {
+	var listenerBackends []*clb.Backend
+	found = true
+
+	for _, side := range []string{CLB_TRAFFIC_SHIFT_SIDE_BLUE, CLB_TRAFFIC_SHIFT_SIDE_GREEN} {
+		var (
+			config        = s.sides[side]
+			targetGroupId = ""
+			targets       = make([]interface{}, 0)
+			count         = len(backends)
+		)
+		if v, ok := config["target_group_id"].(string); ok {
+			targetGroupId = v
+		}
+		if v, ok := config["targets"].([]interface{}); ok {
+			targets = v
+		}
+		if strict && (targetGroupId == "") == (len(targets) == 0) {
+			errRet = fmt.Errorf("exactly one of `%s.0.target_group_id` and `%s.0.targets` must be specified", side, side)
+			return
+		}
+
+		if targetGroupId != "" {
+			var instances []*clb.TargetGroupBackend
+			err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+				result, e := s.service.DescribeTargetGroupInstances(ctx, map[string]string{"TargetGroupId": targetGroupId})
+				if e != nil {
+					return tccommon.RetryError(e)
+				}
+				instances = result
+				return nil
+			})
+			if err != nil {
+				errRet = err
+				return
+			}
+			for _, instance := range instances {
+				backend := &clbTrafficShiftBackend{
+					side:          side,
+					targetGroupId: targetGroupId,
+					instanceId:    helper.PString(instance.InstanceId),
+				}
+				if len(instance.PrivateIpAddresses) > 0 {
+					backend.ip = helper.PString(instance.PrivateIpAddresses[0])
+				}
+				if instance.Port != nil {
+					backend.port = int64(*instance.Port)
+				}
+				if instance.Weight != nil {
+					backend.weight = int64(*instance.Weight)
+				}
+				backends = append(backends, backend)
+			}
+		}
+
+		if len(targets) > 0 && listenerBackends == nil {
+			var listener *clb.ListenerBackend
+			err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+				result, e := s.service.DescribeTargetsByPara(ctx, s.clbId, s.listenerId, s.locationId)
+				if e != nil {
+					return tccommon.RetryError(e)
+				}
+				listener = result
+				return nil
+			})
+			if err != nil {
+				errRet = err
+				return
+			}
+			if listener == nil {
+				found = false
+				return
+			}
+			listenerBackends = listener.Targets
+			for _, rule := range listener.Rules {
+				if s.locationId == "" || (rule.LocationId != nil && *rule.LocationId == s.locationId) {
+					listenerBackends = append(listenerBackends, rule.Targets...)
+				}
+			}
+		}
+
+		for _, item := range targets {
+			target, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			backend := &clbTrafficShiftBackend{
+				side:       side,
+				instanceId: target["instance_id"].(string),
+				ip:         target["eni_ip"].(string),
+				port:       int64(target["port"].(int)),
+			}
+			bound := clbTrafficShiftFindBackend(listenerBackends, backend)
+			if bound == nil {
+				if strict {
+					errRet = fmt.Errorf("%s backend %s is not bound to listener %s", side, backend, s.listenerId)
+					return
+				}
+				continue
+			}
+			if bound.Weight != nil {
+				backend.weight = *bound.Weight
+			}
+			backends = append(backends, backend)
+		}
+
+		if strict && len(backends) == count {
+			errRet = fmt.Errorf("no backend is found on the %s side", side)
+			return
+		}
+	}
+
+	return
+}
+
+// run drives the weights through steps. On failure, the weights of all backends are rolled back to
+// those before the shift, and rolledBack reports whether the rollback succeeded.
+func (s *clbTrafficShift) run(ctx context.Context, steps []clbTrafficShiftStep) (rolledBack bool, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	backends, found, err := s.backends(ctx, true)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if !found {
+		errRet = fmt.Errorf("listener %s of CLB %s is not found", s.listenerId, s.clbId)
+		return
+	}
+
+	rollback := func(step int, cause error) (bool, error) {
+		log.Printf("[WARN]%s traffic shift of listener %s aborted at step %d: %v, rolling back", logId, s.listenerId, step, cause)
+		if e := s.apply(ctx, backends, func(backend *clbTrafficShiftBackend) int64 { return backend.weight }); e != nil {
+			return false, fmt.Errorf("step %d: %v, and roll back failed: %v", step, cause, e)
+		}
+		return true, fmt.Errorf("step %d: %v, weights are rolled back", step, cause)
+	}
+
+	for i, step := range steps {
+		watch := s.healthCheck && step.greenPercent > 0
+		if watch {
+			if e := s.waitHealthy(ctx, backends); e != nil {
+				return rollback(i, e)
+			}
+		}
+
+		blue, green := clbTrafficShiftWeights(s.maxWeight, step.greenPercent, backends)
+		e := s.apply(ctx, backends, func(backend *clbTrafficShiftBackend) int64 {
+			if backend.side == CLB_TRAFFIC_SHIFT_SIDE_GREEN {
+				return green
+			}
+			return blue
+		})
+		if e != nil {
+			return rollback(i, e)
+		}
+		log.Printf("[DEBUG]%s traffic shift of listener %s step %d: %d%% to green, weights blue %d green %d", logId, s.listenerId, i, step.greenPercent, blue, green)
+
+		if step.interval > 0 {
+			if e := s.hold(ctx, backends, step.interval, watch); e != nil {
+				return rollback(i, e)
+			}
+		}
+	}
+
+	return
+}
+
+// apply sets the weight of each backend to weight(backend).
+func (s *clbTrafficShift) apply(ctx context.Context, backends []*clbTrafficShiftBackend, weight func(backend *clbTrafficShiftBackend) int64) error {
+	clbActionMu.Lock()
+	defer clbActionMu.Unlock()
+
+	var (
+		targets   = make([]*clb.Target, 0)
+		instances = make(map[string][]*clb.TargetGroupInstance)
+		groupIds  = make([]string, 0)
+	)
+
+	for _, backend := range backends {
+		w := weight(backend)
+		if backend.targetGroupId != "" {
+			if _, ok := instances[backend.targetGroupId]; !ok {
+				groupIds = append(groupIds, backend.targetGroupId)
+			}
+			instances[backend.targetGroupId] = append(instances[backend.targetGroupId], &clb.TargetGroupInstance{
+				BindIP: helper.String(backend.ip),
+				Port:   helper.Uint64(uint64(backend.port)),
+				Weight: helper.Uint64(uint64(w)),
+			})
+			continue
+		}
+		targets = append(targets, clbNewTarget(backend.instanceId, backend.ip, int(backend.port), int(w)))
+	}
+
+	if len(targets) > 0 {
+		if err := s.service.BatchModifyTargetWeight(ctx, s.clbId, s.listenerId, s.locationId, targets); err != nil {
+			return err
+		}
+	}
+	for _, groupId := range groupIds {
+		if err := s.service.BatchModifyTargetGroupInstancesWeight(ctx, groupId, instances[groupId]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unhealthy returns the green backends which are unhealthy or not health checked yet.
+func (s *clbTrafficShift) unhealthy(ctx context.Context, backends []*clbTrafficShiftBackend) ([]string, error) {
+	var health []*clb.TargetHealth
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := s.service.DescribeListenerTargetHealth(ctx, s.clbId, s.listenerId, s.locationId)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		health = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unhealthy := make([]string, 0)
+	for _, backend := range backends {
+		if backend.side != CLB_TRAFFIC_SHIFT_SIDE_GREEN {
+			continue
+		}
+		healthy := false
+		for _, target := range health {
+			if target.Port == nil || *target.Port != backend.port {
+				continue
+			}
+			if (backend.instanceId != "" && helper.PString(target.TargetId) == backend.instanceId) ||
+				(backend.ip != "" && helper.PString(target.IP) == backend.ip) {
+				healthy = target.HealthStatus != nil && *target.HealthStatus
+				break
+			}
+		}
+		if !healthy {
+			unhealthy = append(unhealthy, backend.String())
+		}
+	}
+	return unhealthy, nil
+}
+
+func (s *clbTrafficShift) waitHealthy(ctx context.Context, backends []*clbTrafficShiftBackend) error {
+	deadline := time.Now().Add(s.healthTimeout)
+	for {
+		unhealthy, err := s.unhealthy(ctx, backends)
+		if err != nil {
+			return err
+		}
+		if len(unhealthy) == 0 {
+			return nil
+		}
+		if !time.Now().Add(s.healthInterval).Before(deadline) {
+			return fmt.Errorf("green backends %s are not healthy in %s", strings.Join(unhealthy, ","), s.healthTimeout)
+		}
+		time.Sleep(s.healthInterval)
+	}
+}
+
+// hold waits for interval, checking the health of the green side meanwhile when watch is true.
+func (s *clbTrafficShift) hold(ctx context.Context, backends []*clbTrafficShiftBackend, interval time.Duration, watch bool) error {
+	deadline := time.Now().Add(interval)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		if !watch {
+			time.Sleep(remaining)
+			return nil
+		}
+		if remaining > s.healthInterval {
+			remaining = s.healthInterval
+		}
+		time.Sleep(remaining)
+
+		unhealthy, err := s.unhealthy(ctx, backends)
+		if err != nil {
+			return err
+		}
+		if len(unhealthy) > 0 {
+			return fmt.Errorf("green backends %s became unhealthy", strings.Join(unhealthy, ","))
+		}
+	}
+}
+
+func clbTrafficShiftFindBackend(backends []*clb.Backend, target *clbTrafficShiftBackend) *clb.Backend {
+	for _, backend := range backends {
+		if backend.Port == nil || *backend.Port != target.port {
+			continue
+		}
+		if target.instanceId != "" && helper.PString(backend.InstanceId) == target.instanceId {
+			return backend
+		}
+		if target.ip != "" {
+			for _, ip := range backend.PrivateIpAddresses {
+				if helper.PString(ip) == target.ip {
+					return backend
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clbTrafficShiftWeights returns the weights of the blue and the green backends forwarding
+// greenPercent of the traffic to the green side. The traffic of a side is proportional to the total
+// weight of its backends, so the weights are scaled by the number of backends of each side, the
+// larger one being maxWeight. A side receiving any traffic has weights of at least 1.
+func clbTrafficShiftWeights(maxWeight int64, greenPercent int, backends []*clbTrafficShiftBackend) (blue, green int64) {
+	count := map[string]int64{}
+	for _, backend := range backends {
+		count[backend.side]++
+	}
+	if greenPercent <= 0 || count[CLB_TRAFFIC_SHIFT_SIDE_GREEN] == 0 {
+		return maxWeight, 0
+	}
+	if greenPercent >= 100 || count[CLB_TRAFFIC_SHIFT_SIDE_BLUE] == 0 {
+		return 0, maxWeight
+	}
+
+	// the shares of a backend of each side.
+	blueShare := float64(100-greenPercent) / float64(count[CLB_TRAFFIC_SHIFT_SIDE_BLUE])
+	greenShare := float64(greenPercent) / float64(count[CLB_TRAFFIC_SHIFT_SIDE_GREEN])
+	scale := float64(maxWeight) / math.Max(blueShare, greenShare)
+
+	blue = int64(math.Max(math.Round(blueShare*scale), 1))
+	green = int64(math.Max(math.Round(greenShare*scale), 1))
+	return
+}
+
+// clbTrafficShiftGreenPercent calculates the percentage of the traffic of the green side from the total weights of both sides.
+func clbTrafficShiftGreenPercent(backends []*clbTrafficShiftBackend) int {
+	sum := map[string]int64{}
+	for _, backend := range backends {
+		sum[backend.side] += backend.weight
+	}
+
+	blue, green := sum[CLB_TRAFFIC_SHIFT_SIDE_BLUE], sum[CLB_TRAFFIC_SHIFT_SIDE_GREEN]
+	if blue+green == 0 {
+		return 0
+	}
+	return int((100*green + (blue+green)/2) / (blue + green))
+}
@@ -0,0 +1,113 @@
+package clb_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudClbTrafficShiftResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClbTrafficShift(50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_clb_traffic_shift.shift", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_clb_traffic_shift.shift", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("tencentcloud_clb_traffic_shift.shift", "green_percent", "50"),
+					resource.TestCheckResourceAttrSet("tencentcloud_clb_traffic_shift.shift", "last_shifted_at"),
+				),
+			},
+			{
+				Config: testAccClbTrafficShift(100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_clb_traffic_shift.shift", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("tencentcloud_clb_traffic_shift.shift", "green_percent", "100"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_clb_traffic_shift.shift",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"blue", "green", "max_weight", "steps", "health_check", "status", "last_shifted_at"},
+			},
+		},
+	})
+}
+
+func testAccClbTrafficShift(percent int) string {
+	return tcacctest.ClbTargetEniTestCase + `
+resource "tencentcloud_clb_instance" "clb_instance" {
+  network_type = "OPEN"
+  clb_name     = "tf-clb-traffic-shift-test"
+  vpc_id       = var.cvm_vpc_id
+}
+
+resource "tencentcloud_clb_listener" "clb_listener" {
+  clb_id              = tencentcloud_clb_instance.clb_instance.id
+  listener_name       = "tf-clb-traffic-shift-test"
+  port                = 44
+  protocol            = "TCP"
+  health_check_switch = false
+  scheduler           = "WRR"
+}
+
+resource "tencentcloud_clb_attachment" "clb_attachment" {
+  clb_id      = tencentcloud_clb_instance.clb_instance.id
+  listener_id = tencentcloud_clb_listener.clb_listener.listener_id
+
+  targets {
+    instance_id = tencentcloud_instance.default.id
+    port        = 23
+    weight      = 100
+  }
+
+  targets {
+    eni_ip = tencentcloud_eni.clb_eni_target.ipv4_info.0.ip
+    port   = 23
+    weight = 0
+  }
+
+  lifecycle {
+    ignore_changes = [targets]
+  }
+}
+
+resource "tencentcloud_clb_traffic_shift" "shift" {
+  clb_id      = tencentcloud_clb_attachment.clb_attachment.clb_id
+  listener_id = tencentcloud_clb_attachment.clb_attachment.listener_id
+
+  blue {
+    targets {
+      instance_id = tencentcloud_instance.default.id
+      port        = 23
+    }
+  }
+
+  green {
+    targets {
+      eni_ip = tencentcloud_eni.clb_eni_target.ipv4_info.0.ip
+      port   = 23
+    }
+  }
+
+  steps {
+    green_percent = 10
+    interval      = 10
+  }
+
+  steps {
+    green_percent = ` + fmt.Sprint(percent) + `
+  }
+
+  health_check {
+    enabled = false
+  }
+}
+`
+}
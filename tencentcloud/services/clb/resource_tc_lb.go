@@ -28,6 +28,9 @@ func ResourceTencentCloudLB() *schema.Resource {
 		Read:               resourceTencentCloudLBRead,
 		Update:             resourceTencentCloudLBUpdate,
 		Delete:             resourceTencentCloudLBDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": {
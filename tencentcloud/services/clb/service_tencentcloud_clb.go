@@ -2593,3 +2593,136 @@ func (me *ClbService) DescribeDescribeCustomizedConfigAssociateListById(ctx cont
 
 	return
 }
+
+// BatchModifyTargetWeight sets the weight of each target bound to a listener, or to a forwarding rule when locationId is not empty.
+func (me *ClbService) BatchModifyTargetWeight(ctx context.Context, clbId, listenerId, locationId string, targets []*clb.Target) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := clb.NewBatchModifyTargetWeightRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.LoadBalancerId = &clbId
+	for count := 0; count < len(targets); count += 20 {
+		//this request only support 20 targets at most once time
+		end := count + 20
+		if end > len(targets) {
+			end = len(targets)
+		}
+		rule := &clb.RsWeightRule{
+			ListenerId: &listenerId,
+			Targets:    targets[count:end],
+		}
+		if locationId != "" {
+			rule.LocationId = &locationId
+		}
+		request.ModifyList = []*clb.RsWeightRule{rule}
+
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			result, e := me.client.UseClbClient().BatchModifyTargetWeight(request)
+			if e != nil {
+				if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok && ee.GetCode() == "FailedOperation.ResourceInOperating" {
+					return resource.RetryableError(e)
+				}
+				return tccommon.RetryError(e, tccommon.InternalError)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+
+			if result == nil || result.Response == nil || result.Response.RequestId == nil {
+				return resource.NonRetryableError(fmt.Errorf("Batch modify target weight failed, Response is nil."))
+			}
+
+			if retryErr := waitForTaskFinish(*result.Response.RequestId, me.client.UseClbClient()); retryErr != nil {
+				return resource.NonRetryableError(retryErr)
+			}
+			return nil
+		})
+		if err != nil {
+			errRet = err
+			return
+		}
+	}
+
+	return
+}
+
+// BatchModifyTargetGroupInstancesWeight sets the weight of each instance of a target group.
+func (me *ClbService) BatchModifyTargetGroupInstancesWeight(ctx context.Context, targetGroupId string, instances []*clb.TargetGroupInstance) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := clb.NewModifyTargetGroupInstancesWeightRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.TargetGroupId = &targetGroupId
+	for count := 0; count < len(instances); count += 20 {
+		end := count + 20
+		if end > len(instances) {
+			end = len(instances)
+		}
+		request.TargetGroupInstances = instances[count:end]
+
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			result, e := me.client.UseClbClient().ModifyTargetGroupInstancesWeight(request)
+			if e != nil {
+				if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok && ee.GetCode() == "FailedOperation.ResourceInOperating" {
+					return resource.RetryableError(e)
+				}
+				return tccommon.RetryError(e, tccommon.InternalError)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+
+			if result == nil || result.Response == nil || result.Response.RequestId == nil {
+				return resource.NonRetryableError(fmt.Errorf("Modify target group instance weight failed, Response is nil."))
+			}
+
+			if retryErr := waitForTaskFinish(*result.Response.RequestId, me.client.UseClbClient()); retryErr != nil {
+				return resource.NonRetryableError(retryErr)
+			}
+			return nil
+		})
+		if err != nil {
+			errRet = err
+			return
+		}
+	}
+
+	return
+}
+
+// DescribeListenerTargetHealth returns the health of the backends of a listener, or of a forwarding rule when locationId is not empty.
+func (me *ClbService) DescribeListenerTargetHealth(ctx context.Context, clbId, listenerId, locationId string) (targets []*clb.TargetHealth, errRet error) {
+	loadBalancers, err := me.DescribeClbTargetHealthByFilter(ctx, map[string]interface{}{
+		"LoadBalancerIds": []*string{&clbId},
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		for _, listener := range loadBalancer.Listeners {
+			if listener.ListenerId == nil || *listener.ListenerId != listenerId {
+				continue
+			}
+			for _, rule := range listener.Rules {
+				if locationId != "" && (rule.LocationId == nil || *rule.LocationId != locationId) {
+					continue
+				}
+				targets = append(targets, rule.Targets...)
+			}
+		}
+	}
+
+	return
+}
@@ -206,7 +206,9 @@ func resourceTencentCloudClsExportDelete(d *schema.ResourceData, meta interface{
 
 	service := ClsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-
+	if len(idSplit) != 2 {
+		return fmt.Errorf("id is broken,%s", d.Id())
+	}
 	exportId := idSplit[1]
 
 	if err := service.DeleteClsExportById(ctx, exportId); err != nil {
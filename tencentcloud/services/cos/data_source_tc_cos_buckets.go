@@ -15,7 +15,7 @@ import (
 )
 
 func DataSourceTencentCloudCosBuckets() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithOrganizationFanOut("bucket_list", false, &schema.Resource{
 		Read: dataSourceTencentCloudCosBucketsRead,
 
 		Schema: map[string]*schema.Schema{
@@ -335,7 +335,7 @@ func DataSourceTencentCloudCosBuckets() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface{}) error {
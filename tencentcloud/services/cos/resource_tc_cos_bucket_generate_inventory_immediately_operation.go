@@ -8,6 +8,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudCosBucketGenerateInventoryImmediatelyOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate,
 		Read:   resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead,
 		Delete: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationDelete,
@@ -34,7 +35,7 @@ func ResourceTencentCloudCosBucketGenerateInventoryImmediatelyOperation() *schem
 				Description: "Bucket.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -66,7 +67,7 @@ func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(d
 	targetBucket := items[len(items)-1]
 	targetBucketItems := strings.Split(targetBucket, "-")
 	objectId := fmt.Sprintf("%s/%s/%s/%s/%s/manifest.json", result.Destination.Prefix, targetBucketItems[len(targetBucketItems)-1], strings.Join(targetBucketItems[:len(targetBucketItems)-1], "-"), id, time.Now().Format("20060102"))
-	err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		resp, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTencentCosClient(targetBucket).Object.Head(ctx, objectId, nil)
 		if resp.StatusCode == 404 {
 			return resource.RetryableError(fmt.Errorf("Inventory still creating!"))
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,12 +19,18 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
+// cosBucketObjectImportIdFormat is the import id of an object, the id of the resource is the bucket followed by the key.
+var cosBucketObjectImportIdFormat = compositeid.New(compositeid.String("bucket"), compositeid.String("key"))
+
 func ResourceTencentCloudCosBucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCosBucketObjectCreate,
 		Read:   resourceTencentCloudCosBucketObjectRead,
 		Update: resourceTencentCloudCosBucketObjectUpdate,
 		Delete: resourceTencentCloudCosBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCosBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -188,6 +195,18 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 	return resourceTencentCloudCosBucketObjectRead(d, meta)
 }
 
+func resourceTencentCloudCosBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := cosBucketObjectImportIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	bucket, key := id.Get("bucket"), id.Get("key")
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("key", key)
+	d.SetId(bucket + key)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket_object.read")()
 
@@ -50,6 +50,12 @@ func TestAccTencentCloudCosBucketObjectResource_source(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_source", "content_type", "binary/octet-stream"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket_object.object_source",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
 		},
 	})
 }
@@ -70,6 +76,12 @@ func TestAccTencentCloudCosBucketObjectResource_content(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_content", "content_type", "binary/octet-stream"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket_object.object_content",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
 		},
 	})
 }
@@ -5,12 +5,13 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceTencentCloudCosObjectAbortMultipartUploadOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate,
 		Read:   resourceTencentCloudCosObjectAbortMultipartUploadOperationRead,
 		Delete: resourceTencentCloudCosObjectAbortMultipartUploadOperationDelete,
@@ -35,7 +36,7 @@ func ResourceTencentCloudCosObjectAbortMultipartUploadOperation() *schema.Resour
 				Description: "Multipart uploaded id.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -5,12 +5,13 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceTencentCloudCosObjectCopyOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCosObjectCopyOperationCreate,
 		Read:   resourceTencentCloudCosObjectCopyOperationRead,
 		Delete: resourceTencentCloudCosObjectCopyOperationDelete,
@@ -35,7 +36,7 @@ func ResourceTencentCloudCosObjectCopyOperation() *schema.Resource {
 				Description: "Source url. In the CDC scenario, the CDC source url is used.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCosObjectCopyOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -7,12 +7,13 @@ import (
 	"os"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceTencentCloudCosObjectDownloadOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCosObjectDownloadOperationCreate,
 		Read:   resourceTencentCloudCosObjectDownloadOperationRead,
 		Delete: resourceTencentCloudCosObjectDownloadOperationDelete,
@@ -37,7 +38,7 @@ func ResourceTencentCloudCosObjectDownloadOperation() *schema.Resource {
 				Description: "Download path.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCosObjectDownloadOperationCreate(d *schema.ResourceData, meta interface{}) error {
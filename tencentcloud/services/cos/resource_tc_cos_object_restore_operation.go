@@ -5,13 +5,14 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
 )
 
 func ResourceTencentCloudCosObjectRestoreOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCosObjectRestoreOperationCreate,
 		Read:   resourceTencentCloudCosObjectRestoreOperationRead,
 		Delete: resourceTencentCloudCosObjectRestoreOperationDelete,
@@ -49,7 +50,7 @@ func ResourceTencentCloudCosObjectRestoreOperation() *schema.Resource {
 				Description: "Specifies the valid duration of the restored temporary copy in days.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCosObjectRestoreOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"regexp"
@@ -96,6 +97,33 @@ func (me *CosService) DeleteObject(ctx context.Context, bucket, key string) (err
 	return nil
 }
 
+func (me *CosService) PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	}
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, bucket [%s], object [%s], reason[%s]\n",
+				logId, "put object", bucket, key, errRet.Error())
+		}
+	}()
+	ratelimit.Check("PutObject")
+	response, err := me.client.UseCosClient().PutObject(&request)
+	if err != nil {
+		errRet = fmt.Errorf("cos put object error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, bucket [%s], object [%s], response body [%s]\n",
+		logId, "put object", bucket, key, response.String())
+
+	return nil
+}
+
 func (me *CosService) PutObjectAcl(ctx context.Context, bucket, key, acl string) (errRet error) {
 	logId := tccommon.GetLogId(ctx)
 
@@ -15,6 +15,7 @@ import (
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisAccount() *schema.Resource {
@@ -76,6 +77,8 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	var (
 		request     = redis.NewCreateInstanceAccountRequest()
@@ -137,21 +140,7 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis create account fail, reason:%s\n", logId, err.Error())
@@ -233,6 +222,8 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := redis.NewModifyInstanceAccountRequest()
 	response := redis.NewModifyInstanceAccountResponse()
@@ -301,21 +292,7 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change account fail, reason:%s\n", logId, err.Error())
@@ -343,6 +320,8 @@ func resourceTencentCloudRedisAccountDelete(d *schema.ResourceData, meta interfa
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
@@ -357,21 +336,7 @@ func resourceTencentCloudRedisAccountDelete(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("delete account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis delete account fail, reason:%s\n", logId, err.Error())
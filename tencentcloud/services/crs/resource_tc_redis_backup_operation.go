@@ -1,22 +1,20 @@
 package crs
 
 import (
-	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudRedisBackupOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.AsyncResource(&schema.Resource{
 		Create: resourceTencentCloudRedisBackupOperationCreate,
 		Read:   resourceTencentCloudRedisBackupOperationRead,
 		Delete: resourceTencentCloudRedisBackupOperationDelete,
@@ -43,7 +41,7 @@ func ResourceTencentCloudRedisBackupOperation() *schema.Resource {
 				Description: "Number of days to store.0 specifies the default retention time.",
 			},
 		},
-	}
+	}, redisOperationTaskWaiter)
 }
 
 func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -51,11 +49,9 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = redis.NewManualBackupInstanceRequest()
-		response   = redis.NewManualBackupInstanceResponse()
 		instanceId string
 	)
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -77,8 +73,8 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
-		response = result
 		return nil
 	})
 	if err != nil {
@@ -86,33 +82,8 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	taskId := *response.Response.TaskId
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	if taskId > 0 {
-		err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("redis backupOperation is processing"))
-			}
-		})
-
-		if err != nil {
-			log.Printf("[CRITAL]%s redis backupOperation fail, reason:%s\n", logId, err.Error())
-			return err
-		}
-	}
-
 	return resourceTencentCloudRedisBackupOperationRead(d, meta)
 }
 
@@ -21,6 +21,16 @@ func TestAccTencentCloudRedisBackupOperationResource_basic(t *testing.T) {
 				Config: testAccRedisBackupOperation,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup_operation.backup_operation", "id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup_operation.backup_operation", "last_run_at"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup_operation.backup_operation", "request_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup_operation.backup_operation", "task_id"),
+				),
+			},
+			{
+				Config: testAccRedisBackupOperationRerun,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_redis_backup_operation.backup_operation", "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup_operation.backup_operation", "last_run_at"),
 				),
 			},
 		},
@@ -42,3 +52,16 @@ resource "tencentcloud_redis_backup_operation" "backup_operation" {
 }
 
 `
+
+const testAccRedisBackupOperationRerun = testAccRedisBackupOperationVar + `
+
+resource "tencentcloud_redis_backup_operation" "backup_operation" {
+	instance_id = var.instance_id
+	remark = "backup test"
+	storage_days = 7
+	triggers = {
+		run = "2"
+	}
+}
+
+`
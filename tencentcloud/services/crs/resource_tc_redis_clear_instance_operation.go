@@ -1,22 +1,20 @@
 package crs
 
 import (
-	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudRedisClearInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.AsyncResource(&schema.Resource{
 		Create: resourceTencentCloudRedisClearInstanceOperationCreate,
 		Read:   resourceTencentCloudRedisClearInstanceOperationRead,
 		Delete: resourceTencentCloudRedisClearInstanceOperationDelete,
@@ -39,7 +37,7 @@ func ResourceTencentCloudRedisClearInstanceOperation() *schema.Resource {
 				Description: "Redis instance password (password-free instances do not need to pass passwords, non-password-free instances must be transmitted).",
 			},
 		},
-	}
+	}, redisOperationTaskWaiter)
 }
 
 func resourceTencentCloudRedisClearInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -47,11 +45,9 @@ func resourceTencentCloudRedisClearInstanceOperationCreate(d *schema.ResourceDat
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = redis.NewClearInstanceRequest()
-		response   = redis.NewClearInstanceResponse()
 		instanceId string
 	)
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -69,8 +65,8 @@ func resourceTencentCloudRedisClearInstanceOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
-		response = result
 		return nil
 	})
 	if err != nil {
@@ -80,29 +76,6 @@ func resourceTencentCloudRedisClearInstanceOperationCreate(d *schema.ResourceDat
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.TaskId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("clear instance is processing"))
-		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s redis clear instance fail, reason:%s\n", logId, err.Error())
-		return err
-	}
-
 	return resourceTencentCloudRedisClearInstanceOperationRead(d, meta)
 }
 
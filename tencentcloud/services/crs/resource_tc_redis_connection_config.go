@@ -2,7 +2,6 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -13,6 +12,7 @@ import (
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisConnectionConfig() *schema.Resource {
@@ -156,6 +156,8 @@ func resourceTencentCloudRedisConnectionConfigUpdate(d *schema.ResourceData, met
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := redis.NewModifyConnectionConfigRequest()
 	response := redis.NewModifyConnectionConfigResponse()
@@ -194,21 +196,7 @@ func resourceTencentCloudRedisConnectionConfigUpdate(d *schema.ResourceData, met
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change connection fail, reason:%s\n", logId, err.Error())
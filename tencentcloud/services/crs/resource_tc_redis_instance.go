@@ -18,6 +18,7 @@ import (
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 //internal version: replace import begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -729,6 +730,8 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	id := d.Id()
 
@@ -856,21 +859,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 			return err
 		}
 
-		err = resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := redisService.DescribeTaskInfo(ctx, id, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("change password is processing"))
-			}
-		})
+		err = redisService.WaitForTask(ctx, id, taskId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis change password fail, reason:%s\n", logId, err.Error())
@@ -9,10 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisParam() *schema.Resource {
@@ -99,6 +99,8 @@ func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := redis.NewModifyInstanceParamsRequest()
 	response := redis.NewModifyInstanceParamsResponse()
@@ -148,21 +150,7 @@ func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change param is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change param fail, reason:%s\n", logId, err.Error())
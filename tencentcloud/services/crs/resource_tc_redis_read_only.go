@@ -2,7 +2,6 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
 
@@ -10,10 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisReadOnly() *schema.Resource {
@@ -96,6 +95,8 @@ func resourceTencentCloudRedisReadOnlyUpdate(d *schema.ResourceData, meta interf
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := redis.NewModifyInstanceReadOnlyRequest()
 	response := redis.NewModifyInstanceReadOnlyResponse()
@@ -125,21 +126,7 @@ func resourceTencentCloudRedisReadOnlyUpdate(d *schema.ResourceData, meta interf
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change inputMode is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change inputMode fail, reason:%s\n", logId, err.Error())
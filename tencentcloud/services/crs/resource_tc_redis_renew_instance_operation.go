@@ -5,6 +5,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudRedisRenewInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudRedisRenewInstanceOperationCreate,
 		Read:   resourceTencentCloudRedisRenewInstanceOperationRead,
 		Delete: resourceTencentCloudRedisRenewInstanceOperationDelete,
@@ -44,7 +45,7 @@ func ResourceTencentCloudRedisRenewInstanceOperation() *schema.Resource {
 				Description: "Identifies whether the billing model is modified:The current instance billing mode is pay-as-you-go, which is prepaid and renewed.The billing mode of the current instance is subscription and you can not set this parameter.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -82,6 +83,7 @@ func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -93,7 +95,7 @@ func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceDat
 	d.SetId(instanceId)
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	_, _, _, err = service.CheckRedisOnlineOk(ctx, instanceId, 20*tccommon.ReadRetryTimeout)
+	_, _, _, err = service.CheckRedisOnlineOk(ctx, instanceId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[CRITAL]%s redis upgradeVersionOperation fail, reason:%s\n", logId, err.Error())
 		return err
@@ -2,14 +2,13 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 )
 
@@ -19,6 +18,9 @@ func ResourceTencentCloudRedisReplicaReadonly() *schema.Resource {
 		Read:   resourceTencentCloudRedisReplicaReadonlyRead,
 		Update: resourceTencentCloudRedisReplicaReadonlyUpdate,
 		Delete: resourceTencentCloudRedisReplicaReadonlyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -106,6 +108,8 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	var (
 		disableRequest  = redis.NewDisableReplicaReadonlyRequest()
@@ -159,21 +163,7 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 	}
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change inputMode is processing"))
-		}
-	})
+	err := service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change inputMode fail, reason:%s\n", logId, err.Error())
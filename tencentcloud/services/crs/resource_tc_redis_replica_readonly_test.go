@@ -33,6 +33,11 @@ func TestAccTencentCloudRedisReplicaReadonlyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_redis_replica_readonly.replica_readonly", "operate", "disable"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_redis_replica_readonly.replica_readonly",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -2,17 +2,16 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisReplicateAttachment() *schema.Resource {
@@ -55,6 +54,8 @@ func resourceTencentCloudRedisReplicateAttachmentCreate(d *schema.ResourceData,
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	var (
 		request          = redis.NewAddReplicationInstanceRequest()
@@ -149,6 +150,8 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	var (
 		request  = redis.NewChangeMasterInstanceRequest()
@@ -184,21 +187,7 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 
 		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 		if taskId > 0 {
-			err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-				ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-				if err != nil {
-					if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-				if ok {
-					return nil
-				} else {
-					return resource.RetryableError(fmt.Errorf("update redis changeMaster is processing"))
-				}
-			})
+			err := service.WaitForTask(ctx, instanceId, taskId)
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis changeMaster fail, reason:%s\n", logId, err.Error())
@@ -243,6 +232,8 @@ func resourceTencentCloudRedisReplicateAttachmentDelete(d *schema.ResourceData,
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 	groupId := d.Id()
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
@@ -2,10 +2,10 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -94,6 +94,8 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	var (
 		openSSLRequest  = redis.NewOpenSSLRequest()
@@ -151,21 +153,7 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	if taskId > 0 {
-		err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("ssl config is processing"))
-			}
-		})
+		err := service.WaitForTask(ctx, instanceId, taskId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis ssl config fail, reason:%s\n", logId, err.Error())
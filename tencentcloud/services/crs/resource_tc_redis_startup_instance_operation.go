@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudRedisStartupInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudRedisStartupInstanceOperationCreate,
 		Read:   resourceTencentCloudRedisStartupInstanceOperationRead,
 		Delete: resourceTencentCloudRedisStartupInstanceOperationDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudRedisStartupInstanceOperation() *schema.Resource {
 				Description: "The ID of instance.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudRedisStartupInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -53,6 +54,7 @@ func resourceTencentCloudRedisStartupInstanceOperationCreate(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -64,7 +66,7 @@ func resourceTencentCloudRedisStartupInstanceOperationCreate(d *schema.ResourceD
 	d.SetId(instanceId)
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, err := service.DescribeRedisInstanceById(ctx, d.Id())
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -2,7 +2,6 @@ package crs
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -13,6 +12,7 @@ import (
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudRedisSwitchMaster() *schema.Resource {
@@ -98,6 +98,8 @@ func resourceTencentCloudRedisSwitchMasterUpdate(d *schema.ResourceData, meta in
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := redis.NewChangeReplicaToMasterRequest()
 	response := redis.NewChangeReplicaToMasterResponse()
@@ -130,21 +132,7 @@ func resourceTencentCloudRedisSwitchMasterUpdate(d *schema.ResourceData, meta in
 
 	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("update redis switchMaster is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, instanceId, taskId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis switchMaster fail, reason:%s\n", logId, err.Error())
@@ -1,22 +1,20 @@
 package crs
 
 import (
-	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudRedisUpgradeCacheVersionOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.AsyncResource(&schema.Resource{
 		Create: resourceTencentCloudRedisUpgradeCacheVersionOperationCreate,
 		Read:   resourceTencentCloudRedisUpgradeCacheVersionOperationRead,
 		Delete: resourceTencentCloudRedisUpgradeCacheVersionOperationDelete,
@@ -52,7 +50,7 @@ func ResourceTencentCloudRedisUpgradeCacheVersionOperation() *schema.Resource {
 				Description: "Switch mode:1 - Upgrade now0 - Maintenance window upgrade.",
 			},
 		},
-	}
+	}, redisOperationTaskWaiter)
 }
 
 func resourceTencentCloudRedisUpgradeCacheVersionOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -60,11 +58,9 @@ func resourceTencentCloudRedisUpgradeCacheVersionOperationCreate(d *schema.Resou
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = redis.NewUpgradeSmallVersionRequest()
-		response   = redis.NewUpgradeSmallVersionResponse()
 		instanceId string
 	)
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -90,8 +86,8 @@ func resourceTencentCloudRedisUpgradeCacheVersionOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
-		response = result
 		return nil
 	})
 	if err != nil {
@@ -101,29 +97,6 @@ func resourceTencentCloudRedisUpgradeCacheVersionOperationCreate(d *schema.Resou
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.FlowId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade cache version is processing"))
-		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s redis upgrade cache version fail, reason:%s\n", logId, err.Error())
-		return err
-	}
-
 	return resourceTencentCloudRedisUpgradeCacheVersionOperationRead(d, meta)
 }
 
@@ -1,22 +1,20 @@
 package crs
 
 import (
-	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.AsyncResource(&schema.Resource{
 		Create: resourceTencentCloudRedisUpgradeMultiZoneOperationCreate,
 		Read:   resourceTencentCloudRedisUpgradeMultiZoneOperationRead,
 		Delete: resourceTencentCloudRedisUpgradeMultiZoneOperationDelete,
@@ -38,7 +36,7 @@ func ResourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
 				Description: "After you upgrade Multi-AZ, whether the nearby access feature is supported.true: Supports nearby access.The upgrade process, which requires upgrading both the proxy version and the Redis kernel minor version, involves data migration and can take several hours.false: No need to support nearby access.Upgrading Multi-AZ only involves managing metadata migration, with no service impact, and the upgrade process typically completes within 3 minutes.",
 			},
 		},
-	}
+	}, redisOperationTaskWaiter)
 }
 
 func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -46,11 +44,9 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = redis.NewUpgradeVersionToMultiAvailabilityZonesRequest()
-		response   = redis.NewUpgradeVersionToMultiAvailabilityZonesResponse()
 		instanceId string
 	)
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -68,8 +64,8 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
-		response = result
 		return nil
 	})
 	if err != nil {
@@ -79,29 +75,6 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.FlowId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade multi zone is processing"))
-		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s redis upgrade multi zone fail, reason:%s\n", logId, err.Error())
-		return err
-	}
-
 	return resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d, meta)
 }
 
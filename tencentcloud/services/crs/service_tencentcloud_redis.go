@@ -10,12 +10,13 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	region "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/region/v20220627"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -907,6 +908,22 @@ func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, new
 }
 
 func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, taskId int64) (ok bool, errRet error) {
+	task, errRet := me.DescribeTaskStatus(ctx, taskId)
+	if errRet != nil {
+		return
+	}
+	if *task.Status == REDIS_TASK_RUNNING || *task.Status == REDIS_TASK_PREPARING {
+		return
+	}
+	if *task.Status == REDIS_TASK_SUCCEED {
+		ok = true
+		return
+	}
+	errRet = fmt.Errorf("redis task exe fail, task status is %s", *task.Status)
+	return
+}
+
+func (me *RedisService) DescribeTaskStatus(ctx context.Context, taskId int64) (task *redis.DescribeTaskInfoResponseParams, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	var uintTaskId = uint64(taskId)
 	request := redis.NewDescribeTaskInfoRequest()
@@ -937,15 +954,46 @@ func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, ta
 		errRet = err
 		return
 	}
-	if *response.Response.Status == REDIS_TASK_RUNNING || *response.Response.Status == REDIS_TASK_PREPARING {
-		return
+	task = response.Response
+	return
+}
+
+// WaitForTask waits for the task of taskId on the instance of redisId to succeed until ctx is done,
+// such as by the timeout of the resource given by waiter.WithResourceTimeout.
+func (me *RedisService) WaitForTask(ctx context.Context, redisId string, taskId int64) error {
+	_, err := (&waiter.Waiter{
+		Name:    fmt.Sprintf("task %d of redis instance %s", taskId, redisId),
+		Pending: []string{REDIS_TASK_PREPARING, REDIS_TASK_RUNNING},
+		Target:  []string{REDIS_TASK_SUCCEED},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			task, err := me.DescribeTaskStatus(ctx, taskId)
+			if err != nil {
+				return nil, err
+			}
+			return &waiter.Status{
+				State:   helper.PString(task.Status),
+				Step:    helper.PString(task.TaskType),
+				Message: helper.PString(task.TaskMessage),
+			}, nil
+		},
+	}).Wait(ctx)
+	return err
+}
+
+// redisOperationTaskWaiter waits for the task recorded in task_id of a redis operation, whose id is
+// the id of the instance, by WaitForTask within the create timeout of the operation, which ctx
+// expires with.
+func redisOperationTaskWaiter(ctx context.Context, d *schema.ResourceData, meta interface{}) *resource.RetryError {
+	taskId, err := strconv.ParseInt(d.Get("task_id").(string), 10, 64)
+	if err != nil || taskId <= 0 {
+		return nil
 	}
-	if *response.Response.Status == REDIS_TASK_SUCCEED {
-		ok = true
-		return
+
+	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if err := service.WaitForTask(ctx, d.Id(), taskId); err != nil {
+		return resource.NonRetryableError(err)
 	}
-	errRet = fmt.Errorf("redis task exe fail, task status is %s", *response.Response.Status)
-	return
+	return nil
 }
 
 func (me *RedisService) ResetPassword(ctx context.Context, redisId string, newPassword string, noAuth bool) (taskId int64, errRet error) {
@@ -1869,21 +1917,7 @@ func (me *RedisService) AddReplicationInstance(ctx context.Context, groupId, ins
 	taskId := *response.Response.TaskId
 
 	if taskId > 0 {
-		err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := me.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("Add replication is processing"))
-			}
-		})
+		err := me.WaitForTask(ctx, instanceId, taskId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis add replication fail, reason:%s\n", logId, err.Error())
@@ -2078,21 +2112,7 @@ func (me *RedisService) DeleteRedisReplicateAttachmentById(ctx context.Context,
 	taskId := *response.Response.TaskId
 
 	if taskId > 0 {
-		err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := me.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("remove replication is processing"))
-			}
-		})
+		err := me.WaitForTask(ctx, instanceId, taskId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis remove replication fail, reason:%s\n", logId, err.Error())
@@ -19,6 +19,9 @@ func ResourceTencentCloudCsipRiskCenter() *schema.Resource {
 		Read:   resourceTencentCloudCsipRiskCenterRead,
 		Update: resourceTencentCloudCsipRiskCenterUpdate,
 		Delete: resourceTencentCloudCsipRiskCenterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"task_name": {
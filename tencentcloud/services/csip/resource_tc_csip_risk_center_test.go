@@ -41,6 +41,11 @@ func TestAccTencentCloudCsipRiskCenterResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_csip_risk_center.example", "assets.#"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_csip_risk_center.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
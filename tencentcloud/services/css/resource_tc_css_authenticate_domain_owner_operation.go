@@ -5,6 +5,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudCssAuthenticateDomainOwnerOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate,
 		Read:   resourceTencentCloudCssAuthenticateDomainOwnerOperationRead,
 		Delete: resourceTencentCloudCssAuthenticateDomainOwnerOperationDelete,
@@ -34,7 +35,7 @@ func ResourceTencentCloudCssAuthenticateDomainOwnerOperation() *schema.Resource
 				Description: "Authentication type. Possible values:`dnsCheck`: Immediately verify whether the resolution record of the configured dns is consistent with the content to be verified, and save the record if successful.`fileCheck`: Immediately verify whether the web file is consistent with the content to be verified, and save the record if successful.`dbCheck`: Check if authentication has been successful.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.Res
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -15,7 +15,7 @@ import (
 )
 
 func DataSourceTencentCloudInstances() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithResultFilter("instance_list", tccommon.WithOrganizationFanOut("instance_list", true, &schema.Resource{
 		Read: dataSourceTencentCloudInstancesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -262,7 +262,7 @@ func DataSourceTencentCloudInstances() *schema.Resource {
 				},
 			},
 		},
-	}
+	}))
 }
 
 func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{}) error {
@@ -1,5 +1,14 @@
 package cvm
 
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
 const (
 	CVM_CHARGE_TYPE_PREPAID    = "PREPAID"
 	CVM_CHARGE_TYPE_POSTPAID   = "POSTPAID_BY_HOUR"
@@ -70,6 +79,14 @@ const (
 	UNDERLINE                     = "_"
 	IMAGE_SHARE_PERMISSION_SHARE  = "SHARE"
 	IMAGE_SHARE_PERMISSION_CANCEL = "CANCEL"
+
+	CVM_READINESS_COMMAND_TYPE_SHELL      = "SHELL"
+	CVM_READINESS_COMMAND_TYPE_POWERSHELL = "POWERSHELL"
+
+	CVM_READINESS_DEFAULT_TIMEOUT  = 600
+	CVM_READINESS_DEFAULT_INTERVAL = 10
+
+	TAT_TASK_STATUS_SUCCESS = "SUCCESS"
 )
 
 // Only client error can cvm retry, others will directly returns
@@ -123,3 +140,99 @@ var CVM_PLACEMENT_GROUP_TYPE = []string{
 var CVM_SPOT_INSTANCE_TYPE = []string{
 	CVM_SPOT_INSTANCE_TYPE_ONE_TIME,
 }
+
+// TAT invocation task statuses which mean the command has not finished yet.
+var TAT_TASK_PENDING_STATUS = []string{
+	"PENDING",
+	"DELIVERING",
+	"DELIVER_DELAYED",
+	"RUNNING",
+	"CANCELLING",
+}
+
+func instanceReadinessCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Readiness check executed through TAT after the instance is launched. Creation only completes once `command` exits with code `0` on every instance, " +
+			"otherwise the instance is marked as tainted. The image must have the TAT agent installed. Changing this block does not affect existing instances. It is not read from the instance, so it is not restored by import.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Command to run on the instance, e.g. `cloud-init status --wait`. The instance is ready when the command exits with code `0`.",
+				},
+				"command_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      CVM_READINESS_COMMAND_TYPE_SHELL,
+					ValidateFunc: tccommon.ValidateAllowedStringValue([]string{CVM_READINESS_COMMAND_TYPE_SHELL, CVM_READINESS_COMMAND_TYPE_POWERSHELL}),
+					Description:  "Type of the command. Valid values: `SHELL`, `POWERSHELL`. Default is `SHELL`.",
+				},
+				"timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      CVM_READINESS_DEFAULT_TIMEOUT,
+					ValidateFunc: tccommon.ValidateIntegerInRange(1, 86400),
+					Description:  "Maximum time in seconds to wait for the instance to become ready. Default is `600`.",
+				},
+				"interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      CVM_READINESS_DEFAULT_INTERVAL,
+					ValidateFunc: tccommon.ValidateIntegerInRange(1, 3600),
+					Description:  "Interval in seconds between two failed checks. Default is `10`.",
+				},
+			},
+		},
+	}
+}
+
+func instanceReadinessCheckFromResourceData(d *schema.ResourceData) (command, commandType string, timeout, interval time.Duration, ok bool) {
+	v, exist := d.GetOk("readiness_check")
+	if !exist {
+		return
+	}
+	checks := v.([]interface{})
+	if len(checks) < 1 || checks[0] == nil {
+		return
+	}
+	check := checks[0].(map[string]interface{})
+	command = check["command"].(string)
+	commandType = check["command_type"].(string)
+	timeout = time.Duration(check["timeout"].(int)) * time.Second
+	interval = time.Duration(check["interval"].(int)) * time.Second
+	ok = true
+	return
+}
+
+// SellableFunc returns the charge type, the availability zones and the instance types planned, of
+// which at least one is required to be sellable. No zone checks them in any zone of the region, and
+// no instance type skips the check, such as when they are not known yet.
+type SellableFunc func(d *schema.ResourceDiff) (chargeType string, zones, instanceTypes []string)
+
+// SellableCheckDiff returns a CustomizeDiff checking that the instance types planned by f are
+// sellable, so that a sold out instance type fails the plan instead of the apply.
+func SellableCheckDiff(f SellableFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if meta == nil {
+			return nil
+		}
+		client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+		if client == nil {
+			return nil
+		}
+
+		chargeType, zones, instanceTypes := f(d)
+		if len(instanceTypes) == 0 {
+			return nil
+		}
+		if chargeType == "" {
+			chargeType = CVM_CHARGE_TYPE_POSTPAID
+		}
+		cvmService := NewCvmService(client)
+		return cvmService.CheckInstanceTypesSellable(ctx, chargeType, zones, instanceTypes)
+	}
+}
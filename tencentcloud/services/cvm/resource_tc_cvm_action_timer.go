@@ -18,9 +18,9 @@ func ResourceTencentCloudCvmActionTimer() *schema.Resource {
 		Read:   resourceTencentCloudCvmActionTimerRead,
 		//Update: resourceTencentCloudCvmActionTimerUpdate,
 		Delete: resourceTencentCloudCvmActionTimerDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -140,6 +140,7 @@ func resourceTencentCloudCvmActionTimerRead(d *schema.ResourceData, meta interfa
 		actionTimerMap["action_time"] = InstanceActionTimer.ActionTime
 	}
 
+	_ = d.Set("instance_id", InstanceActionTimer.InstanceId)
 	_ = d.Set("action_timer", []interface{}{actionTimerMap})
 
 	return nil
@@ -25,6 +25,11 @@ func TestAccTencentCloudNeedFixCvmActionTimerResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cvm_action_timer.example", "action_timer.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cvm_action_timer.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
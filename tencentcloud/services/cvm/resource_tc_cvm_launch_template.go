@@ -18,6 +18,9 @@ func ResourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 		Create: resourceTencentCloudCvmLaunchTemplateCreate,
 		Read:   resourceTencentCloudCvmLaunchTemplateRead,
 		Delete: resourceTencentCloudCvmLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"launch_template_name": {
 				Required:    true,
@@ -23,6 +23,11 @@ func TestAccTencentCloudCvmLaunchTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cvm_launch_template.launch_template", "image_id", "img-9qrfy1xt"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cvm_launch_template.launch_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
@@ -16,6 +17,7 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -128,6 +130,11 @@ func ResourceTencentCloudEip() *schema.Resource {
 				Description: "The EIP current status.",
 			},
 		},
+
+		CustomizeDiff: tccommon.QuotaCheckDiff(func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+			vpcService := svcvpc.NewVpcService(client)
+			return "eip", "EIPs", vpcService.DescribeEipQuotaUsage
+		}),
 	}
 }
 
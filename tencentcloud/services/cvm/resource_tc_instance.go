@@ -17,6 +17,7 @@ import (
 	"github.com/gofrs/flock"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svccbs "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cbs"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
@@ -29,6 +30,7 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -55,12 +57,32 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description:  "The image to use for the instance. Modifications may lead to the reinstallation of the instance's operating system.",
 			},
 			"availability_zone": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				AtLeastOneOf: []string{"availability_zone", "launch_template_id"},
-				Description:  "The available zone for the CVM instance.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				AtLeastOneOf:     []string{"availability_zone", "launch_template_id"},
+				DiffSuppressFunc: instanceZoneCandidateDiffSuppress("availability_zone"),
+				Description:      "The available zone for the CVM instance.",
+			},
+			"zone_candidates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The availability zones to fall back to in order at creation if the instance types are sold out in `availability_zone`. The instance created in one of them is not replaced for not being in `availability_zone`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The availability zone to fall back to.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of a VPC subnet in `availability_zone` to create the instance in instead of `subnet_id`. It should be set if `subnet_id` is set, as a subnet belongs to a single availability zone.",
+						},
+					},
+				},
 			},
 			"dedicated_cluster_id": {
 				Type:        schema.TypeString,
@@ -249,10 +271,11 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description: "The ID of a VPC network. If you want to create instances in a VPC network, this parameter must be set.",
 			},
 			"subnet_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The ID of a VPC subnet. If you want to create instances in a VPC network, this parameter must be set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: instanceZoneCandidateDiffSuppress("subnet_id"),
+				Description:      "The ID of a VPC subnet. If you want to create instances in a VPC network, this parameter must be set.",
 			},
 			"private_ip": {
 				Type:        schema.TypeString,
@@ -512,6 +535,7 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Release elastic IP. Under EIP 2.0, only the first EIP under the primary network card is provided, and the EIP types are limited to HighQualityEIP, AntiDDoSEIP, EIPv6, and HighQualityEIPv6. Default behavior is not released.",
 			},
+			"readiness_check": instanceReadinessCheckSchema(),
 			// Computed values.
 			"instance_status": {
 				Type:        schema.TypeString,
@@ -598,10 +622,137 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				_ = d.ForceNew("instance_type")
 				return nil
 			},
+
+			tccommon.QuotaCheckDiff(instanceQuota),
+			SellableCheckDiff(instanceSellable),
 		),
 	}
 }
 
+// instanceCreateAttempt is an instance type to create the instance with in a zone candidate,
+// availability_zone or one of zone_candidates.
+type instanceCreateAttempt struct {
+	instanceType  string
+	zoneCandidate map[string]interface{}
+}
+
+// instanceZoneCandidateDiffSuppress suppresses the diff of key, availability_zone or subnet_id, of
+// an instance created in one of zone_candidates as a fallback: the old value is the one of the zone
+// candidate Create picked, and the new value is the configured one of the primary zone.
+func instanceZoneCandidateDiffSuppress(key string) schema.SchemaDiffSuppressFunc {
+	return func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+		if oldValue == "" || newValue == "" || d.Id() == "" {
+			return false
+		}
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return false
+		}
+		configured := config.GetAttr(key)
+		if configured.IsNull() || !configured.IsKnown() || configured.AsString() != newValue {
+			return false
+		}
+
+		// the zone the instance is in, picked by Create.
+		zone, _ := d.GetChange("availability_zone")
+		for _, v := range d.Get("zone_candidates").([]interface{}) {
+			candidate, ok := v.(map[string]interface{})
+			if ok && candidate["availability_zone"] == zone && candidate[key] == oldValue {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// instanceSellable returns the instance types of the instance and the zones it may be created in:
+// instance_type_candidates and instance_type in availability_zone and zone_candidates at creation,
+// instance_type in the zone of the instance when resized.
+func instanceSellable(d *schema.ResourceDiff) (string, []string, []string) {
+	if d.Id() != "" && !d.HasChange("instance_type") {
+		return "", nil, nil
+	}
+	chargeType, ok := instanceChargeType(d)
+	if !ok || !d.NewValueKnown("availability_zone") || !d.NewValueKnown("zone_candidates") {
+		return "", nil, nil
+	}
+
+	zones := make([]string, 0)
+	if v := d.Get("availability_zone").(string); v != "" {
+		zones = append(zones, v)
+	}
+	if d.Id() == "" {
+		for _, v := range d.Get("zone_candidates").([]interface{}) {
+			if candidate, ok := v.(map[string]interface{}); ok && candidate["availability_zone"].(string) != "" {
+				zones = append(zones, candidate["availability_zone"].(string))
+			}
+		}
+	}
+	if len(zones) == 0 {
+		// the zone of the launch template is not known.
+		return "", nil, nil
+	}
+
+	// an unknown instance type may be the sellable one, unless it is not configured but computed,
+	// such as from the launch template.
+	if !d.NewValueKnown("instance_type") && !instanceAttributeUnconfigured(d, "instance_type") {
+		return "", nil, nil
+	}
+	instanceTypes := make([]string, 0)
+	if d.Id() == "" {
+		if !d.NewValueKnown("instance_type_candidates") {
+			return "", nil, nil
+		}
+		for _, v := range d.Get("instance_type_candidates").([]interface{}) {
+			if v != nil && v.(string) != "" {
+				instanceTypes = append(instanceTypes, v.(string))
+			}
+		}
+	}
+	if v := d.Get("instance_type").(string); v != "" && d.NewValueKnown("instance_type") {
+		instanceTypes = append(instanceTypes, v)
+	}
+	return chargeType, zones, instanceTypes
+}
+
+// instanceAttributeUnconfigured reports whether key is known not to be configured.
+func instanceAttributeUnconfigured(d *schema.ResourceDiff, key string) bool {
+	config := d.GetRawConfig()
+	return !config.IsNull() && config.IsKnown() && config.GetAttr(key).IsNull()
+}
+
+// instanceChargeType returns the charge type planned of the instance, which is computed but known
+// to be the default if not configured.
+func instanceChargeType(d *schema.ResourceDiff) (string, bool) {
+	if d.NewValueKnown("instance_charge_type") {
+		if v := d.Get("instance_charge_type").(string); v != "" {
+			return v, true
+		}
+		return CVM_CHARGE_TYPE_POSTPAID, true
+	}
+	if !instanceAttributeUnconfigured(d, "instance_charge_type") {
+		return "", false
+	}
+	return CVM_CHARGE_TYPE_POSTPAID, true
+}
+
+// instanceQuota returns the quota of the instances of the charge type in the zone of the instance.
+func instanceQuota(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+	chargeType, ok := instanceChargeType(d)
+	if !ok || !d.NewValueKnown("availability_zone") {
+		return "", "", nil
+	}
+	zone := d.Get("availability_zone").(string)
+	if zone == "" || (chargeType != CVM_CHARGE_TYPE_POSTPAID && chargeType != CVM_CHARGE_TYPE_PREPAID && chargeType != CVM_CHARGE_TYPE_SPOTPAID) {
+		return "", "", nil
+	}
+
+	cvmService := CvmService{client: client}
+	return "instance:" + chargeType + ":" + zone, fmt.Sprintf("%s instances in %s", chargeType, zone), func(ctx context.Context) (quota.Usage, error) {
+		return cvmService.DescribeInstanceQuotaUsage(ctx, zone, chargeType)
+	}
+}
+
 func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance.create")()
 
@@ -626,18 +777,37 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		rpgFlag = v.(bool)
 	}
 
+	// the instance types are tried in availability_zone, then in each of zone_candidates in order.
+	zoneCandidates := []map[string]interface{}{{
+		"availability_zone": d.Get("availability_zone"),
+		"subnet_id":         d.Get("subnet_id"),
+	}}
+	if v, ok := d.GetOk("zone_candidates"); ok {
+		for _, candidate := range v.([]interface{}) {
+			zoneCandidates = append(zoneCandidates, candidate.(map[string]interface{}))
+		}
+	}
+	attempts := make([]instanceCreateAttempt, 0, len(zoneCandidates)*len(instanceTypeCandidates))
+	for _, zoneCandidate := range zoneCandidates {
+		for _, instanceType := range instanceTypeCandidates {
+			attempts = append(attempts, instanceCreateAttempt{instanceType: instanceType, zoneCandidate: zoneCandidate})
+		}
+	}
+
 	var err error
 	instanceId := ""
-	for _, instanceType := range instanceTypeCandidates {
+	for _, attempt := range attempts {
+		instanceType, zoneCandidate := attempt.instanceType, attempt.zoneCandidate
+		zone := zoneCandidate["availability_zone"].(string)
 		request := cvm.NewRunInstancesRequest()
 		request.InstanceType = helper.String(instanceType)
 		if v, ok := d.GetOk("image_id"); ok {
 			request.ImageId = helper.String(v.(string))
 		}
 
-		if v, ok := d.GetOk("availability_zone"); ok {
+		if zone != "" {
 			request.Placement = &cvm.Placement{
-				Zone: helper.String(v.(string)),
+				Zone: helper.String(zone),
 			}
 		}
 
@@ -775,6 +945,9 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok = d.GetOk("subnet_id"); ok {
 				request.VirtualPrivateCloud.SubnetId = helper.String(v.(string))
 			}
+			if v := zoneCandidate["subnet_id"].(string); v != "" {
+				request.VirtualPrivateCloud.SubnetId = helper.String(v)
+			}
 
 			if v, ok = d.GetOk("private_ip"); ok {
 				request.VirtualPrivateCloud.PrivateIpAddresses = []*string{helper.String(v.(string))}
@@ -1072,11 +1245,22 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		}
 		// 记录成功创建的实例类型
 		_ = d.Set("instance_type", instanceType)
+		// record the zone candidate the instance is created in
+		if zone != "" {
+			_ = d.Set("availability_zone", zone)
+		}
 		break
 	}
 
 	// 如果所有实例类型都资源不足，直接报错
 	if errors.Is(err, cvmResourceInsufficientError) {
+		if len(zoneCandidates) > 1 {
+			zones := make([]string, 0, len(zoneCandidates))
+			for _, zoneCandidate := range zoneCandidates {
+				zones = append(zones, zoneCandidate["availability_zone"].(string))
+			}
+			return fmt.Errorf("cvm 资源不足导致开机失败，已尝试可用区列表：%s，已尝试机型列表：%s", strings.Join(zones, ","), strings.Join(instanceTypeCandidates, ","))
+		}
 		return fmt.Errorf("cvm 资源不足导致开机失败，已尝试机型列表：%s", strings.Join(instanceTypeCandidates, ","))
 	}
 
@@ -1155,6 +1339,15 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// wait for the bootstrap of the instance, return error after the id is set so that the instance is tainted
+	if command, commandType, timeout, interval, ok := instanceReadinessCheckFromResourceData(d); ok {
+		if v, ok := d.GetOkExists("running_flag"); ok && !v.(bool) {
+			log.Printf("[WARN]%s instance %s will be stopped, skip readiness check", logId, instanceId)
+		} else if err = cvmService.WaitForInstancesReady(ctx, []string{instanceId}, command, commandType, timeout, interval); err != nil {
+			return err
+		}
+	}
+
 	if v, ok := d.GetOkExists("running_flag"); ok {
 		if !v.(bool) {
 			stoppedMode := d.Get("stopped_mode").(string)
@@ -10,11 +10,13 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
@@ -25,6 +27,9 @@ func ResourceTencentCloudInstanceSet() *schema.Resource {
 		Read:   resourceTencentCloudInstanceSetRead,
 		Update: resourceTencentCloudInstanceSetUpdate,
 		Delete: resourceTencentCloudInstanceSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudInstanceSetImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(600 * time.Second),
 			Read:   schema.DefaultTimeout(600 * time.Second),
@@ -233,6 +238,7 @@ func ResourceTencentCloudInstanceSet() *schema.Resource {
 				Optional:    true,
 				Description: "CAM role name authorized to access.",
 			},
+			"readiness_check": instanceReadinessCheckSchema(),
 			// Computed values.
 			"instance_status": {
 				Type:        schema.TypeString,
@@ -263,7 +269,43 @@ func ResourceTencentCloudInstanceSet() *schema.Resource {
 				Description: "instance id list.",
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			tccommon.QuotaCheckDiffN(instanceQuota, instanceSetCount),
+			SellableCheckDiff(instanceSetSellable),
+		),
+	}
+}
+
+// instanceSetCount returns the number of the instances of the instance set, checked against the
+// quota of the instances in their zone.
+func instanceSetCount(d *schema.ResourceDiff) int64 {
+	if !d.NewValueKnown("instance_count") {
+		return 0
+	}
+	if v, ok := d.GetOk("instance_count"); ok {
+		return int64(v.(int))
+	}
+	return 1
+}
+
+// instanceSetSellable returns the instance type of the instances in their zone, checked at
+// creation and when they are resized.
+func instanceSetSellable(d *schema.ResourceDiff) (string, []string, []string) {
+	if d.Id() != "" && !d.HasChange("instance_type") {
+		return "", nil, nil
+	}
+	for _, key := range []string{"availability_zone", "instance_type", "instance_charge_type"} {
+		if !d.NewValueKnown(key) {
+			return "", nil, nil
+		}
+	}
+
+	instanceType := d.Get("instance_type").(string)
+	if instanceType == "" {
+		return "", nil, nil
 	}
+	return d.Get("instance_charge_type").(string), []string{d.Get("availability_zone").(string)}, []string{instanceType}
 }
 
 func resourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interface{}) error {
@@ -508,6 +550,16 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	_ = d.Set("instance_ids", instanceIds)
 	d.SetId(helper.StrListToStr(instanceIds))
 
+	// wait for the bootstrap of all instances, return error after the id is set so that the instance set is tainted
+	if command, commandType, timeout, interval, ok := instanceReadinessCheckFromResourceData(d); ok {
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		cvmService := CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = cvmService.WaitForInstancesReady(ctx, helper.PStrings(instanceIds), command, commandType, timeout, interval)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -543,7 +595,11 @@ func doResourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interfac
 
 	_ = d.Set("image_id", instance.ImageId)
 	_ = d.Set("availability_zone", instance.Placement.Zone)
-	_ = d.Set("instance_name", d.Get("instance_name"))
+	if _, ok := d.GetOk("instance_name"); ok {
+		_ = d.Set("instance_name", d.Get("instance_name"))
+	} else {
+		_ = d.Set("instance_name", instance.InstanceName)
+	}
 	_ = d.Set("instance_type", instance.InstanceType)
 	_ = d.Set("project_id", instance.Placement.ProjectId)
 	_ = d.Set("instance_charge_type", instance.InstanceChargeType)
@@ -582,6 +638,20 @@ func doResourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceTencentCloudInstanceSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	instanceIds := compositeid.Split(d.Id())
+	for _, instanceId := range instanceIds {
+		if instanceId == "" {
+			return nil, fmt.Errorf("import id %s is broken, it should be in the format of <instance_id>#<instance_id>...", d.Id())
+		}
+	}
+	_ = d.Set("instance_ids", instanceIds)
+	_ = d.Set("instance_count", len(instanceIds))
+	d.SetId(helper.StrListToStr(helper.StringsStringsPoint(instanceIds)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance_set.update")()
 
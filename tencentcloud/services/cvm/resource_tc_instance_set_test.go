@@ -20,6 +20,12 @@ func TestAccTencentCloudNeedFixInstanceSetResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_instance_set.foo", "instance_ids.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_instance_set.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_instance_set.foo", "instance_ids.0", "instance_ids.1"),
+			},
 		},
 	})
 }
@@ -148,6 +148,36 @@ func TestAccTencentCloudInstanceResource_UserDataRaw(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudInstanceResource_ReadinessCheck(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.AccPreCheck(t)
+		},
+		Providers:    acctest.AccProviders,
+		CheckDestroy: testAccCheckCvmInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmInstanceResource_ReadinessCheck,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCvmInstanceExists("tencentcloud_instance.cvm_readiness_check"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.cvm_readiness_check", "instance_status", "RUNNING"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.cvm_readiness_check", "readiness_check.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.cvm_readiness_check", "readiness_check.0.command_type", "SHELL"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.cvm_readiness_check", "readiness_check.0.timeout", "900"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_instance.cvm_readiness_check",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// readiness_check is not read from the instance, so it is not restored by import.
+				ImportStateVerifyIgnore: []string{"disable_monitor_service", "disable_security_service", "disable_automation_service", "hostname", "password", "force_delete", "readiness_check"},
+			},
+		},
+	})
+}
+
 func TestAccTencentCloudInstanceResource_UserData(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
@@ -395,6 +425,58 @@ resource "tencentcloud_instance" "cvm_user_data_raw" {
 }
 
 `
+const testAccCvmInstanceResource_ReadinessCheck = `
+
+data "tencentcloud_images" "default" {
+    image_type = ["PUBLIC_IMAGE"]
+    image_name_regex = "Final"
+}
+data "tencentcloud_instance_types" "default" {
+    memory_size = 2
+    exclude_sold_out = true
+    
+    filter {
+        name = "instance-family"
+        values = ["S1","S2","S3","S4","S5"]
+    }
+    filter {
+        name = "zone"
+        values = ["ap-guangzhou-7"]
+    }
+    cpu_core_count = 2
+}
+resource "tencentcloud_vpc" "vpc" {
+    name = "cvm-readiness-vpc"
+    cidr_block = "10.0.0.0/16"
+}
+resource "tencentcloud_subnet" "subnet" {
+    availability_zone = "ap-guangzhou-7"
+    vpc_id = tencentcloud_vpc.vpc.id
+    name = "cvm-readiness-subnet"
+    cidr_block = "10.0.0.0/16"
+}
+resource "tencentcloud_instance" "cvm_readiness_check" {
+    instance_name = "tf-test-readiness-check"
+    availability_zone = "ap-guangzhou-7"
+    image_id = data.tencentcloud_images.default.images.0.image_id
+    vpc_id = tencentcloud_vpc.vpc.id
+    instance_type = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+    subnet_id = tencentcloud_subnet.subnet.id
+    system_disk_type = "CLOUD_PREMIUM"
+    project_id = 0
+    user_data_raw = <<-EOT
+#!/bin/bash
+touch /tmp/ready
+EOT
+
+    readiness_check {
+        command  = "cloud-init status --wait; test -f /tmp/ready"
+        timeout  = 900
+        interval = 15
+    }
+}
+`
+
 const testAccCvmInstanceResource_UserDataRawUpdate = `
 
 data "tencentcloud_availability_zones" "default" {
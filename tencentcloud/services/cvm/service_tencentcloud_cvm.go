@@ -2,6 +2,7 @@ package cvm
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"sort"
@@ -16,9 +17,12 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sellable"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -649,6 +653,61 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 	return
 }
 
+// DescribeInstanceTypeOfferings returns the instance types offered in the region with chargeType,
+// fetched once per charge type by the SellableCache of the client.
+func (me *CvmService) DescribeInstanceTypeOfferings(ctx context.Context, chargeType string) (offerings []sellable.Offering, errRet error) {
+	return me.client.SellableCache.Get(ctx, chargeType, func(ctx context.Context) ([]sellable.Offering, error) {
+		items, err := me.DescribeInstancesSellTypeByFilter(ctx, map[string][]string{"instance-charge-type": {chargeType}})
+		if err != nil {
+			return nil, err
+		}
+
+		offerings := make([]sellable.Offering, 0, len(items))
+		for _, item := range items {
+			if item.Zone == nil || item.InstanceType == nil {
+				continue
+			}
+			offering := sellable.Offering{
+				Zone:         *item.Zone,
+				InstanceType: *item.InstanceType,
+				ChargeType:   chargeType,
+				Sellable:     item.Status != nil && *item.Status == CVM_SELL_STATUS,
+			}
+			if item.InstanceFamily != nil {
+				offering.Family = *item.InstanceFamily
+			}
+			if item.Cpu != nil {
+				offering.Cpu = *item.Cpu
+			}
+			if item.Memory != nil {
+				offering.Memory = *item.Memory
+			}
+			if item.Gpu != nil {
+				offering.Gpu = *item.Gpu
+			}
+			offerings = append(offerings, offering)
+		}
+		return offerings, nil
+	})
+}
+
+// CheckInstanceTypesSellable checks that one of instanceTypes is sellable with chargeType in one of
+// zones, or in any zone of the region if zones is empty, suggesting the equivalent sellable instance
+// types if not. The check is skipped for the charge types not sold by zone, and if the offerings
+// fail to be fetched.
+func (me *CvmService) CheckInstanceTypesSellable(ctx context.Context, chargeType string, zones, instanceTypes []string) error {
+	if chargeType != CVM_CHARGE_TYPE_POSTPAID && chargeType != CVM_CHARGE_TYPE_PREPAID && chargeType != CVM_CHARGE_TYPE_SPOTPAID {
+		return nil
+	}
+
+	offerings, err := me.DescribeInstanceTypeOfferings(ctx, chargeType)
+	if err != nil {
+		log.Printf("[WARN] fetch instance types offered with charge type %s failed, skip checking whether they are sellable: %s", chargeType, err.Error())
+		return nil
+	}
+	return sellable.Check(offerings, chargeType, zones, instanceTypes)
+}
+
 func (me *CvmService) DescribeKeyPairById(ctx context.Context, keyId string) (keyPair *cvm.KeyPair, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	request := cvm.NewDescribeKeyPairsRequest()
@@ -1963,3 +2022,267 @@ func flattenInstanceTagsMapping(list []*cvm.Tag) map[string]interface{} {
 	}
 	return result
 }
+
+func (me *CvmService) RunInstancesReadinessCommand(ctx context.Context, instanceIds []string, command, commandType string, timeout uint64) (invocationId string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := tat.NewRunCommandRequest()
+	request.InstanceIds = helper.Strings(instanceIds)
+	request.Content = helper.String(base64.StdEncoding.EncodeToString([]byte(command)))
+	request.CommandType = helper.String(commandType)
+	request.Timeout = helper.Uint64(timeout)
+	request.SaveCommand = helper.Bool(false)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTatClient().RunCommand(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.InvocationId == nil {
+		errRet = fmt.Errorf("TAT RunCommand returned empty invocation id")
+		return
+	}
+
+	invocationId = *response.Response.InvocationId
+	return
+}
+
+func (me *CvmService) DescribeInvocationTasksByInvocationId(ctx context.Context, invocationId string) (tasks []*tat.InvocationTask, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := tat.NewDescribeInvocationTasksRequest()
+	request.Filters = []*tat.Filter{
+		{
+			Name:   helper.String("invocation-id"),
+			Values: []*string{helper.String(invocationId)},
+		},
+	}
+	request.HideOutput = helper.Bool(false)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset uint64 = 0
+		limit  uint64 = 100
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseTatClient().DescribeInvocationTasks(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || response.Response == nil || len(response.Response.InvocationTaskSet) < 1 {
+			break
+		}
+
+		tasks = append(tasks, response.Response.InvocationTaskSet...)
+		if len(response.Response.InvocationTaskSet) < int(limit) {
+			break
+		}
+
+		offset += limit
+	}
+
+	return
+}
+
+// WaitForInstancesReady runs the readiness command through TAT until it exits with code 0 on every instance.
+// Instances which are not ready yet are checked again after `interval`, until `timeout` is reached.
+func (me *CvmService) WaitForInstancesReady(ctx context.Context, instanceIds []string, command, commandType string, timeout, interval time.Duration) error {
+	logId := tccommon.GetLogId(ctx)
+	deadline := time.Now().Add(timeout)
+	pending := instanceIds
+
+	var lastErr error
+	for {
+		remaining := time.Until(deadline)
+		if remaining < time.Second {
+			remaining = time.Second
+		}
+
+		invocationId, err := me.RunInstancesReadinessCommand(ctx, pending, command, commandType, uint64(remaining.Seconds()))
+		if err != nil {
+			// the TAT agent may not be online yet right after the instance is launched
+			lastErr = err
+		} else {
+			var tasks []*tat.InvocationTask
+			err = resource.Retry(remaining, func() *resource.RetryError {
+				result, e := me.DescribeInvocationTasksByInvocationId(ctx, invocationId)
+				if e != nil {
+					return tccommon.RetryError(e, tccommon.InternalError)
+				}
+
+				if len(result) < len(pending) {
+					return resource.RetryableError(fmt.Errorf("waiting for TAT invocation %s tasks to be dispatched", invocationId))
+				}
+
+				for _, task := range result {
+					if task.TaskStatus != nil && tccommon.IsContains(TAT_TASK_PENDING_STATUS, *task.TaskStatus) {
+						return resource.RetryableError(fmt.Errorf("TAT invocation task %s status is %s, retry...", *task.InvocationTaskId, *task.TaskStatus))
+					}
+				}
+
+				tasks = result
+				return nil
+			})
+
+			if err != nil {
+				lastErr = err
+			} else {
+				notReady := make([]string, 0)
+				for _, task := range tasks {
+					if isReadinessTaskSucceeded(task) {
+						continue
+					}
+
+					notReady = append(notReady, *task.InstanceId)
+					lastErr = fmt.Errorf("instance %s is not ready, %s", *task.InstanceId, describeReadinessTaskResult(task))
+				}
+
+				if len(notReady) == 0 {
+					return nil
+				}
+
+				pending = notReady
+			}
+		}
+
+		log.Printf("[WARN]%s instances %v are not ready, reason: %v", logId, pending, lastErr)
+
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("instances %s did not become ready within %s, last error: %v", strings.Join(pending, ","), timeout, lastErr)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+func isReadinessTaskSucceeded(task *tat.InvocationTask) bool {
+	if task.TaskStatus == nil || *task.TaskStatus != TAT_TASK_STATUS_SUCCESS {
+		return false
+	}
+
+	return task.TaskResult != nil && task.TaskResult.ExitCode != nil && *task.TaskResult.ExitCode == 0
+}
+
+func describeReadinessTaskResult(task *tat.InvocationTask) string {
+	var (
+		status   string
+		exitCode int64
+		output   string
+	)
+
+	if task.TaskStatus != nil {
+		status = *task.TaskStatus
+	}
+
+	if task.TaskResult != nil {
+		if task.TaskResult.ExitCode != nil {
+			exitCode = *task.TaskResult.ExitCode
+		}
+
+		if task.TaskResult.Output != nil {
+			if decoded, err := base64.StdEncoding.DecodeString(*task.TaskResult.Output); err == nil {
+				output = string(decoded)
+			} else {
+				output = *task.TaskResult.Output
+			}
+		}
+	}
+
+	if task.ErrorInfo != nil && *task.ErrorInfo != "" {
+		return fmt.Sprintf("task status: %s, error: %s", status, *task.ErrorInfo)
+	}
+
+	return fmt.Sprintf("task status: %s, exit code: %d, output: %s", status, exitCode, output)
+}
+
+// DescribeInstanceQuotaUsage returns the usage of the quota of the instances of chargeType in zone.
+func (me *CvmService) DescribeInstanceQuotaUsage(ctx context.Context, zone, chargeType string) (usage quota.Usage, errRet error) {
+	var (
+		logId     = tccommon.GetLogId(ctx)
+		request   = cvm.NewDescribeAccountQuotaRequest()
+		quotaType string
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	switch chargeType {
+	case CVM_CHARGE_TYPE_POSTPAID:
+		quotaType = "PostPaidQuotaSet"
+	case CVM_CHARGE_TYPE_PREPAID:
+		quotaType = "PrePaidQuotaSet"
+	case CVM_CHARGE_TYPE_SPOTPAID:
+		quotaType = "SpotPaidQuotaSet"
+	default:
+		errRet = fmt.Errorf("no quota of the instances of charge type %s", chargeType)
+		return
+	}
+	request.Filters = []*cvm.Filter{
+		{Name: helper.String("zone"), Values: []*string{helper.String(zone)}},
+		{Name: helper.String("quota-type"), Values: []*string{helper.String(quotaType)}},
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClient().DescribeAccountQuota(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.AccountQuotaOverview == nil || response.Response.AccountQuotaOverview.AccountQuota == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	accountQuota := response.Response.AccountQuotaOverview.AccountQuota
+	switch chargeType {
+	case CVM_CHARGE_TYPE_POSTPAID:
+		for _, item := range accountQuota.PostPaidQuotaSet {
+			if item.Zone != nil && *item.Zone == zone && item.UsedQuota != nil && item.TotalQuota != nil {
+				return quota.Usage{Used: int64(*item.UsedQuota), Limit: int64(*item.TotalQuota)}, nil
+			}
+		}
+	case CVM_CHARGE_TYPE_PREPAID:
+		for _, item := range accountQuota.PrePaidQuotaSet {
+			if item.Zone != nil && *item.Zone == zone && item.UsedQuota != nil && item.TotalQuota != nil {
+				return quota.Usage{Used: int64(*item.UsedQuota), Limit: int64(*item.TotalQuota)}, nil
+			}
+		}
+	case CVM_CHARGE_TYPE_SPOTPAID:
+		for _, item := range accountQuota.SpotPaidQuotaSet {
+			if item.Zone != nil && *item.Zone == zone && item.UsedQuota != nil && item.TotalQuota != nil {
+				return quota.Usage{Used: int64(*item.UsedQuota), Limit: int64(*item.TotalQuota)}, nil
+			}
+		}
+	}
+
+	errRet = fmt.Errorf("no %s of the instances in zone %s", quotaType, zone)
+	return
+}
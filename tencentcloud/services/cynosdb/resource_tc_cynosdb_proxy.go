@@ -23,6 +23,9 @@ func ResourceTencentCloudCynosdbProxy() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbProxyRead,
 		Update: resourceTencentCloudCynosdbProxyUpdate,
 		Delete: resourceTencentCloudCynosdbProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -310,6 +313,7 @@ func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface
 	}
 
 	if proxy != nil {
+		_ = d.Set("cluster_id", clusterId)
 		proxyGroupRwInfo := proxy.ProxyGroupInfos[0]
 		connectionPool := proxyGroupRwInfo.ConnectionPool
 		if connectionPool != nil {
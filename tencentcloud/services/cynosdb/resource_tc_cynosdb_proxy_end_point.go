@@ -22,6 +22,9 @@ func ResourceTencentCloudCynosdbProxyEndPoint() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbProxyEndPointRead,
 		Update: resourceTencentCloudCynosdbProxyEndPointUpdate,
 		Delete: resourceTencentCloudCynosdbProxyEndPointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -37,6 +37,11 @@ func TestAccTencentCloudCynosdbProxyEndPointResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy_end_point.proxy_end_point", "id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cynosdb_proxy_end_point.proxy_end_point",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
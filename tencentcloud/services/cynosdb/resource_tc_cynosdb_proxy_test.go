@@ -57,6 +57,11 @@ func TestAccTencentCloudCynosdbProxyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy.proxy", "description"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cynosdb_proxy.proxy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
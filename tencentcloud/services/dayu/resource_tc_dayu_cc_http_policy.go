@@ -23,6 +23,9 @@ func ResourceTencentCloudDayuCCHttpPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuCCHttpPolicyRead,
 		Update: resourceTencentCloudDayuCCHttpPolicyUpdate,
 		Delete: resourceTencentCloudDayuCCHttpPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -234,6 +237,8 @@ func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta inter
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("create_time", policy.CreateTime)
 	_ = d.Set("smode", policy.Smode)
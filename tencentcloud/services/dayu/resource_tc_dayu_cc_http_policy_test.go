@@ -48,6 +48,11 @@ func TestAccTencentCloudDayuCCHttpPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuCCHttpPolicyResourceKey, "frequency", "100"),
 				),
 			},
+			{
+				ResourceName:      testDayuCCHttpPolicyResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
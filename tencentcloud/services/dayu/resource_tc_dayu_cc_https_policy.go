@@ -21,6 +21,9 @@ func ResourceTencentCloudDayuCCHttpsPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuCCHttpsPolicyRead,
 		Update: resourceTencentCloudDayuCCHttpsPolicyUpdate,
 		Delete: resourceTencentCloudDayuCCHttpsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -213,6 +216,8 @@ func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta inte
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("create_time", policy.CreateTime)
 	_ = d.Set("policy_id", policy.SetId)
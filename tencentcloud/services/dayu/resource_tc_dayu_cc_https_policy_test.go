@@ -51,6 +51,11 @@ func TestAccTencentCloudDayuCCHttpsPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuCCHttpsPolicyResourceKey, "rule_list.#", "1"),
 				),
 			},
+			{
+				ResourceName:      testDayuCCHttpsPolicyResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
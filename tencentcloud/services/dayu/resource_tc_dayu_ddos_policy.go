@@ -19,6 +19,9 @@ func ResourceTencentCloudDayuDdosPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyRead,
 		Update: resourceTencentCloudDayuDdosPolicyUpdate,
 		Delete: resourceTencentCloudDayuDdosPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -486,6 +489,7 @@ func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interfa
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
 	_ = d.Set("drop_options", flattenDdosDropOptionList([]*dayu.DDoSPolicyDropOption{ddosPolicy.DropOptions}))
 	_ = d.Set("port_filters", flattenDdosPortLimitList(ddosPolicy.PortLimits))
 	_ = d.Set("packet_filters", flattenDdosPacketFilterList(ddosPolicy.PacketFilters))
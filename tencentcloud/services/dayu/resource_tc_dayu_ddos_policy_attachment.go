@@ -4,20 +4,30 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// dayuDdosPolicyAttachmentIdFormat is the id of an attachment, which is also its import id.
+var dayuDdosPolicyAttachmentIdFormat = compositeid.New(
+	compositeid.String("resource_id"),
+	compositeid.String("resource_type"),
+	compositeid.String("policy_id"),
+)
+
 func ResourceTencentCloudDayuDdosPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDayuDdosPolicyAttachmentCreate,
 		Read:   resourceTencentCloudDayuDdosPolicyAttachmentRead,
 		Delete: resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: dayuDdosPolicyAttachmentIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -105,7 +115,7 @@ func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData,
 		return fmt.Errorf("Create DDoS policy attachment faild")
 	}
 
-	d.SetId(resourceId + tccommon.FILED_SP + resourceType + tccommon.FILED_SP + policyId)
+	d.SetId(dayuDdosPolicyAttachmentIdFormat.Join(resourceId, resourceType, policyId))
 
 	return resourceTencentCloudDayuDdosPolicyAttachmentRead(d, meta)
 }
@@ -117,13 +127,13 @@ func resourceTencentCloudDayuDdosPolicyAttachmentRead(d *schema.ResourceData, me
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(items) < 3 {
-		return fmt.Errorf("broken ID of DDoS policy attachment")
+	id, err := dayuDdosPolicyAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	resourceId := items[0]
-	resourceType := items[1]
-	policyId := items[2]
+	resourceId := id.Get("resource_id")
+	resourceType := id.Get("resource_type")
+	policyId := id.Get("policy_id")
 
 	dayuService := DayuService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
@@ -160,19 +170,19 @@ func resourceTencentCloudDayuDdosPolicyAttachmentDelete(d *schema.ResourceData,
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(items) < 3 {
-		return fmt.Errorf("broken ID of DDoS policy attachment")
+	id, err := dayuDdosPolicyAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	resourceId := items[0]
-	resourceType := items[1]
-	policyId := items[2]
+	resourceId := id.Get("resource_id")
+	resourceType := id.Get("resource_type")
+	policyId := id.Get("policy_id")
 
 	dayuService := DayuService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		e := dayuService.UnbindDdosPolicy(ctx, resourceId, resourceType, policyId)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -29,6 +29,11 @@ func TestAccTencentCloudDayuDdosPolicyAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_dayu_ddos_policy_attachment.dayu_ddos_policy_attachment_basic", "policy_id"),
 					resource.TestCheckResourceAttrSet("tencentcloud_dayu_ddos_policy_attachment.dayu_ddos_policy_attachment_basic", "resource_type")),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_ddos_policy_attachment.dayu_ddos_policy_attachment_basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -23,6 +23,9 @@ func ResourceTencentCloudDayuDdosPolicyCase() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyCaseRead,
 		Update: resourceTencentCloudDayuDdosPolicyCaseUpdate,
 		Delete: resourceTencentCloudDayuDdosPolicyCaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -325,6 +328,7 @@ func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta int
 	for _, record := range ddosPolicyCase.Record {
 		key := *record.Key
 		if key == "CaseName" {
+			_ = d.Set("resource_type", resourceType)
 			_ = d.Set("name", record.Value)
 		}
 		if key == "HasInitiateTcp" {
@@ -81,6 +81,11 @@ func TestAccTencentCloudDayuDdosPolicyCaseResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuDdosPolicyCaseResourceKey, "max_udp_package_len", "1100"),
 				),
 			},
+			{
+				ResourceName:      testDayuDdosPolicyCaseResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,6 +21,9 @@ func ResourceTencentCloudDayuL4Rule() *schema.Resource {
 		Read:   resourceTencentCloudDayuL4RuleRead,
 		Update: resourceTencentCloudDayuL4RuleUpdate,
 		Delete: resourceTencentCloudDayuL4RuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -466,6 +469,8 @@ func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("s_port", int(*rule.SourcePort))
 	_ = d.Set("d_port", int(*rule.VirtualPort))
@@ -71,6 +71,11 @@ func TestAccTencentCloudDayuL4RuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL4RuleResourceKey, "session_time", "30"),
 				),
 			},
+			{
+				ResourceName:      testDayuL4RuleResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -20,6 +20,9 @@ func ResourceTencentCloudDayuL7Rule() *schema.Resource {
 		Read:   resourceTencentCloudDayuL7RuleRead,
 		Update: resourceTencentCloudDayuL7RuleUpdate,
 		Delete: resourceTencentCloudDayuL7RuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -496,6 +499,8 @@ func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("domain", rule.Domain)
 	_ = d.Set("rule_id", rule.RuleId)
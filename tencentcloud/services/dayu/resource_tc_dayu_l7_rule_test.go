@@ -62,6 +62,11 @@ func TestAccTencentCloudDayuL7RuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "protocol", "http"),
 				),
 			},
+			{
+				ResourceName:      testDayuL7RuleResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
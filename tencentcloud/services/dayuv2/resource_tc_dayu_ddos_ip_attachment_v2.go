@@ -23,6 +23,9 @@ func ResourceTencentCloudDayuDDosIpAttachmentV2() *schema.Resource {
 		Create: resourceTencentCloudDayuDDosIpAttachmentCreateV2,
 		Read:   resourceTencentCloudDayuDDosIpAttachmentReadV2,
 		Delete: resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bgp_instance_id": {
 				Required:    true,
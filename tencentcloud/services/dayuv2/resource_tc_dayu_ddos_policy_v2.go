@@ -21,6 +21,9 @@ func ResourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyV2Read,
 		Update: resourceTencentCloudDayuDdosPolicyV2Update,
 		Delete: resourceTencentCloudDayuDdosPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -724,6 +727,8 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 	}
 	ddosLevel := protectThresholdRelation.DDoSLevel
 	ddosThreshold := protectThresholdRelation.DDoSThreshold
+	_ = d.Set("resource_id", instanceId)
+	_ = d.Set("business", items[1])
 	_ = d.Set("ddos_level", ddosLevel)
 	_ = d.Set("ddos_threshold", ddosThreshold)
 
@@ -33,6 +33,11 @@ func TestAccTencentCloudDayuDdosPolicyV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_ddos_policy_v2.test_policy", "protocol_block_config.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_ddos_policy_v2.test_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
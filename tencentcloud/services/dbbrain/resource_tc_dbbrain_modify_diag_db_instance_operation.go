@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDbbrainModifyDiagDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate,
 		Read:   resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead,
 		Delete: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationDelete,
@@ -65,7 +66,7 @@ func ResourceTencentCloudDbbrainModifyDiagDbInstanceOperation() *schema.Resource
 				Description: "Specifies the ID of the instance whose inspection status is changed.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -112,6 +113,7 @@ func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(d *schema.Re
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
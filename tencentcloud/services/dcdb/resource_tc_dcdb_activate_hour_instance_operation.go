@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDcdbActivateHourInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDcdbActivateHourInstanceOperationCreate,
 		Read:   resourceTencentCloudDcdbActivateHourInstanceOperationRead,
 		Delete: resourceTencentCloudDcdbActivateHourInstanceOperationDelete,
@@ -25,7 +26,7 @@ func ResourceTencentCloudDcdbActivateHourInstanceOperation() *schema.Resource {
 				Description: "instance ID in the format of dcdbt-ow728lmc, which can be obtained through the `DescribeDCDBInstances` API.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -49,6 +50,7 @@ func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
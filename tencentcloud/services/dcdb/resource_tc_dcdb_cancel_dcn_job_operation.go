@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDcdbCancelDcnJobOperationCreate,
 		Read:   resourceTencentCloudDcdbCancelDcnJobOperationRead,
 		Delete: resourceTencentCloudDcdbCancelDcnJobOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
 				Description: "Instance ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -52,6 +53,7 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		flowId = result.Response.FlowId
 		return nil
@@ -63,7 +65,7 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 
 	// need to wait flow success
 	// 0:success; 1:failed, 2:running
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDcdbFlushBinlogOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDcdbFlushBinlogOperationCreate,
 		Read:   resourceTencentCloudDcdbFlushBinlogOperationRead,
 		Delete: resourceTencentCloudDcdbFlushBinlogOperationDelete,
@@ -25,7 +26,7 @@ func ResourceTencentCloudDcdbFlushBinlogOperation() *schema.Resource {
 				Description: "Instance ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDcdbFlushBinlogOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -49,6 +50,7 @@ func resourceTencentCloudDcdbFlushBinlogOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
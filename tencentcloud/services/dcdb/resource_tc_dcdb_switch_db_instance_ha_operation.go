@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate,
 		Read:   resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead,
 		Delete: resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete,
@@ -33,7 +34,7 @@ func ResourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
 				Description: "Target AZ. The node with the lowest delay in the target AZ will be automatically promoted to primary node.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		flowId = result.Response.FlowId
 		return nil
@@ -75,7 +77,7 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 	if flowId != nil {
 		// need to wait init operation success
 		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
@@ -2,21 +2,26 @@ package dcg
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// dcGatewayCcnRouteIdFormat is the id of a route, which is also its import id.
+var dcGatewayCcnRouteIdFormat = compositeid.New(compositeid.String("dcg_id"), compositeid.String("route_id"))
+
 func ResourceTencentCloudDcGatewayCcnRouteInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDcGatewayCcnRouteCreate,
 		Read:   resourceTencentCloudDcGatewayCcnRouteRead,
 		Delete: resourceTencentCloudDcGatewayCcnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: dcGatewayCcnRouteIdFormat.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
@@ -65,7 +70,7 @@ func resourceTencentCloudDcGatewayCcnRouteCreate(d *schema.ResourceData, meta in
 		return err
 	}
 
-	d.SetId(dcgId + "#" + routeId)
+	d.SetId(dcGatewayCcnRouteIdFormat.Join(dcgId, routeId))
 
 	// add sleep protect, either network_instance_id will be set "".
 	time.Sleep(1 * time.Second)
@@ -82,13 +87,13 @@ func resourceTencentCloudDcGatewayCcnRouteRead(d *schema.ResourceData, meta inte
 
 	service := VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	items := strings.Split(d.Id(), "#")
-	if len(items) != 2 {
-		return fmt.Errorf("id of resource.tencentcloud_dc_gateway_ccn_route is wrong")
+	id, err := dcGatewayCcnRouteIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	dcgId, routeId := items[0], items[1]
-	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+	dcgId, routeId := id.Get("dcg_id"), id.Get("route_id")
+	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeDirectConnectGatewayCcnRoute(ctx, dcgId, routeId)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -118,12 +123,12 @@ func resourceTencentCloudDcGatewayCcnRouteDelete(d *schema.ResourceData, meta in
 
 	service := VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	items := strings.Split(d.Id(), "#")
-	if len(items) != 2 {
-		return fmt.Errorf("id of resource.tencentcloud_dc_gateway_ccn_route is wrong")
+	id, err := dcGatewayCcnRouteIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	dcgId, routeId := items[0], items[1]
+	dcgId, routeId := id.Get("dcg_id"), id.Get("route_id")
 	_, has, err := service.DescribeDirectConnectGatewayCcnRoute(ctx, dcgId, routeId)
 	if err != nil {
 		return err
@@ -37,6 +37,11 @@ func TestAccTencentCloudDcgV3RouteBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(rKey, "as_path.#"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dc_gateway_ccn_route.route",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,9 @@ func ResourceTencentCloudDlcAttachDataMaskPolicy() *schema.Resource {
 		Create: resourceTencentCloudDlcAttachDataMaskPolicyCreate,
 		Read:   resourceTencentCloudDlcAttachDataMaskPolicyRead,
 		Delete: resourceTencentCloudDlcAttachDataMaskPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"data_mask_strategy_policy_set": {
 				Type:        schema.TypeList,
@@ -23,6 +23,11 @@ func TestAccTencentCloudDlcAttachDataMaskPolicyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_dlc_attach_data_mask_policy.example", "data_mask_strategy_policy_set.#"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dlc_attach_data_mask_policy.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcAttachWorkGroupPolicyOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcAttachWorkGroupPolicyOperationCreate,
 		Read:   resourceTencentCloudDlcAttachWorkGroupPolicyOperationRead,
 		Delete: resourceTencentCloudDlcAttachWorkGroupPolicyOperationDelete,
@@ -121,7 +122,7 @@ func ResourceTencentCloudDlcAttachWorkGroupPolicyOperation() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcAttachWorkGroupPolicyOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -221,6 +222,7 @@ func resourceTencentCloudDlcAttachWorkGroupPolicyOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcDetachWorkGroupPolicyOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcDetachWorkGroupPolicyOperationCreate,
 		Read:   resourceTencentCloudDlcDetachWorkGroupPolicyOperationRead,
 		Delete: resourceTencentCloudDlcDetachWorkGroupPolicyOperationDelete,
@@ -121,7 +122,7 @@ func ResourceTencentCloudDlcDetachWorkGroupPolicyOperation() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcDetachWorkGroupPolicyOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -221,6 +222,7 @@ func resourceTencentCloudDlcDetachWorkGroupPolicyOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcModifyUserTypOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcModifyUserTypOperationCreate,
 		Read:   resourceTencentCloudDlcModifyUserTypOperationRead,
 		Delete: resourceTencentCloudDlcModifyUserTypOperationDelete,
@@ -32,7 +33,7 @@ func ResourceTencentCloudDlcModifyUserTypOperation() *schema.Resource {
 				Description: "Types that users modify. ADMIN: administrators; COMMON: general users.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcModifyUserTypOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -60,6 +61,7 @@ func resourceTencentCloudDlcModifyUserTypOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
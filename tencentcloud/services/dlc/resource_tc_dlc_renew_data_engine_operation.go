@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcRenewDataEngineOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcRenewDataEngineCreate,
 		Read:   resourceTencentCloudDlcRenewDataEngineRead,
 		Delete: resourceTencentCloudDlcRenewDataEngineDelete,
@@ -53,7 +54,7 @@ func ResourceTencentCloudDlcRenewDataEngineOperation() *schema.Resource {
 				Description: "Auto-renewal flag: 0 means the initial status, and there is no automatic renewal by default. If the user has the privilege to retain services with prepayment, there will be an automatic renewal. 1 means that there is an automatic renewal. 2 means that there is surely no automatic renewal. If it is not specified, the parameter is 0 by default.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcRenewDataEngineCreate(d *schema.ResourceData, meta interface{}) error {
@@ -93,6 +94,7 @@ func resourceTencentCloudDlcRenewDataEngineCreate(d *schema.ResourceData, meta i
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
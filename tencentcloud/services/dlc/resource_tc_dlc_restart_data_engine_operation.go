@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDlcRestartDataEngineOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcRestartDataEngineCreateOperation,
 		Read:   resourceTencentCloudDlcRestartDataEngineReadOperation,
 		Delete: resourceTencentCloudDlcRestartDataEngineDeleteOperation,
@@ -36,7 +37,7 @@ func ResourceTencentCloudDlcRestartDataEngineOperation() *schema.Resource {
 				Description: "Whether to restart by force and ignore tasks.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcRestartDataEngineCreateOperation(d *schema.ResourceData, meta interface{}) error {
@@ -65,6 +66,7 @@ func resourceTencentCloudDlcRestartDataEngineCreateOperation(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -76,7 +78,7 @@ func resourceTencentCloudDlcRestartDataEngineCreateOperation(d *schema.ResourceD
 	}
 
 	d.SetId(dataEngineId)
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
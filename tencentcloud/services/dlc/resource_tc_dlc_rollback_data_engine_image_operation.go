@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcRollbackDataEngineImageOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcRollbackDataEngineImageCreateOperation,
 		Read:   resourceTencentCloudDlcRollbackDataEngineImageReadOperation,
 		Delete: resourceTencentCloudDlcRollbackDataEngineImageDeleteOperation,
@@ -39,7 +40,7 @@ func ResourceTencentCloudDlcRollbackDataEngineImageOperation() *schema.Resource
 				Description: "ToRecordId parameters returned by the API for checking the availability of rolling back.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcRollbackDataEngineImageCreateOperation(d *schema.ResourceData, meta interface{}) error {
@@ -71,6 +72,7 @@ func resourceTencentCloudDlcRollbackDataEngineImageCreateOperation(d *schema.Res
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
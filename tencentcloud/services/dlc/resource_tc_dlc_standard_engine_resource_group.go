@@ -19,6 +19,9 @@ func ResourceTencentCloudDlcStandardEngineResourceGroup() *schema.Resource {
 		Read:   resourceTencentCloudDlcStandardEngineResourceGroupRead,
 		Update: resourceTencentCloudDlcStandardEngineResourceGroupUpdate,
 		Delete: resourceTencentCloudDlcStandardEngineResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"engine_resource_group_name": {
 				Type:        schema.TypeString,
@@ -46,6 +46,11 @@ func TestAccTencentCloudDlcStandardEngineResourceGroupResource_basic(t *testing.
 					resource.TestCheckResourceAttrSet("tencentcloud_dlc_standard_engine_resource_group.example", "spark_size"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dlc_standard_engine_resource_group.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -20,6 +20,9 @@ func ResourceTencentCloudDlcStoreLocationConfig() *schema.Resource {
 		Read:   resourceTencentCloudDlcStoreLocationConfigRead,
 		Update: resourceTencentCloudDlcStoreLocationConfigUpdate,
 		Delete: resourceTencentCloudDlcStoreLocationConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"store_location": {
 				Type:        schema.TypeString,
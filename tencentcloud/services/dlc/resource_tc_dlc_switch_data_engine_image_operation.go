@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDlcSwitchDataEngineImageOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcSwitchDataEngineImageOperationCreate,
 		Read:   resourceTencentCloudDlcSwitchDataEngineImageOperationRead,
 		Delete: resourceTencentCloudDlcSwitchDataEngineImageOperationDelete,
@@ -33,7 +34,7 @@ func ResourceTencentCloudDlcSwitchDataEngineImageOperation() *schema.Resource {
 				Description: "New image version ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcSwitchDataEngineImageOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -62,6 +63,7 @@ func resourceTencentCloudDlcSwitchDataEngineImageOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -73,7 +75,7 @@ func resourceTencentCloudDlcSwitchDataEngineImageOperationCreate(d *schema.Resou
 	}
 
 	d.SetId(dataEngineId)
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDlcUpdateRowFilterOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcUpdateRowFilterOperationCreate,
 		Read:   resourceTencentCloudDlcUpdateRowFilterOperationRead,
 		Delete: resourceTencentCloudDlcUpdateRowFilterOperationDelete,
@@ -122,7 +123,7 @@ func ResourceTencentCloudDlcUpdateRowFilterOperation() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcUpdateRowFilterOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -218,6 +219,7 @@ func resourceTencentCloudDlcUpdateRowFilterOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
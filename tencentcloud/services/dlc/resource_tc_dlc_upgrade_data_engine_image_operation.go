@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDlcUpgradeDataEngineImageOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate,
 		Read:   resourceTencentCloudDlcUpgradeDataEngineImageOperationRead,
 		Delete: resourceTencentCloudDlcUpgradeDataEngineImageOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudDlcUpgradeDataEngineImageOperation() *schema.Resource {
 				Description: "Engine ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -51,6 +52,7 @@ func resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
@@ -62,7 +64,7 @@ func resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate(d *schema.Reso
 	}
 
 	d.SetId(dataEngineId)
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
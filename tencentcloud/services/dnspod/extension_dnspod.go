@@ -19,3 +19,13 @@ var DNSPOD_DOMAIN_STATUS_TYPE = []string{
 	DNSPOD_DOMAIN_STATUS_ENABLE,
 	DNSPOD_DOMAIN_STATUS_DISABLE,
 }
+
+const (
+	DNSPOD_ZONE_DEFAULT_RECORD_LINE = "默认"
+	// zone file comments starting with the prefix set DNSPod specific attributes of a record.
+	DNSPOD_ZONE_DIRECTIVE_PREFIX = "dnspod:"
+)
+
+var DNSPOD_ZONE_RECORD_TYPES = []string{
+	"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SRV", "CAA", "PTR", "SPF", "HTTPS", "SVCB",
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"log"
 
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudDnspodDownloadSnapshotOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDnspodDownloadSnapshotOperationCreate,
 		Read:   resourceTencentCloudDnspodDownloadSnapshotOperationRead,
 		Delete: resourceTencentCloudDnspodDownloadSnapshotOperationDelete,
@@ -40,7 +41,7 @@ func ResourceTencentCloudDnspodDownloadSnapshotOperation() *schema.Resource {
 				Description: "Snapshot download url.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDnspodDownloadSnapshotOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -72,6 +73,7 @@ func resourceTencentCloudDnspodDownloadSnapshotOperationCreate(d *schema.Resourc
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
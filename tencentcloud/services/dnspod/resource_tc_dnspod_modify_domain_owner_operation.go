@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudDnspodModifyDomainOwnerOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDnspodModifyDomainOwnerOperationCreate,
 		Read:   resourceTencentCloudDnspodModifyDomainOwnerOperationRead,
 		Delete: resourceTencentCloudDnspodModifyDomainOwnerOperationDelete,
@@ -39,7 +40,7 @@ func ResourceTencentCloudDnspodModifyDomainOwnerOperation() *schema.Resource {
 				Description: "Domain ID. The parameter DomainId has a higher priority than the parameter Domain. If the parameter DomainId is passed, the parameter Domain will be ignored. You can find all Domains and DomainIds through the DescribeDomainList interface.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDnspodModifyDomainOwnerOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -71,6 +72,7 @@ func resourceTencentCloudDnspodModifyDomainOwnerOperationCreate(d *schema.Resour
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDnspodModifyRecordGroupOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDnspodModifyRecordGroupOperationCreate,
 		Read:   resourceTencentCloudDnspodModifyRecordGroupOperationRead,
 		Delete: resourceTencentCloudDnspodModifyRecordGroupOperationDelete,
@@ -47,7 +48,7 @@ func ResourceTencentCloudDnspodModifyRecordGroupOperation() *schema.Resource {
 				Description: "Domain ID. The parameter DomainId has a higher priority than the parameter Domain. If the parameter DomainId is passed, the parameter Domain will be ignored. You can find all Domains and DomainIds through the DescribeDomainList interface.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDnspodModifyRecordGroupOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -85,6 +86,7 @@ func resourceTencentCloudDnspodModifyRecordGroupOperationCreate(d *schema.Resour
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -0,0 +1,760 @@
+package dnspod
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/zonefile"
+)
+
+func ResourceTencentCloudDnspodZone() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceTencentCloudDnspodZoneCreate,
+		Read:          resourceTencentCloudDnspodZoneRead,
+		Update:        resourceTencentCloudDnspodZoneUpdate,
+		Delete:        resourceTencentCloudDnspodZoneDelete,
+		CustomizeDiff: resourceTencentCloudDnspodZoneCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Domain.",
+			},
+			"zone_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "records"},
+				Description:  "Zone file in the RFC 1035 master file format, `$ORIGIN` defaults to `domain`. SOA records and NS records of the domain itself are skipped. A comment such as `; dnspod:line=<line> dnspod:weight=10 dnspod:status=DISABLE` sets the line, weight and status of the record on its line.",
+			},
+			"records": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "records"},
+				Description:  "Records of the domain, in place of `zone_file`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sub_domain": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "@",
+							Description: "The host records, default value is `@`.",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The record type.",
+						},
+						"record_line": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The record line, default is `default_record_line`.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The record value.",
+						},
+						"mx": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "MX priority, valid when the record type is MX, range 1-20.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "TTL, default is `default_ttl`.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Weight information. An integer from 1 to 100.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ENABLE",
+							ValidateFunc: tccommon.ValidateAllowedStringValue([]string{"ENABLE", "DISABLE"}),
+							Description:  "Records the state, `ENABLE` or `DISABLE`. Default is `ENABLE`.",
+						},
+					},
+				},
+			},
+			"default_record_line": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     DNSPOD_ZONE_DEFAULT_RECORD_LINE,
+				Description: "The record line of the records without one, default is the default line of DnsPod.",
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: tccommon.ValidateIntegerInRange(1, 604800),
+				Description:  "TTL of the records without one, and of the zone file without `$TTL`. Default is 600.",
+			},
+			"ignore": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Records managed elsewhere, which are neither changed nor deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sub_domain": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "Pattern of the host records, such as `_acme-challenge*`. Default is `*`.",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The record type, all types if empty.",
+						},
+					},
+				},
+			},
+			"record_set": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Records of the domain managed by the resource. The plan shows every record added, changed and deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sub_domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host records.",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record type.",
+						},
+						"record_line": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record line.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record value.",
+						},
+						"mx": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "MX priority.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Weight.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Records the state.",
+						},
+					},
+				},
+			},
+			"record_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ids of the records managed by the resource, keyed by `sub_domain#record_type#record_line#value`.",
+			},
+		},
+	}
+}
+
+type dnspodZoneRecord struct {
+	recordId   uint64
+	subDomain  string
+	recordType string
+	recordLine string
+	value      string
+	mx         uint64
+	ttl        uint64
+	weight     uint64
+	status     string
+}
+
+// rrset identifies the records sharing the host, type and line.
+func (r *dnspodZoneRecord) rrset() string {
+	return strings.Join([]string{strings.ToLower(r.subDomain), r.recordType, r.recordLine}, tccommon.FILED_SP)
+}
+
+func (r *dnspodZoneRecord) key() string {
+	return r.rrset() + tccommon.FILED_SP + r.value
+}
+
+func (r *dnspodZoneRecord) sameAttributes(other *dnspodZoneRecord) bool {
+	return r.mx == other.mx && r.ttl == other.ttl && r.weight == other.weight && r.status == other.status
+}
+
+func (r *dnspodZoneRecord) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"sub_domain":  r.subDomain,
+		"record_type": r.recordType,
+		"record_line": r.recordLine,
+		"value":       r.value,
+		"mx":          int(r.mx),
+		"ttl":         int(r.ttl),
+		"weight":      int(r.weight),
+		"status":      r.status,
+	}
+}
+
+// normalizeDnspodRecordValue makes the values of DNSPod and of the configuration comparable.
+func normalizeDnspodRecordValue(recordType, value string) string {
+	value = strings.TrimSpace(value)
+	switch recordType {
+	case "CNAME", "NS", "PTR", "MX":
+		return zonefile.Fqdn(value)
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) == 4 {
+			fields[3] = zonefile.Fqdn(fields[3])
+		}
+		return strings.Join(fields, " ")
+	case "TXT", "SPF":
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+type dnspodZoneGetter interface {
+	Get(key string) interface{}
+}
+
+type dnspodZoneIgnore struct {
+	subDomain  string
+	recordType string
+}
+
+func dnspodZoneIgnores(d dnspodZoneGetter) []dnspodZoneIgnore {
+	var ignores []dnspodZoneIgnore
+	for _, item := range d.Get("ignore").([]interface{}) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ignores = append(ignores, dnspodZoneIgnore{
+			subDomain:  strings.ToLower(m["sub_domain"].(string)),
+			recordType: strings.ToUpper(m["record_type"].(string)),
+		})
+	}
+	return ignores
+}
+
+func dnspodZoneIgnored(ignores []dnspodZoneIgnore, record *dnspodZoneRecord) bool {
+	for _, ignore := range ignores {
+		if ignore.recordType != "" && ignore.recordType != record.recordType {
+			continue
+		}
+		if matched, _ := path.Match(ignore.subDomain, strings.ToLower(record.subDomain)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// dnspodZoneDesiredRecords returns the records of the configuration, except the ignored ones.
+func dnspodZoneDesiredRecords(d dnspodZoneGetter) ([]*dnspodZoneRecord, error) {
+	var (
+		domain      = d.Get("domain").(string)
+		defaultLine = d.Get("default_record_line").(string)
+		defaultTTL  = uint64(d.Get("default_ttl").(int))
+		records     []*dnspodZoneRecord
+	)
+
+	if content := d.Get("zone_file").(string); content != "" {
+		var err error
+		if records, err = dnspodZoneFileRecords(content, domain, defaultLine, defaultTTL); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, item := range d.Get("records").([]interface{}) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			record := &dnspodZoneRecord{
+				subDomain:  m["sub_domain"].(string),
+				recordType: strings.ToUpper(m["record_type"].(string)),
+				recordLine: m["record_line"].(string),
+				mx:         uint64(m["mx"].(int)),
+				ttl:        uint64(m["ttl"].(int)),
+				weight:     uint64(m["weight"].(int)),
+				status:     m["status"].(string),
+			}
+			record.value = normalizeDnspodRecordValue(record.recordType, m["value"].(string))
+			if record.recordLine == "" {
+				record.recordLine = defaultLine
+			}
+			if record.ttl == 0 {
+				record.ttl = defaultTTL
+			}
+			records = append(records, record)
+		}
+	}
+
+	ignores := dnspodZoneIgnores(d)
+	desired := make([]*dnspodZoneRecord, 0, len(records))
+	seen := make(map[string]bool, len(records))
+	for _, record := range records {
+		if dnspodZoneIgnored(ignores, record) {
+			continue
+		}
+		if seen[record.key()] {
+			return nil, fmt.Errorf("%s record %s with value %q on line %s is duplicated", record.recordType, record.subDomain, record.value, record.recordLine)
+		}
+		seen[record.key()] = true
+		desired = append(desired, record)
+	}
+	return desired, nil
+}
+
+// dnspodZoneFileRecords maps the records of a zone file to DNSPod records.
+func dnspodZoneFileRecords(content, domain, defaultLine string, defaultTTL uint64) ([]*dnspodZoneRecord, error) {
+	origin := zonefile.Fqdn(domain)
+	rrs, err := zonefile.Parse(content, origin, uint32(defaultTTL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zone_file: %v", err)
+	}
+
+	records := make([]*dnspodZoneRecord, 0, len(rrs))
+	for _, rr := range rrs {
+		// SOA and NS records of the domain are managed by DNSPod.
+		if rr.Type == "SOA" || rr.Type == "NS" && rr.Name == origin {
+			continue
+		}
+		if !helper.StringsContain(DNSPOD_ZONE_RECORD_TYPES, rr.Type) {
+			return nil, fmt.Errorf("zone_file line %d: record type %s is not supported by DNSPod", rr.Line, rr.Type)
+		}
+
+		record := &dnspodZoneRecord{
+			recordType: rr.Type,
+			recordLine: defaultLine,
+			ttl:        uint64(rr.TTL),
+			status:     "ENABLE",
+		}
+
+		switch {
+		case rr.Name == origin:
+			record.subDomain = "@"
+		case strings.HasSuffix(rr.Name, "."+origin):
+			record.subDomain = strings.TrimSuffix(rr.Name, "."+origin)
+		default:
+			return nil, fmt.Errorf("zone_file line %d: %s is out of the zone %s", rr.Line, rr.Name, origin)
+		}
+
+		switch rr.Type {
+		case "MX":
+			record.mx, _ = strconv.ParseUint(rr.Data[0], 10, 64)
+			record.value = rr.Data[1]
+		case "TXT", "SPF":
+			record.value = strings.Join(rr.Data, "")
+		case "CAA":
+			record.value = fmt.Sprintf("%s %s %q", rr.Data[0], rr.Data[1], rr.Data[2])
+		default:
+			record.value = strings.Join(rr.Data, " ")
+		}
+		record.value = normalizeDnspodRecordValue(record.recordType, record.value)
+
+		if err := record.applyDirectives(rr.Comment); err != nil {
+			return nil, fmt.Errorf("zone_file line %d: %v", rr.Line, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// applyDirectives applies the `dnspod:key=value` directives in the comment of a record.
+func (r *dnspodZoneRecord) applyDirectives(comment string) error {
+	for _, field := range strings.Fields(comment) {
+		if !strings.HasPrefix(field, DNSPOD_ZONE_DIRECTIVE_PREFIX) {
+			continue
+		}
+		kv := strings.SplitN(strings.TrimPrefix(field, DNSPOD_ZONE_DIRECTIVE_PREFIX), "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return fmt.Errorf("invalid directive %q, expect %skey=value", field, DNSPOD_ZONE_DIRECTIVE_PREFIX)
+		}
+		switch kv[0] {
+		case "line":
+			r.recordLine = kv[1]
+		case "weight":
+			weight, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil || weight > 100 {
+				return fmt.Errorf("invalid weight %q, expect an integer from 0 to 100", kv[1])
+			}
+			r.weight = weight
+		case "status":
+			status := strings.ToUpper(kv[1])
+			if status != "ENABLE" && status != "DISABLE" {
+				return fmt.Errorf("invalid status %q, expect ENABLE or DISABLE", kv[1])
+			}
+			r.status = status
+		default:
+			return fmt.Errorf("unknown directive %q", field)
+		}
+	}
+	return nil
+}
+
+// dnspodZoneRemoteRecords returns the records of the domain in DNSPod, except the ignored ones and those managed by DNSPod.
+func dnspodZoneRemoteRecords(ctx context.Context, service *DnspodService, d dnspodZoneGetter, domain string) ([]*dnspodZoneRecord, error) {
+	var items []*dnspod.RecordListItem
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := service.DescribeDnspodRecordsByDomain(ctx, domain)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		items = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ignores := dnspodZoneIgnores(d)
+	records := make([]*dnspodZoneRecord, 0, len(items))
+	for _, item := range items {
+		if (item.DefaultNS != nil && *item.DefaultNS) || helper.PString(item.Type) == "SOA" {
+			continue
+		}
+		record := &dnspodZoneRecord{
+			recordId:   helper.PUint64(item.RecordId),
+			subDomain:  helper.PString(item.Name),
+			recordType: helper.PString(item.Type),
+			recordLine: helper.PString(item.Line),
+			mx:         helper.PUint64(item.MX),
+			ttl:        helper.PUint64(item.TTL),
+			weight:     helper.PUint64(item.Weight),
+			status:     helper.PString(item.Status),
+		}
+		if record.recordType != "MX" {
+			record.mx = 0
+		}
+		record.value = normalizeDnspodRecordValue(record.recordType, helper.PString(item.Value))
+		if dnspodZoneIgnored(ignores, record) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func dnspodZoneRecordSet(records []*dnspodZoneRecord) []interface{} {
+	result := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		result = append(result, record.toMap())
+	}
+	return result
+}
+
+func dnspodZoneRecordSetKeys(raw interface{}) []string {
+	var list []interface{}
+	switch v := raw.(type) {
+	case *schema.Set:
+		list = v.List()
+	case []interface{}:
+		list = v
+	}
+
+	keys := make([]string, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		keys = append(keys, fmt.Sprintf("%v|%v|%v|%v|%v|%v|%v|%v",
+			strings.ToLower(m["sub_domain"].(string)), m["record_type"], m["record_line"], m["value"], m["mx"], m["ttl"], m["weight"], m["status"]))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func resourceTencentCloudDnspodZoneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"domain", "zone_file", "records", "default_record_line", "default_ttl", "ignore"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("record_set"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("record_ids")
+		}
+	}
+
+	desired, err := dnspodZoneDesiredRecords(d)
+	if err != nil {
+		return err
+	}
+	recordSet := dnspodZoneRecordSet(desired)
+
+	old, _ := d.GetChange("record_set")
+	if d.Id() != "" && strings.Join(dnspodZoneRecordSetKeys(old), ",") == strings.Join(dnspodZoneRecordSetKeys(recordSet), ",") {
+		return nil
+	}
+	if err := d.SetNew("record_set", recordSet); err != nil {
+		return err
+	}
+	return d.SetNewComputed("record_ids")
+}
+
+func resourceTencentCloudDnspodZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dnspod_zone.create")()
+
+	d.SetId(d.Get("domain").(string))
+	if err := resourceTencentCloudDnspodZoneReconcile(d, meta); err != nil {
+		return err
+	}
+	return resourceTencentCloudDnspodZoneRead(d, meta)
+}
+
+func resourceTencentCloudDnspodZoneRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dnspod_zone.read")()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = DnspodService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		domain  = d.Id()
+	)
+
+	records, err := dnspodZoneRemoteRecords(ctx, &service, d, domain)
+	if err != nil {
+		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok && e.GetCode() == "InvalidParameter.DomainNotExists" {
+			log.Printf("[WARN]%s domain [%s] not found, please check if it has been deleted.\n", logId, domain)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	recordIds := make(map[string]interface{}, len(records))
+	for _, record := range records {
+		recordIds[record.key()] = strconv.FormatUint(record.recordId, 10)
+	}
+
+	_ = d.Set("domain", domain)
+	_ = d.Set("record_set", dnspodZoneRecordSet(records))
+	_ = d.Set("record_ids", recordIds)
+	return nil
+}
+
+func resourceTencentCloudDnspodZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dnspod_zone.update")()
+
+	if err := resourceTencentCloudDnspodZoneReconcile(d, meta); err != nil {
+		return err
+	}
+	return resourceTencentCloudDnspodZoneRead(d, meta)
+}
+
+// resourceTencentCloudDnspodZoneReconcile makes the records of the domain match the configuration.
+// Records with the same host, type, line and value are modified in place, the remaining ones with the
+// same host, type and line are paired and modified, the others are deleted, then created.
+func resourceTencentCloudDnspodZoneReconcile(d *schema.ResourceData, meta interface{}) error {
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = DnspodService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		domain  = d.Id()
+	)
+
+	desired, err := dnspodZoneDesiredRecords(d)
+	if err != nil {
+		return err
+	}
+	remote, err := dnspodZoneRemoteRecords(ctx, &service, d, domain)
+	if err != nil {
+		return err
+	}
+
+	var (
+		modifies     []*dnspodZoneRecord
+		creates      []*dnspodZoneRecord
+		remoteByKey  = make(map[string]*dnspodZoneRecord, len(remote))
+		leftDesired  = make(map[string][]*dnspodZoneRecord)
+		leftRemote   = make(map[string][]*dnspodZoneRecord)
+		rrsetsSorted []string
+	)
+	for _, record := range remote {
+		remoteByKey[record.key()] = record
+	}
+
+	for _, record := range desired {
+		if existing, ok := remoteByKey[record.key()]; ok {
+			delete(remoteByKey, record.key())
+			if !record.sameAttributes(existing) {
+				record.recordId = existing.recordId
+				modifies = append(modifies, record)
+			}
+			continue
+		}
+		if _, ok := leftDesired[record.rrset()]; !ok {
+			rrsetsSorted = append(rrsetsSorted, record.rrset())
+		}
+		leftDesired[record.rrset()] = append(leftDesired[record.rrset()], record)
+	}
+	for _, record := range remote {
+		if _, ok := remoteByKey[record.key()]; ok {
+			leftRemote[record.rrset()] = append(leftRemote[record.rrset()], record)
+		}
+	}
+
+	// pair the changed values of a rrset to modify them in place.
+	for _, rrset := range rrsetsSorted {
+		records := leftDesired[rrset]
+		olds := leftRemote[rrset]
+		sort.Slice(records, func(i, j int) bool { return records[i].value < records[j].value })
+		sort.Slice(olds, func(i, j int) bool { return olds[i].value < olds[j].value })
+		for i, record := range records {
+			if i < len(olds) {
+				record.recordId = olds[i].recordId
+				modifies = append(modifies, record)
+				delete(remoteByKey, olds[i].key())
+			} else {
+				creates = append(creates, record)
+			}
+		}
+	}
+
+	deletes := make([]*dnspodZoneRecord, 0, len(remoteByKey))
+	for _, record := range remoteByKey {
+		deletes = append(deletes, record)
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].key() < deletes[j].key() })
+
+	log.Printf("[DEBUG]%s reconcile records of domain %s: %d to modify, %d to delete, %d to create\n",
+		logId, domain, len(modifies), len(deletes), len(creates))
+
+	for _, record := range modifies {
+		request := dnspod.NewModifyRecordRequest()
+		request.Domain = helper.String(domain)
+		request.RecordId = helper.Uint64(record.recordId)
+		request.SubDomain = helper.String(record.subDomain)
+		request.RecordType = helper.String(record.recordType)
+		request.RecordLine = helper.String(record.recordLine)
+		request.Value = helper.String(record.value)
+		request.TTL = helper.Uint64(record.ttl)
+		request.Status = helper.String(record.status)
+		if record.recordType == "MX" {
+			request.MX = helper.Uint64(record.mx)
+		}
+		if record.weight > 0 {
+			request.Weight = helper.Uint64(record.weight)
+		}
+
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			if e := service.ModifyDnspodRecord(ctx, request); e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("modify %s record %s of domain %s failed: %v", record.recordType, record.subDomain, domain, err)
+		}
+	}
+
+	for _, record := range deletes {
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			if e := service.DeleteDnspodRecord(ctx, domain, record.recordId); e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("delete %s record %s of domain %s failed: %v", record.recordType, record.subDomain, domain, err)
+		}
+	}
+
+	for _, record := range creates {
+		request := dnspod.NewCreateRecordRequest()
+		request.Domain = helper.String(domain)
+		request.SubDomain = helper.String(record.subDomain)
+		request.RecordType = helper.String(record.recordType)
+		request.RecordLine = helper.String(record.recordLine)
+		request.Value = helper.String(record.value)
+		request.TTL = helper.Uint64(record.ttl)
+		request.Status = helper.String(record.status)
+		if record.recordType == "MX" {
+			request.MX = helper.Uint64(record.mx)
+		}
+		if record.weight > 0 {
+			request.Weight = helper.Uint64(record.weight)
+		}
+
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			if _, e := service.CreateDnspodRecord(ctx, request); e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("create %s record %s of domain %s failed: %v", record.recordType, record.subDomain, domain, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTencentCloudDnspodZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dnspod_zone.delete")()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = DnspodService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		domain  = d.Id()
+	)
+
+	keys := make([]string, 0)
+	recordIds := d.Get("record_ids").(map[string]interface{})
+	for key := range recordIds {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		recordId, err := strconv.ParseUint(recordIds[key].(string), 10, 64)
+		if err != nil {
+			return fmt.Errorf("record id %v of %s is invalid", recordIds[key], key)
+		}
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			e := service.DeleteDnspodRecord(ctx, domain, recordId)
+			if e != nil {
+				if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok && ee.GetCode() == "InvalidParameter.RecordIdInvalid" {
+					return nil
+				}
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s delete DnsPod record %s failed, reason:%s\n", logId, key, err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package dnspod_test
+
+import (
+	"regexp"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudDnspodZoneResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnspodZoneInvalid,
+				ExpectError: regexp.MustCompile(`invalid IPv4 address "1.2.3.256"`),
+			},
+			{
+				Config: testAccDnspodZone,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_dnspod_zone.zone", "id", "iac-tf.cloud"),
+					resource.TestCheckResourceAttr("tencentcloud_dnspod_zone.zone", "record_set.#", "4"),
+					resource.TestCheckResourceAttr("tencentcloud_dnspod_zone.zone", "record_ids.%", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("tencentcloud_dnspod_zone.zone", "record_set.*", map[string]string{
+						"sub_domain":  "www",
+						"record_type": "A",
+						"value":       "1.2.3.5",
+						"record_line": "电信",
+						"ttl":         "600",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("tencentcloud_dnspod_zone.zone", "record_set.*", map[string]string{
+						"sub_domain":  "tf-zone-blog",
+						"record_type": "CNAME",
+						"value":       "www.iac-tf.cloud.",
+					}),
+				),
+			},
+			{
+				Config: testAccDnspodZoneUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_dnspod_zone.zone", "record_set.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("tencentcloud_dnspod_zone.zone", "record_set.*", map[string]string{
+						"sub_domain":  "tf-zone-mail",
+						"record_type": "A",
+						"value":       "1.2.3.7",
+						"ttl":         "300",
+					}),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_dnspod_zone.zone",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file", "default_record_line", "default_ttl", "ignore"},
+			},
+		},
+	})
+}
+
+const testAccDnspodZoneInvalid = `
+resource "tencentcloud_dnspod_zone" "zone" {
+  domain    = "iac-tf.cloud"
+  zone_file = <<EOF
+tf-zone-www IN A 1.2.3.256
+EOF
+}
+`
+
+const testAccDnspodZone = `
+resource "tencentcloud_dnspod_zone" "zone" {
+  domain    = "iac-tf.cloud"
+  zone_file = <<EOF
+$ORIGIN iac-tf.cloud.
+$TTL 600
+www          IN  A     1.2.3.4
+             IN  A     1.2.3.5   ; dnspod:line=电信
+tf-zone-mail 300 IN A  1.2.3.6
+tf-zone-blog IN  CNAME www
+EOF
+}
+`
+
+const testAccDnspodZoneUpdate = `
+resource "tencentcloud_dnspod_zone" "zone" {
+  domain    = "iac-tf.cloud"
+  zone_file = <<EOF
+$ORIGIN iac-tf.cloud.
+$TTL 600
+www          IN  A     1.2.3.4
+tf-zone-mail 300 IN A  1.2.3.7
+tf-zone-blog IN  CNAME www
+EOF
+}
+`
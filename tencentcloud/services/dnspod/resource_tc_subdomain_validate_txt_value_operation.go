@@ -10,10 +10,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudSubdomainValidateTxtValueOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSubdomainValidateTxtValueOperationCreate,
 		Read:   resourceTencentCloudSubdomainValidateTxtValueOperationRead,
 		Delete: resourceTencentCloudSubdomainValidateTxtValueOperationDelete,
@@ -45,7 +46,7 @@ func ResourceTencentCloudSubdomainValidateTxtValueOperation() *schema.Resource {
 				Description: "The record value of the TXT record needs to be added.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSubdomainValidateTxtValueOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -76,6 +77,7 @@ func resourceTencentCloudSubdomainValidateTxtValueOperationCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
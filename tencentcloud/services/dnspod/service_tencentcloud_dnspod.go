@@ -830,3 +830,111 @@ func (me *DnspodService) DescribeSubdomainValidateStatusByFilter(ctx context.Con
 	status = 1
 	return
 }
+
+func (me *DnspodService) DescribeDnspodRecordsByDomain(ctx context.Context, domain string) (records []*dnspod.RecordListItem, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := dnspod.NewDescribeRecordListRequest()
+	request.Domain = helper.String(domain)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset uint64 = 0
+		limit  uint64 = 3000
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseDnsPodClient().DescribeRecordList(request)
+		if err != nil {
+			// a domain without records
+			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok && e.GetCode() == "ResourceNotFound.NoDataOfRecord" {
+				return
+			}
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.RecordList) < 1 {
+			break
+		}
+		records = append(records, response.Response.RecordList...)
+		if len(response.Response.RecordList) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
+
+func (me *DnspodService) CreateDnspodRecord(ctx context.Context, request *dnspod.CreateRecordRequest) (recordId uint64, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseDnsPodClient().CreateRecord(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response.RecordId == nil {
+		errRet = fmt.Errorf("DnsPod record id is nil")
+		return
+	}
+	recordId = *response.Response.RecordId
+	return
+}
+
+func (me *DnspodService) ModifyDnspodRecord(ctx context.Context, request *dnspod.ModifyRecordRequest) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseDnsPodClient().ModifyRecord(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return
+}
+
+func (me *DnspodService) DeleteDnspodRecord(ctx context.Context, domain string, recordId uint64) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := dnspod.NewDeleteRecordRequest()
+	request.Domain = helper.String(domain)
+	request.RecordId = helper.Uint64(recordId)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseDnsPodClient().DeleteRecord(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return
+}
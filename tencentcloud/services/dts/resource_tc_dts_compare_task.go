@@ -21,9 +21,9 @@ func ResourceTencentCloudDtsCompareTask() *schema.Resource {
 		Create: resourceTencentCloudDtsCompareTaskCreate,
 		Update: resourceTencentCloudDtsCompareTaskUpdate,
 		Delete: resourceTencentCloudDtsCompareTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
@@ -95,6 +95,11 @@ func TestAccTencentCloudDtsCompareTaskResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_dts_compare_task_stop_operation.stop", "compare_task_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dts_compare_task.compare_task",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsMigrateJobResumeOperationCreate,
 		Read:   resourceTencentCloudDtsMigrateJobResumeOperationRead,
 		Delete: resourceTencentCloudDtsMigrateJobResumeOperationDelete,
@@ -33,7 +34,7 @@ func ResourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
 				Description: "resume mode: 1.clearData-Clear target data; 2.overwrite-The task is executed in overwrite mode; 3.normal-No extra action. Note that clearData and overwrite are valid only for redis links, normal is valid only for non-Redis links.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -61,6 +62,7 @@ func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceDa
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -72,7 +74,7 @@ func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceDa
 
 	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
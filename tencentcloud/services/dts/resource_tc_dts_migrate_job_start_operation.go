@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,7 +17,7 @@ import (
 )
 
 func ResourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsMigrateJobStartOperationCreate,
 		Read:   resourceTencentCloudDtsMigrateJobStartOperationRead,
 		Delete: resourceTencentCloudDtsMigrateJobStartOperationDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
 				Description: "Job Id from `tencentcloud_dts_migrate_job`.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -75,6 +76,7 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, startMigrateJobRequest.GetAction(), startMigrateJobRequest.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -83,7 +85,7 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 		return err
 	}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "error"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "error"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -20,9 +20,9 @@ func ResourceTencentCloudDtsSyncJob() *schema.Resource {
 		Read:   resourceTencentCloudDtsSyncJobRead,
 		Create: resourceTencentCloudDtsSyncJobCreate,
 		Delete: resourceTencentCloudDtsSyncJobDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"pay_mode": {
 				Type:        schema.TypeString,
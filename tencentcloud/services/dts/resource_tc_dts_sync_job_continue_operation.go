@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobContinueOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobContinueOperationRead,
 		Delete: resourceTencentCloudDtsSyncJobContinueOperationDelete,
@@ -29,7 +30,7 @@ func ResourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -53,6 +54,7 @@ func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -65,7 +67,7 @@ func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceDat
 
 	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
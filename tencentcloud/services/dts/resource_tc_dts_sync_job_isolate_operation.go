@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobIsolateOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobIsolateOperationRead,
 		Delete: resourceTencentCloudDtsSyncJobIsolateOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -50,6 +51,7 @@ func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -62,7 +64,7 @@ func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData
 
 	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Isolated"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
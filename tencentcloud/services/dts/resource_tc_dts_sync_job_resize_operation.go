@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobResizeOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobResizeOperationRead,
 		Delete: resourceTencentCloudDtsSyncJobResizeOperationDelete,
@@ -33,7 +34,7 @@ func ResourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
 				Description: "Task specification.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -61,6 +62,7 @@ func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -73,13 +75,13 @@ func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData,
 
 	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
 
-	conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+	conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
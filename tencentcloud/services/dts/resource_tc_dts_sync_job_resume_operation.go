@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudDtsSyncJobResumeOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobResumeOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobResumeOperationRead,
 		Delete: resourceTencentCloudDtsSyncJobResumeOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudDtsSyncJobResumeOperation() *schema.Resource {
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudDtsSyncJobResumeOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -50,6 +51,7 @@ func resourceTencentCloudDtsSyncJobResumeOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -62,7 +64,7 @@ func resourceTencentCloudDtsSyncJobResumeOperationCreate(d *schema.ResourceData,
 
 	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped", "Failed"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped", "Failed"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
@@ -80,6 +80,11 @@ func TestAccTencentCloudDtsSyncJobResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dts_sync_job.sync_job", "instance_class", "micro"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dts_sync_job.sync_job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -12,10 +12,11 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudEmrDeployYarnOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudEmrDeployYarnOperationCreate,
 		Read:   resourceTencentCloudEmrDeployYarnOperationRead,
 		Delete: resourceTencentCloudEmrDeployYarnOperationDelete,
@@ -27,7 +28,7 @@ func ResourceTencentCloudEmrDeployYarnOperation() *schema.Resource {
 				Description: "EMR Instance ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudEmrDeployYarnOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -51,6 +52,7 @@ func resourceTencentCloudEmrDeployYarnOperationCreate(d *schema.ResourceData, me
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, deployRequest.GetAction(), deployRequest.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		flowId = result.Response.FlowId
 		return nil
@@ -64,7 +66,7 @@ func resourceTencentCloudEmrDeployYarnOperationCreate(d *schema.ResourceData, me
 		emrService := EMRService{
 			client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 		}
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 10*tccommon.ReadRetryTimeout, time.Second, emrService.FlowStatusRefreshFunc(instanceId, strconv.FormatUint(*flowId, 10), F_KEY_FLOW_ID, []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, emrService.FlowStatusRefreshFunc(instanceId, strconv.FormatUint(*flowId, 10), F_KEY_FLOW_ID, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchRestartInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartInstanceOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartInstanceOperationRead,
 		Delete: resourceTencentCloudElasticsearchRestartInstanceOperationDelete,
@@ -45,7 +46,7 @@ func ResourceTencentCloudElasticsearchRestartInstanceOperation() *schema.Resourc
 				Description: "Restart mode: 0 roll restart; 1 full restart.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchRestartInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -77,6 +78,7 @@ func resourceTencentCloudElasticsearchRestartInstanceOperationCreate(d *schema.R
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -87,7 +89,7 @@ func resourceTencentCloudElasticsearchRestartInstanceOperationCreate(d *schema.R
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchRestartKibanaOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartKibanaOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartKibanaOperationRead,
 		Delete: resourceTencentCloudElasticsearchRestartKibanaOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudElasticsearchRestartKibanaOperation() *schema.Resource
 				Description: "Instance id.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchRestartKibanaOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -49,6 +50,7 @@ func resourceTencentCloudElasticsearchRestartKibanaOperationCreate(d *schema.Res
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -60,7 +62,7 @@ func resourceTencentCloudElasticsearchRestartKibanaOperationCreate(d *schema.Res
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
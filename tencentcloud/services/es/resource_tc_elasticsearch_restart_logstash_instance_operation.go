@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchRestartLogstashInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartLogstashInstanceOperationRead,
 		Delete: resourceTencentCloudElasticsearchRestartLogstashInstanceOperationDelete,
@@ -36,7 +37,7 @@ func ResourceTencentCloudElasticsearchRestartLogstashInstanceOperation() *schema
 				Description: "Restart type, 0 full restart, 1 rolling restart.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -64,6 +65,7 @@ func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate(d *
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -74,7 +76,7 @@ func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate(d *
 
 	service := ElasticsearchService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashStateRefreshFunc(instanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, service.ElasticsearchLogstashStateRefreshFunc(instanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchRestartNodesOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartNodesOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartNodesOperationRead,
 		Delete: resourceTencentCloudElasticsearchRestartNodesOperationDelete,
@@ -58,7 +59,7 @@ func ResourceTencentCloudElasticsearchRestartNodesOperation() *schema.Resource {
 				Description: "Node status, used in blue-green mode; off-line node blue-green is risky.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchRestartNodesOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -102,6 +103,7 @@ func resourceTencentCloudElasticsearchRestartNodesOperationCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -113,7 +115,7 @@ func resourceTencentCloudElasticsearchRestartNodesOperationCreate(d *schema.Reso
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
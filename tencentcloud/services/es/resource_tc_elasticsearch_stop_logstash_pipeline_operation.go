@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchStopLogstashPipelineOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate,
 		Read:   resourceTencentCloudElasticsearchStopLogstashPipelineOperationRead,
 		Delete: resourceTencentCloudElasticsearchStopLogstashPipelineOperationDelete,
@@ -36,7 +37,7 @@ func ResourceTencentCloudElasticsearchStopLogstashPipelineOperation() *schema.Re
 				Description: "Pipeline id.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -66,6 +67,7 @@ func resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate(d *sch
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -76,7 +78,7 @@ func resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate(d *sch
 
 	service := ElasticsearchService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"-2"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"-2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudElasticsearchUpdatePluginsOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudElasticsearchUpdatePluginsOperationCreate,
 		Read:   resourceTencentCloudElasticsearchUpdatePluginsOperationRead,
 		Delete: resourceTencentCloudElasticsearchUpdatePluginsOperationDelete,
@@ -67,7 +68,7 @@ func ResourceTencentCloudElasticsearchUpdatePluginsOperation() *schema.Resource
 				Description: "Plugin type. 0: system plugin.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudElasticsearchUpdatePluginsOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -119,6 +120,7 @@ func resourceTencentCloudElasticsearchUpdatePluginsOperationCreate(d *schema.Res
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -131,7 +133,7 @@ func resourceTencentCloudElasticsearchUpdatePluginsOperationCreate(d *schema.Res
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
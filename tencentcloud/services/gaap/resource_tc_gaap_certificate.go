@@ -18,6 +18,9 @@ func ResourceTencentCloudGaapCertificate() *schema.Resource {
 		Read:   resourceTencentCloudGaapCertificateRead,
 		Update: resourceTencentCloudGaapCertificateUpdate,
 		Delete: resourceTencentCloudGaapCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
@@ -134,6 +137,11 @@ func resourceTencentCloudGaapCertificateRead(d *schema.ResourceData, m interface
 		return fmt.Errorf("unknown certificate type %d", *certificate.CertificateType)
 	}
 
+	// the content is only read on import, as the one returned may be formatted differently from the one given.
+	if _, ok := d.GetOk("content"); !ok && certificate.CertificateContent != nil {
+		_ = d.Set("content", certificate.CertificateContent)
+	}
+
 	_ = d.Set("name", certificate.CertificateAlias)
 
 	if certificate.CreateTime == nil {
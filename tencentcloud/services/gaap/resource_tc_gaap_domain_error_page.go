@@ -7,14 +7,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// gaapDomainErrorPageImportIdFormat is the import id of an error page, the id of the resource is the error page id.
+var gaapDomainErrorPageImportIdFormat = compositeid.New(compositeid.String("listener_id"), compositeid.String("domain"), compositeid.String("id"))
+
 func ResourceTencentCloudGaapDomainErrorPageInfo() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudGaapDomainErrorPageInfoCreate,
 		Read:   resourceTencentCloudGaapDomainErrorPageInfoRead,
 		Delete: resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		Importer: &schema.ResourceImporter{
+			State: gaapDomainErrorPageImportIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
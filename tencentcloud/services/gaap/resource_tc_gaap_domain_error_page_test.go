@@ -35,6 +35,12 @@ func TestAccTencentCloudGaapDomainErrorPage_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_gaap_domain_error_page.foo", "body", "bad request"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_gaap_domain_error_page.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_gaap_domain_error_page.foo", "listener_id", "domain", "id"),
+			},
 		},
 	})
 }
@@ -2,23 +2,28 @@ package gwlb
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gwlbv20240906 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gwlb/v20240906"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// gwlbInstanceAssociateTargetGroupIdFormat is the id of an association, which is also its import id.
+var gwlbInstanceAssociateTargetGroupIdFormat = compositeid.New(compositeid.String("load_balancer_id"), compositeid.String("target_group_id"))
+
 func ResourceTencentCloudGwlbInstanceAssociateTargetGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudGwlbInstanceAssociateTargetGroupCreate,
 		Read:   resourceTencentCloudGwlbInstanceAssociateTargetGroupRead,
 		Delete: resourceTencentCloudGwlbInstanceAssociateTargetGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: gwlbInstanceAssociateTargetGroupIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:        schema.TypeString,
@@ -72,7 +77,7 @@ func resourceTencentCloudGwlbInstanceAssociateTargetGroupCreate(d *schema.Resour
 
 	_ = response
 
-	d.SetId(strings.Join([]string{loadBalancerId, targetGroupId}, tccommon.FILED_SP))
+	d.SetId(gwlbInstanceAssociateTargetGroupIdFormat.Join(loadBalancerId, targetGroupId))
 
 	return resourceTencentCloudGwlbInstanceAssociateTargetGroupRead(d, meta)
 }
@@ -85,13 +90,13 @@ func resourceTencentCloudGwlbInstanceAssociateTargetGroupRead(d *schema.Resource
 
 	ctx := tccommon.NewResourceLifeCycleHandleFuncContext(context.Background(), logId, d, meta)
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := gwlbInstanceAssociateTargetGroupIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	_ = d.Set("load_balancer_id", items[0])
-	_ = d.Set("target_group_id", items[1])
+	_ = d.Set("load_balancer_id", id.Get("load_balancer_id"))
+	_ = d.Set("target_group_id", id.Get("target_group_id"))
 	_ = ctx
 	return nil
 }
@@ -103,9 +108,9 @@ func resourceTencentCloudGwlbInstanceAssociateTargetGroupDelete(d *schema.Resour
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := tccommon.NewResourceLifeCycleHandleFuncContext(context.Background(), logId, d, meta)
 
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := gwlbInstanceAssociateTargetGroupIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
 	var (
@@ -115,11 +120,11 @@ func resourceTencentCloudGwlbInstanceAssociateTargetGroupDelete(d *schema.Resour
 
 	request.Associations = []*gwlbv20240906.TargetGroupAssociation{
 		{
-			LoadBalancerId: helper.String(items[0]),
-			TargetGroupId:  helper.String(items[1]),
+			LoadBalancerId: helper.String(id.Get("load_balancer_id")),
+			TargetGroupId:  helper.String(id.Get("target_group_id")),
 		},
 	}
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseGwlbV20240906Client().DisassociateTargetGroupsWithContext(ctx, request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -20,6 +20,11 @@ func TestAccTencentCloudGwlbInstanceAssociateTargetGroupResource_basic(t *testin
 				Config: testAccGwlbInstanceAssociateTargetGroup,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_gwlb_instance_associate_target_group.gwlb_instance_associate_target_groups", "id")),
 			},
+			{
+				ResourceName:      "tencentcloud_gwlb_instance_associate_target_group.gwlb_instance_associate_target_groups",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
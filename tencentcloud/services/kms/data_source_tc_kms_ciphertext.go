@@ -0,0 +1,134 @@
+package kms
+
+import (
+	"context"
+	"encoding/json"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/kmsenvelope"
+)
+
+func DataSourceTencentCloudKmsCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudKmsCiphertextRead,
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "CMK unique identifier.",
+			},
+			"plaintext": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Description: "Plaintext to be encrypted. Plaintext over 4096 bytes is encrypted with a data key generated by the CMK.",
+			},
+			"encryption_context": {
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key/value pairs bound to the ciphertext, the same pairs are required to decrypt it.",
+			},
+			"envelope": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+				Description: "Whether to always encrypt with a data key generated by the CMK, whatever the size of `plaintext`. Default is `false`.",
+			},
+			"ciphertext_blob": {
+				Computed:    true,
+				Type:        schema.TypeString,
+				Description: "Base64-encoded ciphertext, which can be decrypted by `tencentcloud_kms_secrets`. It changes on every read.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_kms_ciphertext.read")()
+
+	var (
+		logId          = tccommon.GetLogId(tccommon.ContextNil)
+		ctx            = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service        = KmsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		keyId          = d.Get("key_id").(string)
+		plaintext      = []byte(d.Get("plaintext").(string))
+		ciphertextBlob string
+	)
+
+	encryptionContext, err := kmsEncryptionContext(d.Get("encryption_context").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if d.Get("envelope").(bool) || len(plaintext) > KMS_ENCRYPT_PLAINTEXT_LIMIT {
+		var (
+			dataKey          []byte
+			encryptedDataKey string
+		)
+		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			var e error
+			dataKey, encryptedDataKey, e = service.GenerateDataKey(ctx, keyId, KMS_DATA_KEY_SPEC_AES_256, encryptionContext)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if ciphertextBlob, err = kmsenvelope.Seal(dataKey, encryptedDataKey, plaintext, []byte(encryptionContext)); err != nil {
+			return err
+		}
+	} else {
+		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.Encrypt(ctx, keyId, plaintext, encryptionContext)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			ciphertextBlob = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = d.Set("ciphertext_blob", ciphertextBlob)
+	d.SetId(helper.DataResourceIdsHash([]string{keyId, ciphertextBlob}))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), map[string]interface{}{
+			"key_id":          keyId,
+			"ciphertext_blob": ciphertextBlob,
+		}); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// kmsEncryptionContext returns the encryption context in the JSON format of KMS, with sorted keys.
+func kmsEncryptionContext(raw map[string]interface{}) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	encryptionContext, err := json.Marshal(raw)
+	if err != nil {
+		return "", err
+	}
+	return string(encryptionContext), nil
+}
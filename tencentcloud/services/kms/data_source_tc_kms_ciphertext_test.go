@@ -0,0 +1,58 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// go test -i; go test -test.run TestAccTencentCloudKmsCiphertextDataSource_basic -v
+func TestAccTencentCloudKmsCiphertextDataSource_basic(t *testing.T) {
+	t.Parallel()
+	rName := fmt.Sprintf("tf-testacc-kms-key-%s", acctest.RandString(13))
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKmsCiphertextDataSource, rName),
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_kms_ciphertext.example"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kms_ciphertext.example", "ciphertext_blob"),
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_kms_ciphertext.envelope"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kms_ciphertext.envelope", "ciphertext_blob"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKmsCiphertextDataSource = `
+resource "tencentcloud_kms_key" "example" {
+  alias       = "%s"
+  description = "example of kms key"
+  key_usage   = "ENCRYPT_DECRYPT"
+  is_enabled  = true
+}
+
+data "tencentcloud_kms_ciphertext" "example" {
+  key_id    = tencentcloud_kms_key.example.id
+  plaintext = "example-password"
+
+  encryption_context = {
+    app = "example"
+  }
+}
+
+data "tencentcloud_kms_ciphertext" "envelope" {
+  key_id    = tencentcloud_kms_key.example.id
+  plaintext = "example-password"
+  envelope  = true
+}
+`
@@ -0,0 +1,141 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/kmsenvelope"
+)
+
+func DataSourceTencentCloudKmsSecrets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudKmsSecretsRead,
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Required:    true,
+				Type:        schema.TypeList,
+				Description: "Secrets to be decrypted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Required:    true,
+							Type:        schema.TypeString,
+							Description: "Name of the secret, the key of its plaintext in `plaintext`.",
+						},
+						"ciphertext_blob": {
+							Required:    true,
+							Type:        schema.TypeString,
+							Description: "Base64-encoded ciphertext, such as `ciphertext_blob` of `tencentcloud_kms_ciphertext`.",
+						},
+						"encryption_context": {
+							Optional:    true,
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Key/value pairs the ciphertext was encrypted with.",
+						},
+					},
+				},
+			},
+			"plaintext": {
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of the secret names to their plaintext.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results. Only the names of the secrets and the ids of their keys are saved, never the plaintext.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_kms_secrets.read")()
+
+	var (
+		logId     = tccommon.GetLogId(tccommon.ContextNil)
+		ctx       = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service   = KmsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		plaintext = make(map[string]interface{})
+		names     = make([]string, 0)
+		results   = make([]map[string]interface{}, 0)
+	)
+
+	for _, item := range d.Get("secret").([]interface{}) {
+		secret := item.(map[string]interface{})
+		name := secret["name"].(string)
+		if _, ok := plaintext[name]; ok {
+			return fmt.Errorf("secret %s is duplicated", name)
+		}
+
+		encryptionContext, err := kmsEncryptionContext(secret["encryption_context"].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		value, keyId, err := kmsDecryptSecret(ctx, &service, secret["ciphertext_blob"].(string), encryptionContext)
+		if err != nil {
+			return fmt.Errorf("decrypt secret %s failed: %v", name, err)
+		}
+		plaintext[name] = string(value)
+		names = append(names, name)
+		results = append(results, map[string]interface{}{
+			"name":   name,
+			"key_id": keyId,
+		})
+	}
+
+	_ = d.Set("plaintext", plaintext)
+	d.SetId(helper.DataResourceIdsHash(names))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), results); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// kmsDecryptSecret decrypts a KMS ciphertext blob, or an envelope made by `tencentcloud_kms_ciphertext`,
+// and returns the plaintext with the id of the key.
+func kmsDecryptSecret(ctx context.Context, service *KmsService, ciphertextBlob, encryptionContext string) ([]byte, string, error) {
+	envelope, isEnvelope, err := kmsenvelope.Parse(ciphertextBlob)
+	if err != nil {
+		return nil, "", err
+	}
+	if isEnvelope {
+		ciphertextBlob = envelope.EncryptedDataKey
+	}
+
+	var (
+		plaintext []byte
+		keyId     string
+	)
+	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, id, e := service.Decrypt(ctx, ciphertextBlob, encryptionContext)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		plaintext, keyId = result, id
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if isEnvelope {
+		plaintext, err = envelope.Open(plaintext, []byte(encryptionContext))
+	}
+	return plaintext, keyId, err
+}
@@ -0,0 +1,76 @@
+package kms_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// go test -i; go test -test.run TestAccTencentCloudKmsSecretsDataSource_basic -v
+func TestAccTencentCloudKmsSecretsDataSource_basic(t *testing.T) {
+	t.Parallel()
+	rName := fmt.Sprintf("tf-testacc-kms-key-%s", acctest.RandString(13))
+	// over the size limit of Encrypt, so it is encrypted with a data key.
+	large := strings.Repeat("x", 5000)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccKmsSecretsDataSource, rName, large),
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_kms_secrets.example"),
+					resource.TestCheckResourceAttr("data.tencentcloud_kms_secrets.example", "plaintext.%", "2"),
+					resource.TestCheckResourceAttr("data.tencentcloud_kms_secrets.example", "plaintext.password", "example-password"),
+					resource.TestCheckResourceAttr("data.tencentcloud_kms_secrets.example", "plaintext.large", large),
+				),
+			},
+		},
+	})
+}
+
+const testAccKmsSecretsDataSource = `
+resource "tencentcloud_kms_key" "example" {
+  alias       = "%s"
+  description = "example of kms key"
+  key_usage   = "ENCRYPT_DECRYPT"
+  is_enabled  = true
+}
+
+data "tencentcloud_kms_ciphertext" "password" {
+  key_id    = tencentcloud_kms_key.example.id
+  plaintext = "example-password"
+
+  encryption_context = {
+    app = "example"
+  }
+}
+
+data "tencentcloud_kms_ciphertext" "large" {
+  key_id    = tencentcloud_kms_key.example.id
+  plaintext = "%s"
+}
+
+data "tencentcloud_kms_secrets" "example" {
+  secret {
+    name            = "password"
+    ciphertext_blob = data.tencentcloud_kms_ciphertext.password.ciphertext_blob
+
+    encryption_context = {
+      app = "example"
+    }
+  }
+
+  secret {
+    name            = "large"
+    ciphertext_blob = data.tencentcloud_kms_ciphertext.large.ciphertext_blob
+  }
+}
+`
@@ -69,3 +69,9 @@ var WHITE_BOX_KEY_STATUS = []string{
 	WHITE_BOX_KEY_STATUS_ENABLED,
 	WHITE_BOX_KEY_STATUS_DISABLED,
 }
+
+const (
+	// payloads over the limit of Encrypt are encrypted with a data key.
+	KMS_ENCRYPT_PLAINTEXT_LIMIT = 4096
+	KMS_DATA_KEY_SPEC_AES_256   = "AES_256"
+)
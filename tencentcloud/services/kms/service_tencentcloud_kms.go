@@ -889,3 +889,111 @@ func (me *KmsService) DescribeKmsServiceStatusByFilter(ctx context.Context, para
 	ret = response.Response
 	return
 }
+
+// The request and response bodies of the methods below are not logged, as they carry plaintext.
+
+func (me *KmsService) Encrypt(ctx context.Context, keyId string, plaintext []byte, encryptionContext string) (ciphertextBlob string, errRet error) {
+	var (
+		logId   = tccommon.GetLogId(ctx)
+		request = kms.NewEncryptRequest()
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, key id [%s], reason[%s]\n", logId, request.GetAction(), keyId, errRet.Error())
+		}
+	}()
+
+	request.KeyId = helper.String(keyId)
+	request.Plaintext = helper.String(base64.StdEncoding.EncodeToString(plaintext))
+	if encryptionContext != "" {
+		request.EncryptionContext = helper.String(encryptionContext)
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseKmsClient().Encrypt(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, key id [%s]\n", logId, request.GetAction(), keyId)
+
+	if response == nil || response.Response == nil || response.Response.CiphertextBlob == nil {
+		errRet = fmt.Errorf("TencentCloud SDK %s return empty response", request.GetAction())
+		return
+	}
+
+	ciphertextBlob = *response.Response.CiphertextBlob
+	return
+}
+
+func (me *KmsService) Decrypt(ctx context.Context, ciphertextBlob, encryptionContext string) (plaintext []byte, keyId string, errRet error) {
+	var (
+		logId   = tccommon.GetLogId(ctx)
+		request = kms.NewDecryptRequest()
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), errRet.Error())
+		}
+	}()
+
+	request.CiphertextBlob = helper.String(ciphertextBlob)
+	if encryptionContext != "" {
+		request.EncryptionContext = helper.String(encryptionContext)
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseKmsClient().Decrypt(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	if response == nil || response.Response == nil || response.Response.Plaintext == nil {
+		errRet = fmt.Errorf("TencentCloud SDK %s return empty response", request.GetAction())
+		return
+	}
+	keyId = helper.PString(response.Response.KeyId)
+	log.Printf("[DEBUG]%s api[%s] success, key id [%s]\n", logId, request.GetAction(), keyId)
+
+	plaintext, errRet = base64.StdEncoding.DecodeString(*response.Response.Plaintext)
+	return
+}
+
+func (me *KmsService) GenerateDataKey(ctx context.Context, keyId, keySpec, encryptionContext string) (plaintext []byte, ciphertextBlob string, errRet error) {
+	var (
+		logId   = tccommon.GetLogId(ctx)
+		request = kms.NewGenerateDataKeyRequest()
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, key id [%s], reason[%s]\n", logId, request.GetAction(), keyId, errRet.Error())
+		}
+	}()
+
+	request.KeyId = helper.String(keyId)
+	request.KeySpec = helper.String(keySpec)
+	if encryptionContext != "" {
+		request.EncryptionContext = helper.String(encryptionContext)
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseKmsClient().GenerateDataKey(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, key id [%s]\n", logId, request.GetAction(), keyId)
+
+	if response == nil || response.Response == nil || response.Response.Plaintext == nil || response.Response.CiphertextBlob == nil {
+		errRet = fmt.Errorf("TencentCloud SDK %s return empty response", request.GetAction())
+		return
+	}
+
+	ciphertextBlob = *response.Response.CiphertextBlob
+	plaintext, errRet = base64.StdEncoding.DecodeString(*response.Response.Plaintext)
+	return
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -21,6 +22,9 @@ func ResourceTencentCloudLighthouseDisk() *schema.Resource {
 		Read:   resourceTencentCloudLighthouseDiskRead,
 		Update: resourceTencentCloudLighthouseDiskUpdate,
 		Delete: resourceTencentCloudLighthouseDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
@@ -212,7 +216,7 @@ func resourceTencentCloudLighthouseDiskCreate(d *schema.ResourceData, meta inter
 		diskIds = append(diskIds, *diskId)
 	}
 
-	d.SetId(helper.IdFormat(diskIds...))
+	d.SetId(compositeid.Join(diskIds...))
 
 	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
@@ -235,7 +239,7 @@ func resourceTencentCloudLighthouseDiskRead(d *schema.ResourceData, meta interfa
 
 	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	diskIds := helper.IdParse(d.Id())
+	diskIds := compositeid.Split(d.Id())
 	diskId := diskIds[0]
 	disk, err := service.DescribeLighthouseDiskById(ctx, diskId)
 	if err != nil {
@@ -248,6 +252,8 @@ func resourceTencentCloudLighthouseDiskRead(d *schema.ResourceData, meta interfa
 		return nil
 	}
 
+	_ = d.Set("disk_count", len(diskIds))
+
 	if disk.Zone != nil {
 		_ = d.Set("zone", disk.Zone)
 	}
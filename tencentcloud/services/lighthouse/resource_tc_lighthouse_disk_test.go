@@ -24,6 +24,12 @@ func TestAccTencentCloudLighthouseDiskResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_lighthouse_disk.disk", "zone", "ap-guangzhou-3"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_lighthouse_disk.disk",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disk_charge_prepaid"},
+			},
 		},
 	})
 }
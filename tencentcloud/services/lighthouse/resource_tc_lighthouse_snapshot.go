@@ -20,6 +20,9 @@ func ResourceTencentCloudLighthouseSnapshot() *schema.Resource {
 		Read:   resourceTencentCloudLighthouseSnapshotRead,
 		Update: resourceTencentCloudLighthouseSnapshotUpdate,
 		Delete: resourceTencentCloudLighthouseSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -107,6 +110,16 @@ func resourceTencentCloudLighthouseSnapshotRead(d *schema.ResourceData, meta int
 		return nil
 	}
 
+	if _, ok := d.GetOk("instance_id"); !ok && snapshot.DiskId != nil {
+		disk, err := service.DescribeLighthouseDiskById(ctx, *snapshot.DiskId)
+		if err != nil {
+			return err
+		}
+		if disk != nil {
+			_ = d.Set("instance_id", disk.InstanceId)
+		}
+	}
+
 	if snapshot.SnapshotName != nil {
 		_ = d.Set("snapshot_name", snapshot.SnapshotName)
 	}
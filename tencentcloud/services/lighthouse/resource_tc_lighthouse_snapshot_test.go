@@ -29,6 +29,11 @@ func TestAccTencentCloudLighthouseSnapshotResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_lighthouse_snapshot.snapshot", "snapshot_name", "snapshot_test_update"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_lighthouse_snapshot.snapshot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,9 @@ func ResourceTencentCloudMongodbInstanceParams() *schema.Resource {
 		Read:   resourceTencentCloudMongodbInstanceParamsRead,
 		Update: resourceTencentCloudMongodbInstanceParamsUpdate,
 		Delete: resourceTencentCloudMongodbInstanceParamsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -32,6 +32,12 @@ func TestAccTencentCloudMongodbInstanceParamsResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance_params.mongodb_instance_params", "instance_params.0.value", "off"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_mongodb_instance_params.mongodb_instance_params",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"modify_type"},
+			},
 		},
 	})
 }
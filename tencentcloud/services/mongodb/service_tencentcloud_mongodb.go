@@ -14,6 +14,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -444,26 +445,22 @@ func (me *MongodbService) DescribeAsyncRequestInfo(ctx context.Context, asyncId
 	logId := tccommon.GetLogId(ctx)
 	request := mongodb.NewDescribeAsyncRequestInfoRequest()
 	request.AsyncRequestId = &asyncId
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().DescribeAsyncRequestInfo(request)
-		if e != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
-			return resource.RetryableError(e)
-		}
-		if *result.Response.Status == MONGODB_TASK_FAILED {
-			return resource.NonRetryableError(fmt.Errorf("[CRITAL]%s api[%s] task failed", logId, request.GetAction()))
-		}
-		if *result.Response.Status != MONGODB_TASK_SUCCESS {
-			return resource.RetryableError(fmt.Errorf("[CRITAL]%s api[%s] task is %s, retrying", logId, request.GetAction(), *result.Response.Status))
-		}
-		return nil
-	})
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_, errRet = (&waiter.Waiter{
+		Name:    "mongodb async request " + asyncId,
+		Target:  []string{MONGODB_TASK_SUCCESS},
+		Failed:  []string{MONGODB_TASK_FAILED},
+		Timeout: timeout,
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			ratelimit.Check(request.GetAction())
+			result, e := me.client.UseMongodbClient().DescribeAsyncRequestInfo(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
+				return nil, e
+			}
+			return &waiter.Status{State: *result.Response.Status}, nil
+		},
+	}).Wait(ctx)
+	return
 }
 
 func (me *MongodbService) OfflineIsolatedDBInstance(ctx context.Context, instanceId string, timeOutTolerant bool) (errRet error) {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,12 +16,18 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
+// monitorBindingReceiverImportIdFormat is the import id of the receivers, which is the policy group id.
+var monitorBindingReceiverImportIdFormat = compositeid.New(compositeid.Int("group_id"))
+
 func ResourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentMonitorBindingAlarmReceiverCreate,
 		Read:   resourceTencentMonitorBindingAlarmReceiverRead,
 		Update: resourceTencentMonitorBindingAlarmReceiverUpdate,
 		Delete: resourceTencentMonitorBindingAlarmReceiverDelete,
+		Importer: &schema.ResourceImporter{
+			State: monitorBindingReceiverImportIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
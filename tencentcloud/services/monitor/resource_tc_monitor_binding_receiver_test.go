@@ -0,0 +1,64 @@
+package monitor_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudMonitorBindingReceiverResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorBindingReceiverBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_monitor_binding_receiver.receiver", "group_id"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_binding_receiver.receiver", "receivers.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_binding_receiver.receiver", "receivers.0.receiver_type", "group"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_monitor_binding_receiver.receiver",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccMonitorBindingReceiverBasic = `
+data "tencentcloud_cam_groups" "groups" {
+}
+
+resource "tencentcloud_monitor_policy_group" "group" {
+  group_name       = "tf_test_binding_receiver"
+  policy_view_name = "cvm_device"
+  remark           = "this is a test policy group"
+  conditions {
+    metric_id           = 33
+    alarm_notify_type   = 1
+    alarm_notify_period = 600
+    calc_type           = 1
+    calc_value          = 3
+    calc_period         = 300
+    continue_period     = 2
+  }
+}
+
+resource "tencentcloud_monitor_binding_receiver" "receiver" {
+  group_id = tencentcloud_monitor_policy_group.group.id
+  receivers {
+    start_time          = 0
+    end_time            = 86399
+    notify_way          = ["SMS"]
+    receiver_type       = "group"
+    receiver_group_list = [data.tencentcloud_cam_groups.groups.group_list[0].group_id]
+    receive_language    = "en-US"
+  }
+}
+`
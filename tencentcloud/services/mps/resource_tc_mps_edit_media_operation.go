@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsEditMediaOperationCreate,
 		Read:   resourceTencentCloudMpsEditMediaOperationRead,
 		Delete: resourceTencentCloudMpsEditMediaOperationDelete,
@@ -323,7 +324,7 @@ func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
 				Description: "The source context which is used to pass through the user request information. The task flow status change callback will return the value of this field. It can contain up to 1,000 characters.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsEditMediaOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -506,6 +507,7 @@ func resourceTencentCloudMpsEditMediaOperationCreate(d *schema.ResourceData, met
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
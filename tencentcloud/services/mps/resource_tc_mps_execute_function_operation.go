@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsExecuteFunctionOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsExecuteFunctionOperationCreate,
 		Read:   resourceTencentCloudMpsExecuteFunctionOperationRead,
 		Delete: resourceTencentCloudMpsExecuteFunctionOperationDelete,
@@ -32,7 +33,7 @@ func ResourceTencentCloudMpsExecuteFunctionOperation() *schema.Resource {
 				Description: "API parameter. Parameter format will depend on the actual function definition.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsExecuteFunctionOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -60,6 +61,7 @@ func resourceTencentCloudMpsExecuteFunctionOperationCreate(d *schema.ResourceDat
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsManageTaskOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsManageTaskOperationCreate,
 		Read:   resourceTencentCloudMpsManageTaskOperationRead,
 		Delete: resourceTencentCloudMpsManageTaskOperationDelete,
@@ -32,7 +33,7 @@ func ResourceTencentCloudMpsManageTaskOperation() *schema.Resource {
 				Description: "Video processing task ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsManageTaskOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsProcessLiveStreamOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsProcessLiveStreamOperationCreate,
 		Read:   resourceTencentCloudMpsProcessLiveStreamOperationRead,
 		Delete: resourceTencentCloudMpsProcessLiveStreamOperationDelete,
@@ -240,7 +241,7 @@ func ResourceTencentCloudMpsProcessLiveStreamOperation() *schema.Resource {
 				Description: "The scheme ID.Note 1: About `OutputStorage` and `OutputDir`:If an output storage and directory are specified for a subtask of the scheme, those output settings will be applied.If an output storage and directory are not specified for the subtasks of a scheme, the output parameters passed in the `ProcessMedia` API will be applied.Note 2: If `TaskNotifyConfig` is specified, the specified settings will be used instead of the default callback settings of the scheme.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsProcessLiveStreamOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -375,6 +376,7 @@ func resourceTencentCloudMpsProcessLiveStreamOperationCreate(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
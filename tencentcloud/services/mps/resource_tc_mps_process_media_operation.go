@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsProcessMediaOperationCreate,
 		Read:   resourceTencentCloudMpsProcessMediaOperationRead,
 		Delete: resourceTencentCloudMpsProcessMediaOperationDelete,
@@ -2629,7 +2630,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 				Description: "The task type. `Online` (default): A task that is executed immediately. `Offline`: A task that is executed when the system is idle (within three days by default).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsProcessMediaOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -4074,6 +4075,7 @@ func resourceTencentCloudMpsProcessMediaOperationCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
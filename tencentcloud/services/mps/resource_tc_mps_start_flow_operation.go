@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsStartFlowOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsStartFlowOperationCreate,
 		Read:   resourceTencentCloudMpsStartFlowOperationRead,
 		Delete: resourceTencentCloudMpsStartFlowOperationDelete,
@@ -31,7 +32,7 @@ func ResourceTencentCloudMpsStartFlowOperation() *schema.Resource {
 				Description: "`true`: start mps stream link flow; `false`: stop.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsStartFlowOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudMpsStartFlowOperationCreate(d *schema.ResourceData, met
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, startRequest.GetAction(), startRequest.ToJsonString(), result.ToJsonString())
+				operation.SetResponse(d, result)
 			}
 			return nil
 		})
@@ -77,6 +79,7 @@ func resourceTencentCloudMpsStartFlowOperationCreate(d *schema.ResourceData, met
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, stopRequest.GetAction(), stopRequest.ToJsonString(), result.ToJsonString())
+				operation.SetResponse(d, result)
 			}
 			return nil
 		})
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudMpsWithdrawsWatermarkOperationCreate,
 		Read:   resourceTencentCloudMpsWithdrawsWatermarkOperationRead,
 		Delete: resourceTencentCloudMpsWithdrawsWatermarkOperationDelete,
@@ -194,7 +195,7 @@ func ResourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 				Description: "The source context which is used to pass through the user request information. The task flow status change callback will return the value of this field.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -307,6 +308,7 @@ func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(d *schema.Resource
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
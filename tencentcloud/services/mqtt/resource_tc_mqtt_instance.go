@@ -21,6 +21,9 @@ func ResourceTencentCloudMqttInstance() *schema.Resource {
 		Read:   ResourceTencentCloudMqttInstanceRead,
 		Update: ResourceTencentCloudMqttInstanceUpdate,
 		Delete: ResourceTencentCloudMqttInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
 				Type:        schema.TypeString,
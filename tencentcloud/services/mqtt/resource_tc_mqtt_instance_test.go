@@ -42,6 +42,11 @@ func TestAccTencentCloudNeedFixMqttInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_mqtt_instance.example", "automatic_activation"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mqtt_instance.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -22,6 +22,9 @@ func ResourceTencentCloudOceanusJobConfig() *schema.Resource {
 		Read:   resourceTencentCloudOceanusJobConfigRead,
 		Update: resourceTencentCloudOceanusJobConfigUpdate,
 		Delete: resourceTencentCloudOceanusJobConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"job_id": {
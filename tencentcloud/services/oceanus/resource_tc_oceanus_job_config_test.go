@@ -38,6 +38,11 @@ func TestAccTencentCloudNeedFixOceanusJobConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_oceanus_job_config.example", "expert_mode_on"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_oceanus_job_config.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -22,6 +22,9 @@ func ResourceTencentCloudOceanusResource() *schema.Resource {
 		Read:   resourceTencentCloudOceanusResourceRead,
 		Update: resourceTencentCloudOceanusResourceUpdate,
 		Delete: resourceTencentCloudOceanusResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_loc": {
@@ -22,6 +22,9 @@ func ResourceTencentCloudOceanusResourceConfig() *schema.Resource {
 		Read:   resourceTencentCloudOceanusResourceConfigRead,
 		Update: resourceTencentCloudOceanusResourceConfigUpdate,
 		Delete: resourceTencentCloudOceanusResourceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -26,6 +26,11 @@ func TestAccTencentCloudNeedFixOceanusResourceConfigResource_basic(t *testing.T)
 					resource.TestCheckResourceAttrSet("tencentcloud_oceanus_resource_config.example", "work_space_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_oceanus_resource_config.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
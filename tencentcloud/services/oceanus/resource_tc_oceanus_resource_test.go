@@ -29,6 +29,11 @@ func TestAccTencentCloudNeedFixOceanusResourceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_oceanus_resource.example", "work_space_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_oceanus_resource.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -9,10 +9,11 @@ import (
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudPostgresqlAccountPrivilegesOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlAccountPrivilegesOperationCreate,
 		Read:   resourceTencentCloudPostgresqlAccountPrivilegesOperationRead,
 		Update: resourceTencentCloudPostgresqlAccountPrivilegesOperationUpdate,
@@ -102,7 +103,7 @@ func ResourceTencentCloudPostgresqlAccountPrivilegesOperation() *schema.Resource
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlAccountPrivilegesOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -188,6 +189,7 @@ func resourceTencentCloudPostgresqlAccountPrivilegesOperationCreate(d *schema.Re
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		return nil
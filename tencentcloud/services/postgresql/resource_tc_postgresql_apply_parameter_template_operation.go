@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,7 +13,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlApplyParameterTemplateOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlApplyParameterTemplateOperationCreate,
 		Read:   resourceTencentCloudPostgresqlApplyParameterTemplateOperationRead,
 		Delete: resourceTencentCloudPostgresqlApplyParameterTemplateOperationDelete,
@@ -30,7 +31,7 @@ func ResourceTencentCloudPostgresqlApplyParameterTemplateOperation() *schema.Res
 				Description: "Template ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlApplyParameterTemplateOperationCreate(d *schema.ResourceData, meta interface{}) error {
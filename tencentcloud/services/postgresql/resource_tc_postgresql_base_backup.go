@@ -22,6 +22,9 @@ func ResourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlBaseBackupRead,
 		Update: resourceTencentCloudPostgresqlBaseBackupUpdate,
 		Delete: resourceTencentCloudPostgresqlBaseBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -114,7 +117,7 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 	if len(idSplit) != 2 {
 		return fmt.Errorf("id is broken,%s", d.Id())
 	}
-	// dBInstanceId := idSplit[0]
+	dBInstanceId := idSplit[0]
 	baseBackupId := idSplit[1]
 
 	BaseBackup, err := service.DescribePostgresqlBaseBackupById(ctx, baseBackupId)
@@ -128,6 +131,8 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
+	_ = d.Set("db_instance_id", dBInstanceId)
+
 	if BaseBackup.Id != nil {
 		_ = d.Set("base_backup_id", BaseBackup.Id)
 	}
@@ -47,6 +47,11 @@ func TestAccTencentCloudPostgresqlBaseBackupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccPostgresqlBaseBackupObject, "new_expire_time", newExpireTime),
 				),
 			},
+			{
+				ResourceName:      testAccPostgresqlBaseBackupObject,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
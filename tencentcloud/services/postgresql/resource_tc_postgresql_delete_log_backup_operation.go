@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlDeleteLogBackupOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate,
 		Read:   resourceTencentCloudPostgresqlDeleteLogBackupOperationRead,
 		Delete: resourceTencentCloudPostgresqlDeleteLogBackupOperationDelete,
@@ -33,7 +34,7 @@ func ResourceTencentCloudPostgresqlDeleteLogBackupOperation() *schema.Resource {
 				Description: "Log backup ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -64,6 +65,7 @@ func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
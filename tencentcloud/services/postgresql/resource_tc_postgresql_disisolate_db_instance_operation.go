@@ -6,6 +6,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead,
 		Delete: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete,
@@ -54,7 +55,7 @@ func ResourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resou
 				Description: "Voucher ID list.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -104,6 +105,7 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -116,7 +118,7 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
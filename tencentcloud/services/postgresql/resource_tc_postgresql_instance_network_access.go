@@ -13,6 +13,7 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudPostgresqlInstanceNetworkAccess() *schema.Resource {
@@ -70,6 +71,8 @@ func resourceTencentCloudPostgresqlInstanceNetworkAccessCreate(d *schema.Resourc
 		subnetId     string
 		vip          string
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	if v, ok := d.GetOk("db_instance_id"); ok {
 		request.DBInstanceId = helper.String(v.(string))
@@ -120,42 +123,17 @@ func resourceTencentCloudPostgresqlInstanceNetworkAccessCreate(d *schema.Resourc
 	}
 
 	// wait & get vip
-	flowId := *response.Response.FlowId
-	flowRequest := postgresqlv20170312.NewDescribeTasksRequest()
-	flowRequest.TaskId = helper.Int64Uint64(flowId)
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlV20170312Client().DescribeTasksWithContext(ctx, flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
+	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	task, err := service.WaitForTask(ctx, uint64(*response.Response.FlowId))
+	if err == nil && task.TaskDetail != nil && task.TaskDetail.Output != nil {
+		outPutObj := make(map[string]interface{})
+		if e := json.Unmarshal([]byte(*task.TaskDetail.Output), &outPutObj); e != nil {
+			err = fmt.Errorf("Json unmarshall output error: %s.", e.Error())
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, flowRequest.GetAction(), flowRequest.ToJsonString(), result.ToJsonString())
-		}
-
-		if result == nil || result.Response == nil || result.Response.TaskSet == nil {
-			return resource.NonRetryableError(fmt.Errorf("Describe tasks failed, Response is nil."))
+			dBInstanceNetInfo := outPutObj["DBInstanceNetInfo"].(map[string]interface{})
+			vip = dBInstanceNetInfo["Ip"].(string)
 		}
-
-		if len(result.Response.TaskSet) == 0 {
-			return resource.RetryableError(fmt.Errorf("wait TaskSet init."))
-		}
-
-		if result.Response.TaskSet[0].Status != nil && *result.Response.TaskSet[0].Status == "Success" {
-			if result.Response.TaskSet[0].TaskDetail != nil && result.Response.TaskSet[0].TaskDetail.Output != nil {
-				outPutObj := make(map[string]interface{})
-				outputStr := *result.Response.TaskSet[0].TaskDetail.Output
-				e := json.Unmarshal([]byte(outputStr), &outPutObj)
-				if e != nil {
-					return resource.NonRetryableError(fmt.Errorf("Json unmarshall output error: %s.", e.Error()))
-				}
-
-				dBInstanceNetInfo := outPutObj["DBInstanceNetInfo"].(map[string]interface{})
-				vip = dBInstanceNetInfo["Ip"].(string)
-				return nil
-			}
-		}
-
-		return resource.RetryableError(fmt.Errorf("postgresql instance network access is running, status is %s.", *result.Response.TaskSet[0].Status))
-	})
+	}
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create postgresql instance network access failed, reason:%+v", logId, err)
@@ -232,6 +210,8 @@ func resourceTencentCloudPostgresqlInstanceNetworkAccessDelete(d *schema.Resourc
 		request  = postgresqlv20170312.NewDeleteDBInstanceNetworkAccessRequest()
 		response = postgresqlv20170312.NewDeleteDBInstanceNetworkAccessResponse()
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
 	if len(idSplit) != 4 {
@@ -269,31 +249,8 @@ func resourceTencentCloudPostgresqlInstanceNetworkAccessDelete(d *schema.Resourc
 	}
 
 	// wait
-	flowId := *response.Response.FlowId
-	flowRequest := postgresqlv20170312.NewDescribeTasksRequest()
-	flowRequest.TaskId = helper.Int64Uint64(flowId)
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlV20170312Client().DescribeTasksWithContext(ctx, flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
-		}
-
-		if result == nil || result.Response == nil || result.Response.TaskSet == nil {
-			return resource.NonRetryableError(fmt.Errorf("Describe tasks failed, Response is nil."))
-		}
-
-		if len(result.Response.TaskSet) == 0 {
-			return resource.RetryableError(fmt.Errorf("wait TaskSet init."))
-		}
-
-		if result.Response.TaskSet[0].Status != nil && *result.Response.TaskSet[0].Status == "Success" {
-			return nil
-		}
-
-		return resource.RetryableError(fmt.Errorf("postgresql instance network access is running, status is %s.", *result.Response.TaskSet[0].Status))
-	})
+	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	_, err = service.WaitForTask(ctx, uint64(*response.Response.FlowId))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete postgresql instance network access failed, reason:%+v", logId, err)
@@ -11,6 +11,7 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudPostgresqlInstanceSslConfig() *schema.Resource {
@@ -113,6 +114,8 @@ func resourceTencentCloudPostgresqlInstanceSslConfigUpdate(d *schema.ResourceDat
 		ctx          = tccommon.NewResourceLifeCycleHandleFuncContext(context.Background(), logId, d, meta)
 		dbInsntaceId = d.Id()
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	request := postgresv20170312.NewModifyDBInstanceSSLConfigRequest()
 	response := postgresv20170312.NewModifyDBInstanceSSLConfigResponse()
@@ -152,31 +155,8 @@ func resourceTencentCloudPostgresqlInstanceSslConfigUpdate(d *schema.ResourceDat
 	}
 
 	// wait
-	taskId := *response.Response.TaskId
-	taskRequest := postgresv20170312.NewDescribeTasksRequest()
-	taskRequest.TaskId = helper.Int64Uint64(taskId)
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlV20170312Client().DescribeTasksWithContext(ctx, taskRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, taskRequest.GetAction(), taskRequest.ToJsonString(), result.ToJsonString())
-		}
-
-		if result == nil || result.Response == nil || result.Response.TaskSet == nil {
-			return resource.NonRetryableError(fmt.Errorf("Describe tasks failed, Response is nil."))
-		}
-
-		if len(result.Response.TaskSet) == 0 {
-			return resource.RetryableError(fmt.Errorf("wait TaskSet init."))
-		}
-
-		if result.Response.TaskSet[0].Status != nil && *result.Response.TaskSet[0].Status == "Success" {
-			return nil
-		}
-
-		return resource.RetryableError(fmt.Errorf("postgresql instance ssl config is running, status is %s.", *result.Response.TaskSet[0].Status))
-	})
+	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	_, err = service.WaitForTask(ctx, uint64(*response.Response.TaskId))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgresql instance ssl config, reason:%+v", logId, err)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead,
 		Delete: resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource
 				Description: "List of resource IDs. Note that currently you cannot isolate multiple instances at the same time. Only one instance ID can be passed in here.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -60,6 +61,7 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.Re
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -72,7 +74,7 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.Re
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"isolated"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"isolated"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlModifyAccountRemarkOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate,
 		Read:   resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead,
 		Delete: resourceTencentCloudPostgresqlModifyAccountRemarkOperationDelete,
@@ -39,7 +40,7 @@ func ResourceTencentCloudPostgresqlModifyAccountRemarkOperation() *schema.Resour
 				Description: "New remarks corresponding to user `UserName`.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -71,6 +72,7 @@ func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(d *schema.
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlModifySwitchTimePeriodOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate,
 		Read:   resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead,
 		Delete: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationDelete,
@@ -35,7 +36,7 @@ func ResourceTencentCloudPostgresqlModifySwitchTimePeriodOperation() *schema.Res
 				Description: "Valid value: `0` (switch immediately).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(d *sche
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -2,9 +2,7 @@ package postgresql
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -12,18 +10,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// postgresqlReadonlyAttachmentIdFormat is the id of an attachment, which is also its import id.
+var postgresqlReadonlyAttachmentIdFormat = compositeid.New(compositeid.String("db_instance_id"), compositeid.String("read_only_group_id"))
+
 func ResourceTencentCloudPostgresqlReadonlyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlReadOnlyAttachmentCreate,
 		Read:   resourceTencentCloudPostgresqlReadOnlyAttachmentRead,
 		//Update: resourceTencentCloudPostgresqlReadOnlyAttachmentUpdate,
 		Delete: resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: postgresqlReadonlyAttachmentIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -75,8 +77,7 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(d *schema.ResourceDa
 	if err != nil {
 		return err
 	}
-	instanceId := helper.IdFormat(dbInstanceId, groupId)
-	d.SetId(instanceId)
+	d.SetId(postgresqlReadonlyAttachmentIdFormat.Join(dbInstanceId, groupId))
 
 	return nil
 }
@@ -87,12 +88,20 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentRead(d *schema.ResourceData
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
+	id, err := postgresqlReadonlyAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+
 	postgresqlService := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	_, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, d.Id())
+	_, err = postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, d.Id())
 	if err != nil {
 		return err
 	}
 
+	_ = d.Set("db_instance_id", id.Get("db_instance_id"))
+	_ = d.Set("read_only_group_id", id.Get("read_only_group_id"))
+
 	return nil
 }
 
@@ -102,16 +111,16 @@ func resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete(d *schema.ResourceDa
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	request := postgresql.NewRemoveDBInstanceFromReadOnlyGroupRequest()
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := postgresqlReadonlyAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	dbInstanceId := idSplit[0]
-	groupId := idSplit[1]
+	dbInstanceId := id.Get("db_instance_id")
+	groupId := id.Get("read_only_group_id")
 	request.ReadOnlyGroupId = helper.String(groupId)
 	request.DBInstanceId = helper.String(dbInstanceId)
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlClient().RemoveDBInstanceFromReadOnlyGroup(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -0,0 +1,76 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testPostgresqlReadonlyAttachmentResourceKey = "tencentcloud_postgresql_readonly_attachment.attach"
+
+// go test -i; go test -test.run TestAccTencentCloudPostgresqlReadonlyAttachmentResource_basic -v
+func TestAccTencentCloudPostgresqlReadonlyAttachmentResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlReadonlyAttachment,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testPostgresqlReadonlyAttachmentResourceKey, "id"),
+					resource.TestCheckResourceAttrSet(testPostgresqlReadonlyAttachmentResourceKey, "db_instance_id"),
+					resource.TestCheckResourceAttrSet(testPostgresqlReadonlyAttachmentResourceKey, "read_only_group_id"),
+				),
+			},
+			{
+				ResourceName:      testPostgresqlReadonlyAttachmentResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccPostgresqlReadonlyAttachment string = tcacctest.OperationPresetPGSQL + tcacctest.DefaultVpcSubnets + tcacctest.DefaultSecurityGroupData + `
+  resource "tencentcloud_postgresql_readonly_instance" "instance" {
+	auto_renew_flag       = 0
+	db_version            = "15.1"
+	instance_charge_type  = "POSTPAID_BY_HOUR"
+	master_db_instance_id = local.pgsql_id
+	memory                = 4
+	cpu                   = 2
+	name                  = "tf_ro_attachment_test"
+	need_support_ipv6     = 0
+	project_id            = 0
+	security_groups_ids   = [
+	  local.sg_id,
+	]
+	storage               = 20
+	vpc_id                = local.vpc_id
+	subnet_id             = local.subnet_id
+	zone                  = var.default_az
+  }
+
+  resource "tencentcloud_postgresql_readonly_group" "group" {
+	master_db_instance_id       = local.pgsql_id
+	name                        = "tf_ro_attachment_group_test"
+	project_id                  = 0
+	vpc_id                      = local.vpc_id
+	subnet_id                   = local.subnet_id
+	replay_lag_eliminate        = 1
+	replay_latency_eliminate    = 1
+	max_replay_lag              = 100
+	max_replay_latency          = 512
+	min_delay_eliminate_reserve = 1
+  }
+
+  resource "tencentcloud_postgresql_readonly_attachment" "attach" {
+	db_instance_id     = tencentcloud_postgresql_readonly_instance.instance.id
+	read_only_group_id = tencentcloud_postgresql_readonly_group.group.id
+  }
+`
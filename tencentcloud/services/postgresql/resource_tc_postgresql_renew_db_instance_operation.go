@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlRenewDbInstanceOperationRead,
 		Delete: resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete,
@@ -50,7 +51,7 @@ func ResourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
 				Description: "Voucher ID list (only one voucher can be specified currently).",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -92,6 +93,7 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -104,7 +106,7 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.Reso
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
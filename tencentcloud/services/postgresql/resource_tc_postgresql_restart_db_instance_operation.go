@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlRestartDbInstanceOperationRead,
 		Delete: resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete,
@@ -26,7 +27,7 @@ func ResourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource
 				Description: "dbInstance ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -50,6 +51,7 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.Re
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -62,7 +64,7 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.Re
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 2*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
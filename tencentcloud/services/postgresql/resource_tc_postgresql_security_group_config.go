@@ -21,6 +21,9 @@ func ResourceTencentCloudPostgresqlSecurityGroupConfig() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlSecurityGroupConfigRead,
 		Update: resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
 		Delete: resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id_set": {
 				Required: true,
@@ -101,6 +104,12 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigRead(d *schema.ResourceDat
 			sgIDList = append(sgIDList, sg.SecurityGroupId)
 		}
 	}
+	if dBInstanceId != "" {
+		_ = d.Set("db_instance_id", dBInstanceId)
+	}
+	if readOnlyGroupId != "" {
+		_ = d.Set("read_only_group_id", readOnlyGroupId)
+	}
 	_ = d.Set("security_group_id_set", sgIDList)
 
 	return nil
@@ -31,6 +31,11 @@ func TestAccTencentCloudPostgresqlSecurityGroupConfigResource_basic(t *testing.T
 					resource.TestCheckResourceAttrSet(TestAccPostgresqlSecurityGroupConfigObject, "db_instance_id"),
 				),
 			},
+			{
+				ResourceName:      TestAccPostgresqlSecurityGroupConfigObject,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -2426,3 +2427,40 @@ func (me *PostgresqlService) DescribePostgresqlTimeWindowById(ctx context.Contex
 	ret = response.Response
 	return
 }
+
+// WaitForTask waits for the task of taskId to succeed until ctx is done, and returns the task, whose
+// TaskDetail holds the output of the task.
+func (me *PostgresqlService) WaitForTask(ctx context.Context, taskId uint64) (task *postgresql.TaskSet, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := postgresql.NewDescribeTasksRequest()
+	request.TaskId = &taskId
+
+	_, errRet = (&waiter.Waiter{
+		Name:   fmt.Sprintf("postgresql task %d", taskId),
+		Target: []string{"Success"},
+		Failed: []string{"Failed"},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			ratelimit.Check(request.GetAction())
+			response, err := me.client.UsePostgresqlClient().DescribeTasks(request)
+			if err != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+				return nil, err
+			}
+			// the task may not be listed right after it is started.
+			if response == nil || response.Response == nil || len(response.Response.TaskSet) == 0 {
+				return nil, nil
+			}
+			task = response.Response.TaskSet[0]
+			status := &waiter.Status{State: helper.PString(task.Status)}
+			if task.Progress != nil {
+				status.Percent = int(*task.Progress)
+			}
+			if task.TaskDetail != nil {
+				status.Step = helper.PString(task.TaskDetail.CurrentStep)
+				status.Message = helper.PString(task.TaskDetail.Message)
+			}
+			return status, nil
+		},
+	}).Wait(ctx)
+	return
+}
@@ -41,6 +41,10 @@ const (
 	SCF_FUNCTION_DESCRIBE_LIMIT  = 20
 	SCF_NAMESPACE_DESCRIBE_LIMIT = 20
 
+	// SCF_FUNCTION_ZIP_FILE_LIMIT is the limit of the base64 encoded zip file uploaded inline.
+	SCF_FUNCTION_ZIP_FILE_LIMIT = 20 << 20
+	SCF_FUNCTION_STAGING_PREFIX = "terraform-scf-staging"
+
 	SCF_FUNCTION_OPEN  = "TRUE"
 	SCF_FUNCTION_CLOSE = "FALSE"
 )
@@ -2,12 +2,9 @@ package scf
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"os"
 	"strings"
 	"time"
 	"unicode"
@@ -18,10 +15,11 @@ import (
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/mitchellh/go-homedir"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
 )
 
 func scfFunctionValidate(allowDot bool) schema.SchemaValidateFunc {
@@ -56,7 +54,7 @@ func scfFunctionValidate(allowDot bool) schema.SchemaValidateFunc {
 }
 
 func ResourceTencentCloudScfFunction() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceTencentCloudScfFunctionCreate,
 		Read:   resourceTencentCloudScfFunctionRead,
 		Update: resourceTencentCloudScfFunctionUpdate,
@@ -64,6 +62,8 @@ func ResourceTencentCloudScfFunction() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceTencentCloudScfFunctionCustomizeDiff,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -218,20 +218,20 @@ func ResourceTencentCloudScfFunction() *schema.Resource {
 			"cos_bucket_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file", "image_config"},
+				ConflictsWith: []string{"zip_file", "source_dir", "image_config"},
 				Description:   "Cos bucket name of the SCF function, such as `cos-1234567890`, conflict with `zip_file`.",
 			},
 			"cos_object_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file", "image_config"},
+				ConflictsWith: []string{"zip_file", "source_dir", "image_config"},
 				ValidateFunc:  tccommon.ValidateStringSuffix(".zip", ".jar"),
 				Description:   "Cos object name of the SCF function, should have suffix `.zip` or `.jar`, conflict with `zip_file`.",
 			},
 			"cos_bucket_region": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"zip_file", "image_config"},
+				ConflictsWith: []string{"zip_file", "source_dir", "image_config"},
 				Description:   "Cos bucket region of the SCF function, conflict with `zip_file`.",
 			},
 
@@ -239,15 +239,86 @@ func ResourceTencentCloudScfFunction() *schema.Resource {
 			"zip_file": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"cos_bucket_name", "cos_object_name", "cos_bucket_region", "image_config"},
+				ConflictsWith: []string{"cos_bucket_name", "cos_object_name", "cos_bucket_region", "source_dir", "image_config"},
 				Description:   "Zip file of the SCF function, conflict with `cos_bucket_name`, `cos_object_name`, `cos_bucket_region`.",
 			},
 
+			// source directory
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"cos_bucket_name", "cos_object_name", "cos_bucket_region", "zip_file", "image_config"},
+				Description:   "Directory of the source code of the SCF function, which is packaged into a zip file with fixed timestamps and ordering, so that the package only changes with the content of the files. Conflict with `cos_bucket_name`, `cos_object_name`, `cos_bucket_region`, `zip_file`.",
+			},
+			"source_includes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files in `source_dir` to be packaged, relative to `source_dir`. `**` matches any directories, and a pattern matching a directory matches all files in it. All files are packaged by default.",
+			},
+			"source_excludes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files in `source_dir` not to be packaged, in the same format as `source_includes`, such as `.git` and `**/*.pyc`.",
+			},
+			"staging_cos_bucket_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cos bucket in the region of the provider, such as `cos-1234567890`, to stage the package of `source_dir` or `zip_file` when it is too large to be uploaded inline (over 20 MB after base64 encoding).",
+			},
+			"source_code_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64-encoded SHA-256 hash of the package of `source_dir` or `zip_file`. The code of the function is updated when it changes. It is unknown when planning if `zip_file` does not exist yet, such as when it is built during the apply.",
+			},
+
+			// publish
+			"publish": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to publish a new version of the SCF function whenever its code changes. Default `false`.",
+			},
+			"alias_traffic_shift": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Shift the traffic of an alias to the newly published version step by step. Only works with `publish` enabled. If a step fails, all traffic of the alias is routed back to the version before.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the alias, such as the `name` of a `tencentcloud_scf_function_alias`.",
+						},
+						"weights": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(1, 99)},
+							Description: "Percentages of the traffic routed to the new version at each step, such as `[10, 50]`. All traffic is routed to the new version after the last step.",
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(0, 3600),
+							Description:  "Seconds to wait after each step. Default `60`.",
+						},
+					},
+				},
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest version published by `publish`.",
+			},
+
 			// image
 			"image_config": {
 				Type:          schema.TypeList,
 				Optional:      true,
-				ConflictsWith: []string{"cos_bucket_name", "cos_object_name", "cos_bucket_region", "zip_file"},
+				ConflictsWith: []string{"cos_bucket_name", "cos_object_name", "cos_bucket_region", "zip_file", "source_dir"},
 				Description:   "Image of the SCF function, conflict with `cos_bucket_name`, `cos_object_name`, `cos_bucket_region`, `zip_file`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -529,6 +600,10 @@ func ResourceTencentCloudScfFunction() *schema.Resource {
 			},
 		},
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{
+		stateupgrade.Upgrader(0, resourceTencentCloudScfFunctionV0(resource), stateupgrade.Default("publish", false)),
+	}
+	return resource
 }
 
 func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}) error {
@@ -654,24 +729,11 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 		}
 	}
 
-	if raw, ok := d.GetOk("zip_file"); ok {
-		path, err := homedir.Expand(raw.(string))
-		if err != nil {
-			return fmt.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("zip file (%s) open error: %s", path, err.Error())
-		}
-		defer file.Close()
-		body, err := ioutil.ReadAll(file)
-		if err != nil {
-			return fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
-		}
-
+	if _, ok := d.GetOk("zip_file"); ok {
+		codeType = scfFunctionZipFileCode
+	}
+	if _, ok := d.GetOk("source_dir"); ok {
 		codeType = scfFunctionZipFileCode
-		content := base64.StdEncoding.EncodeToString(body)
-		functionInfo.zipFile = &content
 	}
 
 	var imageConfigs = make([]*scf.ImageConfig, 0)
@@ -712,6 +774,12 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 		}
 
 	case scfFunctionZipFileCode:
+		hash, err := scfFunctionStageCode(ctx, d, m, &functionInfo)
+		if err != nil {
+			return err
+		}
+		_ = d.Set("source_code_hash", hash)
+
 	case scfFunctionImageCode:
 	default:
 		return errors.New("no function code set")
@@ -845,6 +913,12 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 	functionId = *funcResp.Response.FunctionId
 	_ = d.Set("function_id", functionId)
 
+	if d.Get("publish").(bool) {
+		if err := scfFunctionPublish(ctx, d, &scfService, functionInfo.name, *functionInfo.namespace); err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudScfFunctionRead(d, m)
 }
 
@@ -1103,23 +1177,12 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	if d.HasChange("zip_file") {
 		updateAttrs = append(updateAttrs, "zip_file")
 	}
-	if raw, ok := d.GetOk("zip_file"); ok {
-		path, err := homedir.Expand(raw.(string))
-		if err != nil {
-			return fmt.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("zip file (%s) open error: %s", path, err.Error())
-		}
-		defer file.Close()
-		body, err := ioutil.ReadAll(file)
-		if err != nil {
-			return fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
-		}
-
-		content := base64.StdEncoding.EncodeToString(body)
-		functionInfo.zipFile = &content
+	if d.HasChange("source_dir") {
+		updateAttrs = append(updateAttrs, "source_dir")
+	}
+	// the hash is only recorded, not deployed, when it is first computed for an existing function.
+	if o, _ := d.GetChange("source_code_hash"); d.HasChange("source_code_hash") && o.(string) != "" {
+		updateAttrs = append(updateAttrs, "source_code_hash")
 	}
 
 	if d.HasChange("image_config") {
@@ -1193,6 +1256,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	}
 
 	// update function code
+	codeUpdated := false
 	if len(updateAttrs) > 0 {
 		if len(updateAttrs) == 0 && updateAttrs[0] == "handler" {
 			return errors.New("can't only change handler")
@@ -1200,11 +1264,17 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 		functionInfo.handler = helper.String(d.Get("handler").(string))
 
+		if d.Get("zip_file").(string) != "" || d.Get("source_dir").(string) != "" {
+			if _, err := scfFunctionStageCode(ctx, d, m, &functionInfo); err != nil {
+				return err
+			}
+		}
+
 		if err := scfService.ModifyFunctionCode(ctx, functionInfo); err != nil {
 			log.Printf("[CRITAL]%s update function code failed: %+v", logId, err)
 			return err
 		}
-
+		codeUpdated = true
 	}
 
 	updateAttrs = updateAttrs[:0]
@@ -1356,6 +1426,13 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		}
 	}
 
+	// publish after the configuration is updated, so that the version has both.
+	if codeUpdated && d.Get("publish").(bool) {
+		if err := scfFunctionPublish(ctx, d, &scfService, name, namespace); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("triggers") {
 		oldRaw, newRaw := d.GetChange("triggers")
 		oldSet := oldRaw.(*schema.Set)
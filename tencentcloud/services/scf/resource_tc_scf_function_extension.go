@@ -0,0 +1,267 @@
+package scf
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	svccos "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cos"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sourcezip"
+)
+
+// resourceTencentCloudScfFunctionV0 returns the function of schema version 0, which was neither
+// packaged from source_dir nor published. As publish was absent from its state, the upgrade sets
+// the default of publish, which would otherwise show in the first plan.
+func resourceTencentCloudScfFunctionV0(current *schema.Resource) *schema.Resource {
+	added := map[string]bool{
+		"source_dir":              true,
+		"source_includes":         true,
+		"source_excludes":         true,
+		"staging_cos_bucket_name": true,
+		"source_code_hash":        true,
+		"publish":                 true,
+		"alias_traffic_shift":     true,
+		"version":                 true,
+	}
+	prior := make(map[string]*schema.Schema, len(current.Schema))
+	for name, s := range current.Schema {
+		if !added[name] {
+			prior[name] = s
+		}
+	}
+	return &schema.Resource{Schema: prior}
+}
+
+// scfFunctionGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type scfFunctionGetter interface {
+	Get(key string) interface{}
+}
+
+// scfFunctionCodeKeys are the arguments deployed by updating the code of the function.
+var scfFunctionCodeKeys = []string{
+	"handler", "cos_bucket_name", "cos_object_name", "cos_bucket_region", "zip_file", "source_dir", "image_config", "cfs_config",
+}
+
+func resourceTencentCloudScfFunctionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("alias_traffic_shift").([]interface{})) > 0 && !d.Get("publish").(bool) {
+		return fmt.Errorf("`alias_traffic_shift` only works with `publish` enabled")
+	}
+
+	codeChanged := d.HasChanges(scfFunctionCodeKeys...)
+
+	known := true
+	for _, key := range []string{"source_dir", "source_includes", "source_excludes", "zip_file"} {
+		known = known && d.NewValueKnown(key)
+	}
+	// a zip file built during the apply is hashed when it is deployed.
+	if known && d.Get("zip_file").(string) != "" && d.Get("source_dir").(string) == "" {
+		path, err := homedir.Expand(d.Get("zip_file").(string))
+		if err != nil {
+			return fmt.Errorf("zip file (%s) homedir expand error: %s", d.Get("zip_file").(string), err.Error())
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			known = false
+		}
+	}
+	if !known {
+		if err := d.SetNewComputed("source_code_hash"); err != nil {
+			return err
+		}
+		codeChanged = true
+	} else {
+		body, err := scfFunctionPackage(d)
+		if err != nil {
+			return err
+		}
+
+		hash := ""
+		if body != nil {
+			hash = sourcezip.Hash(body)
+		}
+		if old := d.Get("source_code_hash").(string); hash != old {
+			if err := d.SetNew("source_code_hash", hash); err != nil {
+				return err
+			}
+			codeChanged = codeChanged || old != ""
+		}
+	}
+
+	// a code change publishes a new version.
+	if codeChanged && d.Id() != "" && d.Get("publish").(bool) {
+		return d.SetNewComputed("version")
+	}
+	return nil
+}
+
+// scfFunctionPackage returns the zip package of the function, built from `source_dir` or read from
+// `zip_file`, and nil when the code comes from elsewhere.
+func scfFunctionPackage(d scfFunctionGetter) ([]byte, error) {
+	if dir := d.Get("source_dir").(string); dir != "" {
+		path, err := homedir.Expand(dir)
+		if err != nil {
+			return nil, fmt.Errorf("source dir (%s) homedir expand error: %s", dir, err.Error())
+		}
+		body, err := sourcezip.Build(path,
+			helper.InterfacesStrings(d.Get("source_includes").([]interface{})),
+			helper.InterfacesStrings(d.Get("source_excludes").([]interface{})))
+		if err != nil {
+			return nil, fmt.Errorf("source dir (%s) package error: %s", dir, err.Error())
+		}
+		return body, nil
+	}
+
+	if file := d.Get("zip_file").(string); file != "" {
+		path, err := homedir.Expand(file)
+		if err != nil {
+			return nil, fmt.Errorf("zip file (%s) homedir expand error: %s", file, err.Error())
+		}
+		body, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
+		}
+		return body, nil
+	}
+
+	return nil, nil
+}
+
+// scfFunctionStageCode sets the package of the function to info, inline when it is within the
+// limit of the API, otherwise uploaded to the staging COS bucket first. It returns the hash of
+// the package, which must be the planned one.
+func scfFunctionStageCode(ctx context.Context, d *schema.ResourceData, meta interface{}, info *scfFunctionInfo) (string, error) {
+	body, err := scfFunctionPackage(d)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sourcezip.Hash(body)
+	if planned := d.Get("source_code_hash").(string); planned != "" && planned != hash {
+		return "", fmt.Errorf("the code of function %s has changed since the plan, please plan again", info.name)
+	}
+
+	if base64.StdEncoding.EncodedLen(len(body)) <= SCF_FUNCTION_ZIP_FILE_LIMIT {
+		content := base64.StdEncoding.EncodeToString(body)
+		info.zipFile = &content
+		return hash, nil
+	}
+
+	bucket := d.Get("staging_cos_bucket_name").(string)
+	if bucket == "" {
+		return "", fmt.Errorf("the package of function %s is %d bytes, over the limit of inline upload, `staging_cos_bucket_name` is required", info.name, len(body))
+	}
+	key := fmt.Sprintf("%s/%s/%s/%x.zip", SCF_FUNCTION_STAGING_PREFIX, *info.namespace, info.name, sha256.Sum256(body))
+
+	cosService := svccos.NewCosService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		if e := cosService.PutObject(ctx, bucket, key, bytes.NewReader(body)); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// to remove string like -1234567890 from bucket id
+	split := strings.Split(bucket, "-")
+	if len(split) > 1 {
+		bucket = strings.Join(split[:len(split)-1], "-")
+	}
+	info.cosBucketName = helper.String(bucket)
+	info.cosObjectName = helper.String("/" + key)
+	info.cosBucketRegion = helper.String(meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+	return hash, nil
+}
+
+// scfFunctionPublish publishes a version of the function, and shifts the traffic of the alias in
+// `alias_traffic_shift` to it gradually.
+func scfFunctionPublish(ctx context.Context, d *schema.ResourceData, service *ScfService, name, namespace string) error {
+	logId := tccommon.GetLogId(ctx)
+
+	var version string
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := service.PublishFunctionVersion(ctx, name, namespace, d.Get("description").(string))
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		version = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s publish function version failed: %+v", logId, err)
+		return err
+	}
+	if err := waitScfFunctionReady(ctx, name, namespace, service.client.UseScfClient()); err != nil {
+		return err
+	}
+	_ = d.Set("version", version)
+
+	shift, ok := helper.InterfacesHeadMap(d, "alias_traffic_shift")
+	if !ok {
+		return nil
+	}
+	alias := shift["alias_name"].(string)
+
+	response, err := service.DescribeScfFunctionAliasById(ctx, namespace, name, alias)
+	if err != nil {
+		if sdkErr := helper.UnwarpSDKError(err); sdkErr != nil && strings.HasPrefix(sdkErr.Code, "ResourceNotFound") {
+			log.Printf("[WARN]%s alias %s of function %s not found, skip shifting its traffic", logId, alias, name)
+			return nil
+		}
+		return err
+	}
+	current := helper.PString(response.Response.FunctionVersion)
+	if current == version {
+		return nil
+	}
+
+	setWeight := func(to, additional string, weight float64) error {
+		return resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			if e := service.ModifyFunctionAliasWeight(ctx, namespace, name, alias, to, additional, weight); e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+	}
+
+	// a shift failing midway routes all traffic of the alias back to the version before, so that
+	// the alias is never left half shifted.
+	rollback := func(err error) error {
+		if e := setWeight(current, "", 0); e != nil {
+			log.Printf("[CRITAL]%s roll back alias %s to version %s failed: %+v", logId, alias, current, e)
+			return fmt.Errorf("%v, and rolling back alias %s to version %s failed: %v", err, alias, current, e)
+		}
+		log.Printf("[DEBUG]%s alias %s rolled back to version %s", logId, alias, current)
+		return fmt.Errorf("%v, alias %s is rolled back to version %s", err, alias, current)
+	}
+
+	interval := time.Duration(shift["interval"].(int)) * time.Second
+	for _, raw := range shift["weights"].([]interface{}) {
+		if err := setWeight(current, version, float64(raw.(int))/100); err != nil {
+			log.Printf("[CRITAL]%s shift %d%% traffic of alias %s to version %s failed: %+v", logId, raw.(int), alias, version, err)
+			return rollback(err)
+		}
+		log.Printf("[DEBUG]%s %d%% traffic of alias %s shifted to version %s", logId, raw.(int), alias, version)
+		time.Sleep(interval)
+	}
+
+	if err := setWeight(version, "", 0); err != nil {
+		log.Printf("[CRITAL]%s shift all traffic of alias %s to version %s failed: %+v", logId, alias, version, err)
+		return rollback(err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"strings"
@@ -84,6 +85,37 @@ func init() {
 	})
 }
 
+func TestUnitScfFunctionStateUpgradeV0(t *testing.T) {
+	t.Parallel()
+	r := svcscf.ResourceTencentCloudScfFunction()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("expected one upgrader to schema version 1, got version %d and %d upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	upgrader := r.StateUpgraders[0]
+	if upgrader.Version != 0 {
+		t.Fatalf("expected the upgrader of version 0, got %d", upgrader.Version)
+	}
+	if upgrader.Type.HasAttribute("publish") || !upgrader.Type.HasAttribute("handler") {
+		t.Fatalf("expected the schema of version 0 without publish, got %s", upgrader.Type.FriendlyName())
+	}
+
+	state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"name": "foo", "handler": "first.do_it"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["publish"] != false || state["name"] != "foo" {
+		t.Fatalf("unexpected upgraded state %v", state)
+	}
+
+	state, err = upgrader.Upgrade(context.Background(), map[string]interface{}{"name": "foo", "publish": true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["publish"] != true {
+		t.Fatalf("expected publish to be kept, got %v", state["publish"])
+	}
+}
+
 func TestAccTencentCloudScfFunction_basic(t *testing.T) {
 	t.Parallel()
 	var fnId string
@@ -164,6 +196,7 @@ func TestAccTencentCloudScfFunction_basic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"zip_file",
+					"source_code_hash",
 				},
 			},
 		},
@@ -243,6 +276,77 @@ func TestAccTencentCloudScfFunction_cos(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudScfFunction_sourceDir(t *testing.T) {
+	t.Parallel()
+	var fnId string
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "index.py")
+	writeSource := func(content string) {
+		if err := os.WriteFile(source, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("def main_handler(event, context):\n    return 'first'\n")
+	if err := os.WriteFile(filepath.Join(dir, "index.pyc"), []byte("compiled"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// for unit test run on windows
+	path := dir
+	if runtime.GOOS == "windows" {
+		path = strings.Replace(path, "\\", "\\\\", -1)
+	}
+	config := fmt.Sprintf(testAccScfFunctionSourceDir, scfFunctionRandomName(), path)
+
+	var firstHash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { tcacctest.AccPreCheck(t) },
+		Providers:    tcacctest.AccProviders,
+		CheckDestroy: testAccCheckScfFunctionDestroy(&fnId),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScfFunctionExists("tencentcloud_scf_function.foo", &fnId),
+					resource.TestCheckResourceAttrSet("tencentcloud_scf_function.foo", "source_code_hash"),
+					resource.TestCheckResourceAttr("tencentcloud_scf_function.foo", "version", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_scf_function.foo", "code_result", "success"),
+					func(s *terraform.State) error {
+						firstHash = s.RootModule().Resources["tencentcloud_scf_function.foo"].Primary.Attributes["source_code_hash"]
+						return nil
+					},
+				),
+			},
+			{
+				// touching the sources or changing the excluded files changes nothing.
+				PreConfig: func() {
+					later := time.Now().Add(time.Hour)
+					_ = os.Chtimes(source, later, later)
+					_ = os.WriteFile(filepath.Join(dir, "index.pyc"), []byte("recompiled"), 0644)
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() { writeSource("def main_handler(event, context):\n    return 'second'\n") },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScfFunctionExists("tencentcloud_scf_function.foo", &fnId),
+					resource.TestCheckResourceAttr("tencentcloud_scf_function.foo", "version", "2"),
+					func(s *terraform.State) error {
+						hash := s.RootModule().Resources["tencentcloud_scf_function.foo"].Primary.Attributes["source_code_hash"]
+						if hash == firstHash {
+							return fmt.Errorf("source_code_hash %s is not changed with the sources", hash)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccTencentCloudScfFunction_role(t *testing.T) {
 	t.Parallel()
 	var fnId string
@@ -475,6 +579,7 @@ func TestAccTencentCloudScfFunction_fs(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"zip_file",
+					"source_code_hash",
 				},
 			},
 		},
@@ -680,6 +785,18 @@ resource "tencentcloud_scf_function" "foo" {
 }`, tcacctest.DefaultSCFCosBucket, codeSource, codeSource, scfFunctionRandomName())
 }
 
+const testAccScfFunctionSourceDir = `
+resource "tencentcloud_scf_function" "foo" {
+  name    = "%s"
+  handler = "index.main_handler"
+  runtime = "Python3.6"
+
+  source_dir      = "%s"
+  source_excludes = ["**/*.pyc"]
+  publish         = true
+}
+`
+
 const DefaultScfRoleName1 = "preset-scf-role"
 const DefaultScfRoleName2 = "preset-scf-role-new"
 
@@ -21,6 +21,9 @@ func ResourceTencentCloudScfProvisionedConcurrencyConfig() *schema.Resource {
 		Create: resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
 		Read:   resourceTencentCloudScfProvisionedConcurrencyConfigRead,
 		Delete: resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -20,6 +20,11 @@ func TestAccTencentCloudScfProvisionedConcurrencyConfigResource_basic(t *testing
 				Config: testAccScfProvisionedConcurrencyConfig,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_scf_provisioned_concurrency_config.provisioned_concurrency_config", "id")),
 			},
+			{
+				ResourceName:      "tencentcloud_scf_provisioned_concurrency_config.provisioned_concurrency_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
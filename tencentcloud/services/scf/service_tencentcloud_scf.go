@@ -1425,3 +1425,74 @@ func (me *ScfService) DescribeScfCustomDomainById(ctx context.Context, domain st
 	ret = response.Response
 	return
 }
+
+func (me *ScfService) PublishFunctionVersion(ctx context.Context, name, namespace, desc string) (version string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := scf.NewPublishVersionRequest()
+	request.FunctionName = &name
+	request.Namespace = &namespace
+	if desc != "" {
+		request.Description = &desc
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseScfClient().PublishVersion(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.FunctionVersion == nil {
+		errRet = fmt.Errorf("function %s has no version published", name)
+		return
+	}
+	version = *response.Response.FunctionVersion
+	return
+}
+
+// ModifyFunctionAliasWeight routes weight of the traffic of the alias to additionalVersion,
+// and the rest to version. The alias is routed to version only when additionalVersion is empty.
+func (me *ScfService) ModifyFunctionAliasWeight(ctx context.Context, namespace, functionName, name, version, additionalVersion string, weight float64) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := scf.NewUpdateAliasRequest()
+	request.Namespace = &namespace
+	request.FunctionName = &functionName
+	request.Name = &name
+	request.FunctionVersion = &version
+	request.RoutingConfig = &scf.RoutingConfig{
+		AdditionalVersionWeights: []*scf.VersionWeight{},
+	}
+	if additionalVersion != "" {
+		request.RoutingConfig.AdditionalVersionWeights = append(request.RoutingConfig.AdditionalVersionWeights, &scf.VersionWeight{
+			Version: &additionalVersion,
+			Weight:  &weight,
+		})
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseScfClient().UpdateAlias(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
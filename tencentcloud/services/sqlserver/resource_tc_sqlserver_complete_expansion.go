@@ -1,6 +1,7 @@
 package sqlserver
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,6 +12,7 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
@@ -19,6 +21,9 @@ func ResourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
 		Read:   resourceTencentCloudSqlserverCompleteExpansionRead,
 		Delete: resourceTencentCloudSqlserverCompleteExpansionDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -35,13 +40,16 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		request     = sqlserver.NewCompleteExpansionRequest()
-		response    = sqlserver.NewCompleteExpansionResponse()
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
-		instanceId  string
-		flowId      int64
+		logId      = tccommon.GetLogId(tccommon.ContextNil)
+		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service    = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request    = sqlserver.NewCompleteExpansionRequest()
+		response   = sqlserver.NewCompleteExpansionResponse()
+		instanceId string
+		flowId     int64
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -71,24 +79,7 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 		return err
 	}
 
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver completeExpansion status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver completeExpansion status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver completeExpansion status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver completeExpansion failed, reason:%+v", logId, err)
@@ -13,6 +13,7 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudSqlserverConfigDatabaseCDC() *schema.Resource {
@@ -24,6 +25,9 @@ func ResourceTencentCloudSqlserverConfigDatabaseCDC() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_name": {
 				Required:    true,
@@ -120,11 +124,14 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		request     = sqlserver.NewModifyDatabaseCDCRequest()
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
-		flowId      int64
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = sqlserver.NewModifyDatabaseCDCRequest()
+		flowId  int64
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
 	if len(idSplit) != 2 {
@@ -169,23 +176,7 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 		return err
 	}
 
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver configDatabaseCDC status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver configDatabaseCDC status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver configDatabaseCDC status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver configDatabaseCDC failed, reason:%+v", logId, err)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,9 @@ func ResourceTencentCloudSqlserverConfigDatabaseMdf() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_name": {
 				Required:    true,
@@ -106,11 +110,14 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		request     = sqlserver.NewModifyDatabaseMdfRequest()
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
-		flowId      int64
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = sqlserver.NewModifyDatabaseMdfRequest()
+		flowId  int64
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
 	if len(idSplit) != 2 {
@@ -146,24 +153,7 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 		return err
 	}
 
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver configDatabaseMdf status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver configDatabaseMdf status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver configDatabaseMdf status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver configDatabaseMdf failed, reason:%+v", logId, err)
@@ -12,6 +12,7 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func ResourceTencentCloudSqlserverGeneralCommunication() *schema.Resource {
@@ -22,6 +23,10 @@ func ResourceTencentCloudSqlserverGeneralCommunication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+			Delete: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -38,11 +43,14 @@ func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceD
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		request     = sqlserver.NewOpenInterCommunicationRequest()
-		response    = sqlserver.NewOpenInterCommunicationResponse()
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
+		logId    = tccommon.GetLogId(tccommon.ContextNil)
+		ctx      = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service  = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request  = sqlserver.NewOpenInterCommunicationRequest()
+		response = sqlserver.NewOpenInterCommunicationResponse()
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutCreate)
+	defer cancel()
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceIdSet = append(request.InstanceIdSet, helper.String(v.(string)))
@@ -72,23 +80,7 @@ func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceD
 
 	instanceId := *response.Response.InterInstanceFlowSet[0].InstanceId
 	flowId := *response.Response.InterInstanceFlowSet[0].FlowId
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver generalCommunication status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver bgeneralCommunication status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver generalCommunication status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver generalCommunication failed, reason:%+v", logId, err)
@@ -134,12 +126,13 @@ func resourceTencentCloudSqlserverGeneralCommunicationDelete(d *schema.ResourceD
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		ctx         = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
-		service     = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
-		instanceId  = d.Id()
+		logId      = tccommon.GetLogId(tccommon.ContextNil)
+		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service    = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		instanceId = d.Id()
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutDelete)
+	defer cancel()
 
 	flowId, err := service.DeleteSqlserverGeneralCommunicationById(ctx, instanceId)
 	if err != nil {
@@ -147,23 +140,7 @@ func resourceTencentCloudSqlserverGeneralCommunicationDelete(d *schema.ResourceD
 		return err
 	}
 
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver generalCommunication status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver bgeneralCommunication status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver generalCommunication status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete sqlserver generalCommunication status failed, reason:%+v", logId, err)
@@ -14,6 +14,7 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func TencentSqlServerBasicInfo(isROInstance bool) map[string]*schema.Schema {
@@ -218,6 +219,9 @@ func ResourceTencentCloudSqlserverInstance() *schema.Resource {
 				"auto_voucher": 0,
 			}),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * tccommon.WriteRetryTimeout),
+		},
 		Schema: specialInfo,
 	}
 }
@@ -452,12 +456,15 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 
 func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		logId       = tccommon.GetLogId(tccommon.ContextNil)
-		request     = sqlserver.NewModifyDBInstanceNetworkRequest()
-		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
-		flowId      int64
-		instanceId  = d.Id()
+		logId      = tccommon.GetLogId(tccommon.ContextNil)
+		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service    = SqlserverService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request    = sqlserver.NewModifyDBInstanceNetworkRequest()
+		flowId     int64
+		instanceId = d.Id()
 	)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	if d.HasChange("vpc_id") || d.HasChange("subnet_id") {
 		vpcId := d.Get("vpc_id").(string)
@@ -487,24 +494,7 @@ func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) err
 			return err
 		}
 
-		flowRequest.FlowId = &flowId
-		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-			if e != nil {
-				return tccommon.RetryError(e)
-			}
-
-			if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-				return nil
-			} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-				return resource.RetryableError(fmt.Errorf("sqlserver configInstanceNetwork status is running"))
-			} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-				return resource.NonRetryableError(fmt.Errorf("sqlserver configInstanceNetwork status is fail"))
-			} else {
-				e = fmt.Errorf("sqlserver configInstanceNetwork status illegal")
-				return resource.NonRetryableError(e)
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create sqlserver configInstanceNetwork failed, reason:%+v", logId, err)
@@ -20,6 +20,9 @@ func ResourceTencentCloudSqlserverInstanceSsl() *schema.Resource {
 		Read:   resourceTencentCloudSqlserverInstanceSslRead,
 		Update: resourceTencentCloudSqlserverInstanceSslUpdate,
 		Delete: resourceTencentCloudSqlserverInstanceSslDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -40,6 +40,11 @@ func TestAccTencentCloudSqlserverInstanceSslResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_sqlserver_instance_ssl.example", "type", "disable"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_sqlserver_instance_ssl.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,22 +4,29 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// sqlserverWanIpConfigIdFormat is the id of the wan ip config of an instance or of its readonly group,
+// which is also its import id.
+var sqlserverWanIpConfigIdFormat = compositeid.New(compositeid.String("instance_id"), compositeid.Optional(compositeid.String("ro_group_id")))
+
 func ResourceTencentCloudSqlserverWanIpConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudSqlserverWanIpConfigCreate,
 		Read:   resourceTencentCloudSqlserverWanIpConfigRead,
 		Update: resourceTencentCloudSqlserverWanIpConfigUpdate,
 		Delete: resourceTencentCloudSqlserverWanIpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: sqlserverWanIpConfigIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -95,11 +102,7 @@ func resourceTencentCloudSqlserverWanIpConfigCreate(d *schema.ResourceData, meta
 		roGroupId = v.(string)
 	}
 
-	if roGroupId != "" {
-		d.SetId(strings.Join([]string{instanceId, roGroupId}, tccommon.FILED_SP))
-	} else {
-		d.SetId(instanceId)
-	}
+	d.SetId(sqlserverWanIpConfigIdFormat.Join(instanceId, roGroupId))
 
 	return resourceTencentCloudSqlserverWanIpConfigUpdate(d, meta)
 }
@@ -115,16 +118,14 @@ func resourceTencentCloudSqlserverWanIpConfigRead(d *schema.ResourceData, meta i
 		roGroupId        string
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) == 1 {
-		instanceId = idSplit[0]
-	} else if len(idSplit) == 2 {
-		instanceId = idSplit[0]
-		roGroupId = idSplit[1]
-	} else {
-		return fmt.Errorf("tencentcloud_sqlserver_wan_ip_config id is broken, id is %s", d.Id())
+	id, err := sqlserverWanIpConfigIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
+	instanceId = id.Get("instance_id")
+	roGroupId = id.Get("ro_group_id")
+
 	instance, has, err := sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
 	if err != nil {
 		return err
@@ -135,6 +136,8 @@ func resourceTencentCloudSqlserverWanIpConfigRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
+	_ = d.Set("instance_id", instanceId)
+
 	if instance.DnsPodDomain != nil {
 		_ = d.Set("dns_pod_domain", instance.DnsPodDomain)
 	}
@@ -143,7 +146,13 @@ func resourceTencentCloudSqlserverWanIpConfigRead(d *schema.ResourceData, meta i
 		_ = d.Set("tgw_wan_vport", instance.TgwWanVPort)
 	}
 
-	if roGroupId != "" {
+	// `enable_wan_ip` is only derived from the domain when it is not in the state yet, as on import.
+	_, configured := d.GetOkExists("enable_wan_ip")
+	if roGroupId == "" {
+		if !configured {
+			_ = d.Set("enable_wan_ip", instance.DnsPodDomain != nil && *instance.DnsPodDomain != "")
+		}
+	} else {
 		roGroupList, err := sqlserverService.DescribeReadonlyGroupList(ctx, instanceId)
 		if err != nil {
 			return err
@@ -163,6 +172,10 @@ func resourceTencentCloudSqlserverWanIpConfigRead(d *schema.ResourceData, meta i
 					dMap["dns_pod_domain"] = v.DnsPodDomain
 				}
 
+				if !configured {
+					_ = d.Set("enable_wan_ip", v.DnsPodDomain != nil && *v.DnsPodDomain != "")
+				}
+
 				if v.TgwWanVPort != nil {
 					dMap["tgw_wan_vport"] = v.TgwWanVPort
 				}
@@ -171,6 +184,7 @@ func resourceTencentCloudSqlserverWanIpConfigRead(d *schema.ResourceData, meta i
 			}
 		}
 
+		_ = d.Set("ro_group_id", roGroupId)
 		_ = d.Set("ro_group", tmpList)
 	}
 
@@ -190,16 +204,14 @@ func resourceTencentCloudSqlserverWanIpConfigUpdate(d *schema.ResourceData, meta
 		flowId      uint64
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) == 1 {
-		instanceId = idSplit[0]
-	} else if len(idSplit) == 2 {
-		instanceId = idSplit[0]
-		roGroupId = idSplit[1]
-	} else {
-		return fmt.Errorf("tencentcloud_sqlserver_wan_ip_config id is broken, id is %s", d.Id())
+	id, err := sqlserverWanIpConfigIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
+	instanceId = id.Get("instance_id")
+	roGroupId = id.Get("ro_group_id")
+
 	if v, ok := d.GetOkExists("enable_wan_ip"); ok {
 		enableWanIp = v.(bool)
 	}
@@ -275,7 +287,7 @@ func resourceTencentCloudSqlserverWanIpConfigUpdate(d *schema.ResourceData, meta
 	// wait
 	flowRequest := sqlserver.NewDescribeFlowStatusRequest()
 	flowRequest.FlowId = helper.UInt64Int64(flowId)
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
 		if e != nil {
 			return tccommon.RetryError(e)
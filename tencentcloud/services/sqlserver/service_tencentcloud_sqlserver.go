@@ -17,6 +17,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -955,31 +956,41 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string,
 }
 
 func (me *SqlserverService) WaitForTaskFinish(ctx context.Context, flowId int64) (errRet error) {
-	logId := tccommon.GetLogId(ctx)
-	request := sqlserver.NewDescribeFlowStatusRequest()
-	request.FlowId = &flowId
-
-	defer func() {
-		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
-		}
-	}()
-
-	errRet = resource.Retry(6*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
-		ratelimit.Check(request.GetAction())
-		if err != nil {
-			return resource.NonRetryableError(errors.WithStack(err))
-		}
-		if *taskResponse.Response.Status == int64(SQLSERVER_TASK_RUNNING) {
-			return resource.RetryableError(errors.WithStack(fmt.Errorf("SQLSERVER task status is %d(task running), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
-		} else if *taskResponse.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(errors.WithStack(fmt.Errorf("SQLSERVER task status is %d(failed), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
-		}
-		return nil
-	})
-	return
+	ctx, cancel := context.WithTimeout(ctx, 6*tccommon.WriteRetryTimeout)
+	defer cancel()
+	return me.WaitForFlow(ctx, flowId)
+}
+
+// sqlserverFlowStates names the statuses of the flows.
+var sqlserverFlowStates = map[int64]string{
+	SQLSERVER_TASK_SUCCESS: "success",
+	SQLSERVER_TASK_FAIL:    "fail",
+	SQLSERVER_TASK_RUNNING: "running",
+}
+
+// WaitForFlow waits for the flow of flowId to succeed until ctx is done, such as by the timeout of
+// the resource given by waiter.WithResourceTimeout.
+func (me *SqlserverService) WaitForFlow(ctx context.Context, flowId int64) error {
+	_, err := (&waiter.Waiter{
+		Name:    fmt.Sprintf("sqlserver flow %d", flowId),
+		Pending: []string{sqlserverFlowStates[SQLSERVER_TASK_RUNNING]},
+		Target:  []string{sqlserverFlowStates[SQLSERVER_TASK_SUCCESS]},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			flow, err := me.DescribeCloneStatusByFlowId(ctx, flowId)
+			if err != nil {
+				return nil, err
+			}
+			if flow.Status == nil {
+				return nil, fmt.Errorf("status of sqlserver flow %d is nil", flowId)
+			}
+			state, ok := sqlserverFlowStates[*flow.Status]
+			if !ok {
+				state = fmt.Sprint(*flow.Status)
+			}
+			return &waiter.Status{State: state}, nil
+		},
+	}).Wait(ctx)
+	return err
 }
 
 func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID string, dbname string, charset string, remark string) (errRet error) {
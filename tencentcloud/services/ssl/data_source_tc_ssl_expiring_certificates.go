@@ -0,0 +1,211 @@
+package ssl
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/certcheck"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudSslExpiringCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudSslExpiringCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			"days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "List the certificates expiring within the days.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tccommon.ValidateAllowedStringValue(SSL_CERT_TYPE),
+				Description:  "Type of the SSL certificate to be queried. Available values includes: `CA` and `SVR`.",
+			},
+			"include_expired": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to list the expired certificates too. Default is `false`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// computed
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Certificates expiring within `days`, the earliest expiring first. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the SSL certificate.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the SSL certificate.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the SSL certificate.",
+						},
+						"project_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Project ID of the SSL certificate.",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Primary domain of the SSL certificate.",
+						},
+						"subject_names": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "ALL domains included in the SSL certificate. Including the primary domain name.",
+						},
+						"status": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Status of the SSL certificate.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Ending time of the SSL certificate.",
+						},
+						"days_until_expiry": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Days until the SSL certificate expires, negative once expired.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudSslExpiringCertificatesRead(d *schema.ResourceData, m interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_ssl_expiring_certificates.read")()
+
+	var (
+		logId          = tccommon.GetLogId(tccommon.ContextNil)
+		ctx            = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		sslService     = SSLService{client: m.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		days           = d.Get("days").(int)
+		includeExpired = d.Get("include_expired").(bool)
+		now            = time.Now()
+		location       = time.FixedZone("UTC+8", SSL_TIME_UTC_OFFSET)
+	)
+
+	request := ssl.NewDescribeCertificatesRequest()
+	if v, ok := d.GetOk("type"); ok {
+		request.CertificateType = helper.String(v.(string))
+	}
+
+	var certificateList []*ssl.Certificates
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := sslService.DescribeCertificates(ctx, request)
+		if e != nil {
+			if sdkErr := helper.UnwarpSDKError(e); sdkErr != nil && tccommon.IsContains("LimitExceeded", sdkErr.Code) {
+				return resource.RetryableError(e)
+			}
+			return tccommon.RetryError(e)
+		}
+		certificateList = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read certificates failed, reason: %v", logId, err)
+		return err
+	}
+
+	type expiring struct {
+		endTime time.Time
+		item    map[string]interface{}
+	}
+	expirings := make([]expiring, 0)
+	for _, certificate := range certificateList {
+		if certificate.CertificateId == nil || certificate.CertEndTime == nil || certificate.Status == nil {
+			continue
+		}
+		status := *certificate.Status
+		if status != SSL_STATUS_AVAILABLE && !(includeExpired && status == SSL_STATUS_EXPIRED) {
+			continue
+		}
+
+		endTime, err := time.ParseInLocation(SSL_TIME_LAYOUT, *certificate.CertEndTime, location)
+		if err != nil {
+			log.Printf("[WARN]%s parse end time %s of certificate %s failed, reason: %v", logId, *certificate.CertEndTime, *certificate.CertificateId, err)
+			continue
+		}
+		daysUntilExpiry := certcheck.DaysUntil(endTime, now)
+		if daysUntilExpiry > days || (!includeExpired && !now.Before(endTime)) {
+			continue
+		}
+
+		projectId, _ := strconv.Atoi(helper.PString(certificate.ProjectId))
+		subjectAltNames := make([]string, 0, len(certificate.SubjectAltName))
+		for _, name := range certificate.SubjectAltName {
+			subjectAltNames = append(subjectAltNames, *name)
+		}
+
+		expirings = append(expirings, expiring{
+			endTime: endTime,
+			item: map[string]interface{}{
+				"id":                *certificate.CertificateId,
+				"name":              helper.PString(certificate.Alias),
+				"type":              helper.PString(certificate.CertificateType),
+				"project_id":        projectId,
+				"domain":            helper.PString(certificate.Domain),
+				"subject_names":     subjectAltNames,
+				"status":            int(status),
+				"end_time":          *certificate.CertEndTime,
+				"days_until_expiry": daysUntilExpiry,
+			},
+		})
+	}
+
+	sort.SliceStable(expirings, func(i, j int) bool { return expirings[i].endTime.Before(expirings[j].endTime) })
+
+	certificates := make([]map[string]interface{}, 0, len(expirings))
+	ids := make([]string, 0, len(expirings))
+	for _, item := range expirings {
+		certificates = append(certificates, item.item)
+		ids = append(ids, item.item["id"].(string))
+	}
+
+	_ = d.Set("certificates", certificates)
+	d.SetId(helper.DataResourceIdsHash(append(ids, strconv.Itoa(days))))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := tccommon.WriteToFile(output.(string), certificates); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
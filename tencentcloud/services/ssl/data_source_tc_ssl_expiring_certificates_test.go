@@ -0,0 +1,33 @@
+package ssl_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudSslExpiringCertificatesDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSslExpiringCertificatesDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_ssl_expiring_certificates.all"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ssl_expiring_certificates.all", "certificates.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSslExpiringCertificatesDataSource = `
+data "tencentcloud_ssl_expiring_certificates" "all" {
+  days            = 3650
+  include_expired = true
+}
+`
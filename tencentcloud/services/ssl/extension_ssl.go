@@ -21,6 +21,12 @@ const (
 	SSL_STATUS_REVOCATION_PENDING
 )
 
+const (
+	// times of certificates are returned in UTC+8, e.g. `2024-06-01 23:59:59`.
+	SSL_TIME_LAYOUT     = "2006-01-02 15:04:05"
+	SSL_TIME_UTC_OFFSET = 8 * 3600
+)
+
 const (
 	SSL_CERT_TYPE_SERVER = "SVR"
 	SSL_CERT_TYPE_CA     = "CA"
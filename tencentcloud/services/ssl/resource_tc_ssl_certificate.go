@@ -7,6 +7,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
@@ -16,15 +17,17 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/certcheck"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudSslCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSslCertificateCreate,
-		Read:   resourceTencentCloudSslCertificateRead,
-		Update: resourceTencentCloudSslCertificateUpdate,
-		Delete: resourceTencentCloudSslCertificateDelete,
+		Create:        resourceTencentCloudSslCertificateCreate,
+		Read:          resourceTencentCloudSslCertificateRead,
+		Update:        resourceTencentCloudSslCertificateUpdate,
+		Delete:        resourceTencentCloudSslCertificateDelete,
+		CustomizeDiff: resourceTencentCloudSslCertificateCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -92,6 +95,18 @@ func ResourceTencentCloudSslCertificate() *schema.Resource {
 				Computed:    true,
 				Description: "Tags of the SSL certificate.",
 			},
+			"expected_domains": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Domains the certificate must cover, checked against its subject alternative names when planning. Only checked when `type` is `SVR`. Wildcard names such as `*.example.com` and IP addresses are supported.",
+			},
+			"verify_chain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to check when planning that `cert` chains up to a root certificate trusted by the system running Terraform. The order of the certificates in `cert` and the match of `key` are always checked when `type` is `SVR`. A `CA` certificate is a bundle of client CA certificates and is not checked.",
+			},
 			// computed
 			"product_zh_name": {
 				Type:        schema.TypeString,
@@ -129,6 +144,21 @@ func ResourceTencentCloudSslCertificate() *schema.Resource {
 				Computed:    true,
 				Description: "ALL domains included in the SSL certificate. Including the primary domain name.",
 			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the SSL certificate in RFC 3339 format, parsed from `cert`.",
+			},
+			"days_until_expiry": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Days until the SSL certificate expires as of the last refresh, negative once expired.",
+			},
+			"fingerprint_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the SSL certificate in lower case hex.",
+			},
 		},
 	}
 }
@@ -283,6 +313,14 @@ func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{
 	}
 	_ = d.Set("subject_names", subjectAltNames)
 
+	if chain, err := certcheck.ParseCertificates(*certificate.CertificatePublicKey); err == nil {
+		for key, value := range sslCertificateLocalAttributes(chain[0], time.Now()) {
+			_ = d.Set(key, value)
+		}
+	} else {
+		log.Printf("[WARN]%s parse certificate %s failed, reason: %v", logId, id, err)
+	}
+
 	tagClient := m.(tccommon.ProviderMeta).GetAPIV3Conn()
 	tagService := svctag.NewTagService(tagClient)
 
@@ -0,0 +1,93 @@
+package ssl
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/certcheck"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// resourceTencentCloudSslCertificateCustomizeDiff validates a new certificate offline, so that a bad
+// chain or key of a SVR certificate fails the plan instead of the upload, and plans its expiry and fingerprint.
+func resourceTencentCloudSslCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cert", "key", "type", "expected_domains", "verify_chain"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges("cert", "key", "expected_domains", "verify_chain") {
+		return nil
+	}
+
+	chain, err := certcheck.ParseCertificates(d.Get("cert").(string))
+	if err != nil {
+		return fmt.Errorf("invalid cert: %v", err)
+	}
+	leaf := chain[0]
+	now := time.Now()
+
+	if !now.Before(leaf.NotAfter) {
+		return fmt.Errorf("invalid cert: certificate %s expired at %s", certcheck.Name(leaf), leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	// a CA certificate is a bundle of the client CA certificates, not a chain, and has no key.
+	if d.Get("type").(string) != SSL_CERT_TYPE_SERVER {
+		return sslCertificateSetLocalAttributes(d, leaf, now)
+	}
+
+	if key := d.Get("key").(string); key != "" {
+		privateKey, err := certcheck.ParsePrivateKey(key)
+		if err != nil {
+			return fmt.Errorf("invalid key: %v", err)
+		}
+		if err := certcheck.CheckKeyPair(leaf, privateKey); err != nil {
+			return fmt.Errorf("invalid key: %v", err)
+		}
+	} else {
+		return fmt.Errorf("when type is %s, key can't be empty", SSL_CERT_TYPE_SERVER)
+	}
+
+	var roots *x509.CertPool
+	if d.Get("verify_chain").(bool) {
+		if roots, err = x509.SystemCertPool(); err != nil {
+			return fmt.Errorf("load the system root certificates failed: %v", err)
+		}
+	}
+	if err := certcheck.CheckChain(chain, roots, now); err != nil {
+		return fmt.Errorf("invalid cert: %v", err)
+	}
+
+	if domains := helper.InterfacesStrings(d.Get("expected_domains").([]interface{})); len(domains) > 0 {
+		if err := certcheck.CheckDomains(leaf, domains); err != nil {
+			return fmt.Errorf("invalid cert: %v", err)
+		}
+	}
+
+	return sslCertificateSetLocalAttributes(d, leaf, now)
+}
+
+// sslCertificateSetLocalAttributes plans the attributes computed from the leaf certificate of a new cert.
+func sslCertificateSetLocalAttributes(d *schema.ResourceDiff, leaf *x509.Certificate, now time.Time) error {
+	if d.Id() == "" || d.HasChange("cert") {
+		for key, value := range sslCertificateLocalAttributes(leaf, now) {
+			if err := d.SetNew(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sslCertificateLocalAttributes returns the attributes computed from the leaf certificate.
+func sslCertificateLocalAttributes(leaf *x509.Certificate, now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"not_after":          leaf.NotAfter.UTC().Format(time.RFC3339),
+		"days_until_expiry":  certcheck.DaysUntilExpiry(leaf, now),
+		"fingerprint_sha256": certcheck.FingerprintSHA256(leaf),
+	}
+}
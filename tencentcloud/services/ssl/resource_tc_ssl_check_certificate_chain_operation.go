@@ -5,6 +5,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudSslCheckCertificateChainOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslCheckCertificateChainCreate,
 		Read:   resourceTencentCloudSslCheckCertificateChainRead,
 		Delete: resourceTencentCloudSslCheckCertificateChainDelete,
@@ -30,7 +31,7 @@ func ResourceTencentCloudSslCheckCertificateChainOperation() *schema.Resource {
 				Description: "The certificate chain to check.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslCheckCertificateChainCreate(d *schema.ResourceData, meta interface{}) error {
@@ -56,6 +57,7 @@ func resourceTencentCloudSslCheckCertificateChainCreate(d *schema.ResourceData,
 			return tccommon.RetryError(err)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		operation.SetResponse(d, result)
 		return nil
 	})
 	if err != nil {
@@ -13,10 +13,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudSslCheckCertificateDomainVerificationOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslCheckCertificateDomainVerificationOperationCreate,
 		Read:   resourceTencentCloudSslCheckCertificateDomainVerificationOperationRead,
 		Delete: resourceTencentCloudSslCheckCertificateDomainVerificationOperationDelete,
@@ -84,7 +85,7 @@ func ResourceTencentCloudSslCheckCertificateDomainVerificationOperation() *schem
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslCheckCertificateDomainVerificationOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -117,6 +118,7 @@ func resourceTencentCloudSslCheckCertificateDomainVerificationOperationCreate(d
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 
 		if result == nil || result.Response == nil || len(result.Response.VerificationResults) != 1 {
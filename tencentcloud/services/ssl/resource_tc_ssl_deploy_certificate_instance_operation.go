@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudSslDeployCertificateInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateInstanceCreate,
 		Read:   resourceTencentCloudSslDeployCertificateInstanceRead,
 		Delete: resourceTencentCloudSslDeployCertificateInstanceDelete,
@@ -52,7 +53,7 @@ func ResourceTencentCloudSslDeployCertificateInstanceOperation() *schema.Resourc
 				Description: "Deployment cloud resource status: Live: -1: The domain name is not associated with a certificate.1:  Domain name https is enabled.0:  Domain name https is closed.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslDeployCertificateInstanceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -92,6 +93,7 @@ func resourceTencentCloudSslDeployCertificateInstanceCreate(d *schema.ResourceDa
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
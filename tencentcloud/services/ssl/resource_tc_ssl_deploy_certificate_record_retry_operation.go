@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudSslDeployCertificateRecordRetryOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateRecordRetryCreate,
 		Read:   resourceTencentCloudSslDeployCertificateRecordRetryRead,
 		Delete: resourceTencentCloudSslDeployCertificateRecordRetryDelete,
@@ -35,7 +36,7 @@ func ResourceTencentCloudSslDeployCertificateRecordRetryOperation() *schema.Reso
 				Description: "Deployment record details ID to be retried.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslDeployCertificateRecordRetryCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudSslDeployCertificateRecordRetryCreate(d *schema.Resourc
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudSslDeployCertificateRecordRollbackOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateRecordRollbackCreate,
 		Read:   resourceTencentCloudSslDeployCertificateRecordRollbackRead,
 		Delete: resourceTencentCloudSslDeployCertificateRecordRollbackDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudSslDeployCertificateRecordRollbackOperation() *schema.R
 				Description: "Deployment record ID to be rollback.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslDeployCertificateRecordRollbackCreate(d *schema.ResourceData, meta interface{}) error {
@@ -52,6 +53,7 @@ func resourceTencentCloudSslDeployCertificateRecordRollbackCreate(d *schema.Reso
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 )
 
 func ResourceTencentCloudSslDownloadCertificateOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslDownloadCertificateCreate,
 		Read:   resourceTencentCloudSslDownloadCertificateRead,
 		Delete: resourceTencentCloudSslDownloadCertificateDelete,
@@ -36,7 +37,7 @@ func ResourceTencentCloudSslDownloadCertificateOperation() *schema.Resource {
 				Description: "Certificate ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslDownloadCertificateCreate(d *schema.ResourceData, meta interface{}) error {
@@ -65,6 +66,7 @@ func resourceTencentCloudSslDownloadCertificateCreate(d *schema.ResourceData, me
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudSslRevokeCertificateOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslRevokeCertificateCreate,
 		Read:   resourceTencentCloudSslRevokeCertificateRead,
 		Delete: resourceTencentCloudSslRevokeCertificateDelete,
@@ -36,7 +37,7 @@ func ResourceTencentCloudSslRevokeCertificateOperation() *schema.Resource {
 				Description: "Reasons for revoking certificate.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslRevokeCertificateCreate(d *schema.ResourceData, meta interface{}) error {
@@ -69,6 +70,7 @@ func resourceTencentCloudSslRevokeCertificateCreate(d *schema.ResourceData, meta
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudSslUpdateCertificateInstanceOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslUpdateCertificateInstanceOperationCreate,
 		Read:   resourceTencentCloudSslUpdateCertificateInstanceOperationRead,
 		Delete: resourceTencentCloudSslUpdateCertificateInstanceOperationDelete,
@@ -114,7 +115,7 @@ func ResourceTencentCloudSslUpdateCertificateInstanceOperation() *schema.Resourc
 				Description: "Project ID, if you choose to upload the certificate, you can configure this parameter.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslUpdateCertificateInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -196,6 +197,7 @@ func resourceTencentCloudSslUpdateCertificateInstanceOperationCreate(d *schema.R
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		if response == nil || response.Response == nil || response.Response.DeployRecordId == nil {
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudSslUpdateCertificateRecordRetryOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudSslUpdateCertificateRecordRetryCreate,
 		Read:   resourceTencentCloudSslUpdateCertificateRecordRetryRead,
 		Delete: resourceTencentCloudSslUpdateCertificateRecordRetryDelete,
@@ -35,7 +36,7 @@ func ResourceTencentCloudSslUpdateCertificateRecordRetryOperation() *schema.Reso
 				Description: "Deployment record details ID to be retried.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudSslUpdateCertificateRecordRetryCreate(d *schema.ResourceData, meta interface{}) error {
@@ -63,6 +64,7 @@ func resourceTencentCloudSslUpdateCertificateRecordRetryCreate(d *schema.Resourc
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -2,9 +2,7 @@ package tat
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -12,17 +10,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// tatInvocationCommandAttachmentIdFormat is the id of an invocation on an instance, which is also its import id.
+var tatInvocationCommandAttachmentIdFormat = compositeid.New(compositeid.String("invocation_id"), compositeid.String("instance_id"))
+
 func ResourceTencentCloudTatInvocationCommandAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTatInvocationCommandAttachmentCreate,
 		Read:   resourceTencentCloudTatInvocationCommandAttachmentRead,
 		Delete: resourceTencentCloudTatInvocationCommandAttachmentDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: tatInvocationCommandAttachmentIdFormat.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"content": {
 				Required:    true,
@@ -217,7 +219,7 @@ func resourceTencentCloudTatInvocationCommandAttachmentCreate(d *schema.Resource
 	}
 
 	invocationId = *response.Response.InvocationId
-	d.SetId(invocationId + tccommon.FILED_SP + instanceId)
+	d.SetId(tatInvocationCommandAttachmentIdFormat.Join(invocationId, instanceId))
 
 	return resourceTencentCloudTatInvocationCommandAttachmentRead(d, meta)
 }
@@ -232,12 +234,12 @@ func resourceTencentCloudTatInvocationCommandAttachmentRead(d *schema.ResourceDa
 
 	service := TatService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := tatInvocationCommandAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	invocationId := idSplit[0]
-	instanceId := idSplit[1]
+	invocationId := id.Get("invocation_id")
+	instanceId := id.Get("instance_id")
 
 	invocation, err := service.DescribeTatInvocationById(ctx, invocationId)
 	if err != nil {
@@ -320,12 +322,12 @@ func resourceTencentCloudTatInvocationCommandAttachmentDelete(d *schema.Resource
 
 	service := TatService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := tatInvocationCommandAttachmentIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	invocationId := idSplit[0]
-	instanceId := idSplit[1]
+	invocationId := id.Get("invocation_id")
+	instanceId := id.Get("instance_id")
 
 	if err := service.DeleteTatInvocationById(ctx, invocationId, instanceId); err != nil {
 		return err
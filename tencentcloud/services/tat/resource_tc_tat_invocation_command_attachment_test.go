@@ -31,6 +31,11 @@ func TestAccTencentCloudTatInvocationCommandAttachmentResource_basic(t *testing.
 					resource.TestCheckResourceAttr("tencentcloud_tat_invocation_command_attachment.invocation_command_attachment", "output_cos_key_prefix", "log"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tat_invocation_command_attachment.invocation_command_attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
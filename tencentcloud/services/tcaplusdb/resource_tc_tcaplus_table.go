@@ -7,17 +7,25 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// tcaplusTableImportIdFormat is the import id of a table, the id of the resource is the table instance id.
+var tcaplusTableImportIdFormat = compositeid.New(compositeid.String("cluster_id"), compositeid.String("id"))
+
 func ResourceTencentCloudTcaplusTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTcaplusTableCreate,
 		Read:   resourceTencentCloudTcaplusTableRead,
 		Update: resourceTencentCloudTcaplusTableUpdate,
 		Delete: resourceTencentCloudTcaplusTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: tcaplusTableImportIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -300,6 +308,23 @@ func resourceTencentCloudTcaplusTableRead(d *schema.ResourceData, meta interface
 	_ = d.Set("description", tableInfo.Memo)
 	_ = d.Set("table_idl_type", tableInfo.TableIdlType)
 	_ = d.Set("reserved_volume", tableInfo.ReservedVolume)
+	_ = d.Set("reserved_read_cu", tableInfo.ReservedReadQps)
+	_ = d.Set("reserved_write_cu", tableInfo.ReservedWriteQps)
+	if _, ok := d.GetOk("idl_id"); !ok && len(tableInfo.IdlFiles) > 0 {
+		idlFile := tableInfo.IdlFiles[0]
+		idlId, err := json.Marshal(TcaplusIdlId{
+			ClusterId:   *tableInfo.ClusterId,
+			FileExtType: helper.PString(idlFile.FileExtType),
+			FileId:      helper.PInt64(idlFile.FileId),
+			FileName:    helper.PString(idlFile.FileName),
+			FileSize:    helper.PInt64(idlFile.FileSize),
+			FileType:    helper.PString(idlFile.FileType),
+		})
+		if err != nil {
+			return fmt.Errorf("format idl id fail,%s", err.Error())
+		}
+		_ = d.Set("idl_id", string(idlId))
+	}
 	_ = d.Set("create_time", tableInfo.CreatedTime)
 	if tableInfo.Error != nil && tableInfo.Error.Message != nil {
 		_ = d.Set("error", tableInfo.Error.Message)
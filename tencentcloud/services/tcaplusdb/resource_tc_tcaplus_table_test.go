@@ -134,6 +134,11 @@ func TestAccTencentCloudTcaplusTableResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTcaplusTableResourceNameResourceKey, "error", ""),
 				),
 			},
+			{
+				ResourceName:      testTcaplusTableResourceNameResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -17,6 +18,9 @@ func ResourceTencentCloudTcaplusTableGroup() *schema.Resource {
 		Read:   resourceTencentCloudTcaplusTableGroupRead,
 		Update: resourceTencentCloudTcaplusTableGroupUpdate,
 		Delete: resourceTencentCloudTcaplusTableGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -82,6 +86,16 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 	clusterId := d.Get("cluster_id").(string)
 	groupId := d.Id()
 
+	// the id is `<cluster_id>:<tablegroup_id>`, the cluster of an imported group is taken from it.
+	if clusterId == "" {
+		items := strings.Split(groupId, ":")
+		if len(items) != 2 {
+			return fmt.Errorf("group id is broken,%s", groupId)
+		}
+		clusterId = items[0]
+		_ = d.Set("cluster_id", clusterId)
+	}
+
 	info, has, err := tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 	if err != nil {
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -43,6 +43,11 @@ func TestAccTencentCloudTcaplusGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTcaplusGroupResourceNameResourceKey, "table_count", "0"),
 				),
 			},
+			{
+				ResourceName:      testTcaplusGroupResourceNameResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -22,6 +22,9 @@ func ResourceTencentCloudMonitorGrafanaIntegration() *schema.Resource {
 		Create: resourceTencentCloudMonitorGrafanaIntegrationCreate,
 		Update: resourceTencentCloudMonitorGrafanaIntegrationUpdate,
 		Delete: resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -22,6 +22,9 @@ func ResourceTencentCloudMonitorGrafanaNotificationChannel() *schema.Resource {
 		Create: resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
 		Update: resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
 		Delete: resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
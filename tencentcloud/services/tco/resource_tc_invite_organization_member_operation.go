@@ -11,10 +11,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudInviteOrganizationMemberOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudInviteOrganizationMemberOperationCreate,
 		Read:   resourceTencentCloudInviteOrganizationMemberOperationRead,
 		Delete: resourceTencentCloudInviteOrganizationMemberOperationDelete,
@@ -127,7 +128,7 @@ func ResourceTencentCloudInviteOrganizationMemberOperation() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudInviteOrganizationMemberOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -219,6 +220,7 @@ func resourceTencentCloudInviteOrganizationMemberOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
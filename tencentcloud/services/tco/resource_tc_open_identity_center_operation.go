@@ -9,10 +9,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudOpenIdentityCenterOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudInviteOpenIdentityCenterOperationCreate,
 		Read:   resourceTencentCloudInviteOpenIdentityCenterOperationRead,
 		Delete: resourceTencentCloudInviteOpenIdentityCenterOperationDelete,
@@ -30,7 +31,7 @@ func ResourceTencentCloudOpenIdentityCenterOperation() *schema.Resource {
 				Description: "Space ID. z-Prefix starts with 12 random numbers/lowercase letters followed by.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudInviteOpenIdentityCenterOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -54,6 +55,7 @@ func resourceTencentCloudInviteOpenIdentityCenterOperationCreate(d *schema.Resou
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,7 +14,7 @@ import (
 )
 
 func ResourceTencentCloudOrganizationQuitOrganizationOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudOrganizationQuitOrganizationOperationCreate,
 		Read:   resourceTencentCloudOrganizationQuitOrganizationOperationRead,
 		Delete: resourceTencentCloudOrganizationQuitOrganizationOperationDelete,
@@ -28,7 +29,7 @@ func ResourceTencentCloudOrganizationQuitOrganizationOperation() *schema.Resourc
 				Description: "Organization ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudOrganizationQuitOrganizationOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -51,6 +52,7 @@ func resourceTencentCloudOrganizationQuitOrganizationOperationCreate(d *schema.R
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
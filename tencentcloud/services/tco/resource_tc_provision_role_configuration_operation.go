@@ -13,10 +13,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudProvisionRoleConfigurationOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudProvisionRoleConfigurationOperationCreate,
 		Read:   resourceTencentCloudProvisionRoleConfigurationOperationRead,
 		Delete: resourceTencentCloudProvisionRoleConfigurationOperationDelete,
@@ -49,7 +50,7 @@ func ResourceTencentCloudProvisionRoleConfigurationOperation() *schema.Resource
 				Description: "UIN of the target account of the Tencent Cloud Organization.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudProvisionRoleConfigurationOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -94,6 +95,7 @@ func resourceTencentCloudProvisionRoleConfigurationOperationCreate(d *schema.Res
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
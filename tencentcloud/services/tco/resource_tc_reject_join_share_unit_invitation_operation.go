@@ -10,10 +10,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudRejectJoinShareUnitInvitationOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudRejectJoinShareUnitInvitationOperationCreate,
 		Read:   resourceTencentCloudRejectJoinShareUnitInvitationOperationRead,
 		Delete: resourceTencentCloudRejectJoinShareUnitInvitationOperationDelete,
@@ -25,7 +26,7 @@ func ResourceTencentCloudRejectJoinShareUnitInvitationOperation() *schema.Resour
 				Description: "Shared unit ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudRejectJoinShareUnitInvitationOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -55,6 +56,7 @@ func resourceTencentCloudRejectJoinShareUnitInvitationOperationCreate(d *schema.
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		response = result
 		return nil
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudTcrCreateImageSignatureOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudTcrCreateImageSignatureOperationCreate,
 		Read:   resourceTencentCloudTcrCreateImageSignatureOperationRead,
 		Delete: resourceTencentCloudTcrCreateImageSignatureOperationDelete,
@@ -50,7 +51,7 @@ func ResourceTencentCloudTcrCreateImageSignatureOperation() *schema.Resource {
 				Description: "image version name.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudTcrCreateImageSignatureOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -92,6 +93,7 @@ func resourceTencentCloudTcrCreateImageSignatureOperationCreate(d *schema.Resour
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
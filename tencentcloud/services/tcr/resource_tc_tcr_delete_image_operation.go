@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ import (
 )
 
 func ResourceTencentCloudTcrDeleteImageOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudTcrDeleteImageOperationCreate,
 		Read:   resourceTencentCloudTcrDeleteImageOperationRead,
 		Delete: resourceTencentCloudTcrDeleteImageOperationDelete,
@@ -47,7 +48,7 @@ func ResourceTencentCloudTcrDeleteImageOperation() *schema.Resource {
 				Description: "namespace name.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudTcrDeleteImageOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -89,6 +90,7 @@ func resourceTencentCloudTcrDeleteImageOperationCreate(d *schema.ResourceData, m
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -22,6 +22,9 @@ func ResourceTencentCloudTcrTagRetentionExecutionConfig() *schema.Resource {
 		Read:   resourceTencentCloudTcrTagRetentionExecutionConfigRead,
 		Update: resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
 		Delete: resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
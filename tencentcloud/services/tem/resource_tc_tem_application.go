@@ -21,9 +21,9 @@ func ResourceTencentCloudTemApplication() *schema.Resource {
 		Create: resourceTencentCloudTemApplicationCreate,
 		Update: resourceTencentCloudTemApplicationUpdate,
 		Delete: resourceTencentCloudTemApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Type:        schema.TypeString,
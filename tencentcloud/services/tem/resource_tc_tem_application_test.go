@@ -39,11 +39,12 @@ func TestAccTencentCloudTemApplicationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tem_application.application", "tags.createdBy", "terraform"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tem_application.application",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:            "tencentcloud_tem_application.application",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"use_default_image_service", "repo_server"},
+			},
 		},
 	})
 }
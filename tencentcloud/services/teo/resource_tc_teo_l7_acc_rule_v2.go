@@ -11,8 +11,33 @@ import (
 	teov20220901 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
 )
 
+// TeoL7AccRuleMove moves tencentcloud_teo_l7_acc_rule to tencentcloud_teo_l7_acc_rule_v2, each rule of
+// the zone becomes a resource of its own.
+var TeoL7AccRuleMove = stateupgrade.Move{
+	From: "tencentcloud_teo_l7_acc_rule",
+	To:   "tencentcloud_teo_l7_acc_rule_v2",
+	ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+		zoneId, err := stateupgrade.String(attributes, "zone_id")
+		if err != nil {
+			return nil, err
+		}
+		rules, _ := attributes["rules"].([]interface{})
+		ids := make([]string, 0, len(rules))
+		for i, rule := range rules {
+			ruleMap, _ := rule.(map[string]interface{})
+			ruleId, err := stateupgrade.String(ruleMap, "rule_id")
+			if err != nil {
+				return nil, fmt.Errorf("rules.%d: %v", i, err)
+			}
+			ids = append(ids, zoneId+tccommon.FILED_SP+ruleId)
+		}
+		return ids, nil
+	},
+}
+
 func ResourceTencentCloudTeoL7AccRuleV2() *schema.Resource {
 	return &schema.Resource{
 		Create: ResourceTencentCloudTeoL7AccRuleV2Create,
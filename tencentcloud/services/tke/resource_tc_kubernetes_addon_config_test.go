@@ -29,6 +29,11 @@ func TestAccTencentCloudKubernetesAddonConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_kubernetes_addon_config.kubernetes_addon_config", "raw_values", "{\"extraArgs\":{\"scale-down-enabled\":false,\"max-empty-bulk-delete\":10,\"scale-down-delay-after-add\":\"10mm\",\"scale-down-unneeded-time\":\"10mm\",\"scale-down-utilization-threshold\":0.005,\"ignore-daemonsets-utilization\":false,\"skip-nodes-with-local-storage\":true,\"skip-nodes-with-system-pods\":true}}"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_kubernetes_addon_config.kubernetes_addon_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,6 +15,7 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	svcas "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/as"
 	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
 )
@@ -31,6 +32,9 @@ func ResourceTencentCloudKubernetesCluster() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customizeDiffForContainerRuntimeDefault,
 		),
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * tccommon.ReadRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
 				Type:        schema.TypeString,
@@ -1974,6 +1978,8 @@ func resourceTencentCloudKubernetesClusterUpdate(d *schema.ResourceData, meta in
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 
 	ctx := tccommon.NewResourceLifeCycleHandleFuncContext(context.Background(), logId, d, meta)
+	ctx, cancel := waiter.WithResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	defer cancel()
 
 	immutableArgs := []string{"cdc_id", "extension_addon"}
 	for _, v := range immutableArgs {
@@ -2294,22 +2300,7 @@ func resourceTencentCloudKubernetesClusterUpdate(d *schema.ResourceData, meta in
 				}
 
 				// wait for tke cluster status
-				err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-					result, e := tkeService.DescribeKubernetesClusterById(ctx, clusterId)
-					if e != nil {
-						return tccommon.RetryError(e, tccommon.InternalError)
-					}
-
-					if result == nil {
-
-					}
-
-					if result.ClusterStatus != nil && *result.ClusterStatus == "Running" {
-						return nil
-					}
-
-					return resource.RetryableError(fmt.Errorf("tke status is not RUNNING, retry..."))
-				})
+				err = tkeService.WaitForClusterRunning(ctx, clusterId)
 
 				if err != nil {
 					return err
@@ -2387,22 +2378,7 @@ func resourceTencentCloudKubernetesClusterUpdate(d *schema.ResourceData, meta in
 				}
 
 				// wait for tke cluster status
-				err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-					result, e := tkeService.DescribeKubernetesClusterById(ctx, clusterId)
-					if e != nil {
-						return tccommon.RetryError(e, tccommon.InternalError)
-					}
-
-					if result == nil {
-
-					}
-
-					if result.ClusterStatus != nil && *result.ClusterStatus == "Running" {
-						return nil
-					}
-
-					return resource.RetryableError(fmt.Errorf("tke status is not RUNNING, retry..."))
-				})
+				err = tkeService.WaitForClusterRunning(ctx, clusterId)
 
 				if err != nil {
 					return err
@@ -20,6 +20,9 @@ func ResourceTencentCloudKubernetesClusterAttachment() *schema.Resource {
 		Create: resourceTencentCloudKubernetesClusterAttachmentCreate,
 		Read:   resourceTencentCloudKubernetesClusterAttachmentRead,
 		Delete: resourceTencentCloudKubernetesClusterAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
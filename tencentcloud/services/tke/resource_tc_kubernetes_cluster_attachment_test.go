@@ -43,6 +43,12 @@ func TestAccTencentCloudKubernetesClusterAttachmentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_kubernetes_cluster_attachment.test_attach", "labels.test2", "test2"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_kubernetes_cluster_attachment.test_attach",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "worker_config"},
+			},
 		},
 	})
 }
@@ -1026,21 +1026,7 @@ func resourceTencentCloudKubernetesClusterUpdatePostHandleResponse1(ctx context.
 	tkeService := TkeService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	id := d.Id()
 
-	// todo check status
-	err := resource.Retry(3*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ins, has, inErr := tkeService.DescribeCluster(ctx, id)
-		if inErr != nil {
-			return tccommon.RetryError(inErr)
-		}
-		if !has {
-			return resource.NonRetryableError(fmt.Errorf("Cluster %s is not exist", id))
-		}
-		if ins.ClusterStatus == "Running" {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cluster %s status %s, retry...", id, ins.ClusterStatus))
-		}
-	})
+	err := tkeService.WaitForClusterRunning(ctx, id)
 
 	if err != nil {
 		return err
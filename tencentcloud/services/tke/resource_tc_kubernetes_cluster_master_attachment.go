@@ -20,6 +20,9 @@ func ResourceTencentCloudKubernetesClusterMasterAttachment() *schema.Resource {
 		Create: resourceTencentCloudKubernetesClusterMasterAttachmentCreate,
 		Read:   resourceTencentCloudKubernetesClusterMasterAttachmentRead,
 		Delete: resourceTencentCloudKubernetesClusterMasterAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -15,12 +15,23 @@ func TestAccTencentCloudNeedFixKubernetesClusterMasterAttachmentResource_basic(t
 			tcacctest.AccPreCheck(t)
 		},
 		Providers: tcacctest.AccProviders,
-		Steps: []resource.TestStep{{
-			Config: testAccKubernetesClusterMasterAttachment,
-			Check: resource.ComposeTestCheckFunc(
-				resource.TestCheckResourceAttrSet("tencentcloud_kubernetes_cluster_master_attachment.example", "id"),
-			),
-		}},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterMasterAttachment,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_kubernetes_cluster_master_attachment.example", "id"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_kubernetes_cluster_master_attachment.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"desired_pod_numbers", "enhanced_automation_service", "enhanced_monitor_service", "enhanced_security_service",
+					"extra_args", "host_name", "key_ids", "master_config", "password", "security_group_ids",
+				},
+			},
+		},
 	})
 }
 
@@ -682,6 +682,8 @@ func ResourceTencentCloudKubernetesNodePool() *schema.Resource {
 				Description: "The auto scaling group ID.",
 			},
 		},
+
+		CustomizeDiff: svccvm.SellableCheckDiff(nodePoolSellable),
 	}
 }
 
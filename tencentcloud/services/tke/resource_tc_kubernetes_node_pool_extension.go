@@ -885,6 +885,52 @@ func getNodePoolInstanceTypes(d *schema.ResourceData) []*string {
 	return instanceTypes
 }
 
+// nodePoolSellable returns the instance type and the backup instance types of the node pool,
+// checked in any zone of the region as the zones are those of its subnets.
+func nodePoolSellable(d *schema.ResourceDiff) (string, []string, []string) {
+	if d.Id() != "" && !d.HasChanges("auto_scaling_config.0.instance_type", "auto_scaling_config.0.backup_instance_types", "auto_scaling_config.0.instance_charge_type") {
+		return "", nil, nil
+	}
+	if !d.NewValueKnown("auto_scaling_config.0.instance_type") || !d.NewValueKnown("auto_scaling_config.0.backup_instance_types") {
+		return "", nil, nil
+	}
+	chargeType, ok := nodePoolChargeType(d)
+	if !ok {
+		return "", nil, nil
+	}
+
+	instanceTypes := make([]string, 0)
+	if v := d.Get("auto_scaling_config.0.instance_type").(string); v != "" {
+		instanceTypes = append(instanceTypes, v)
+	}
+	for _, v := range d.Get("auto_scaling_config.0.backup_instance_types").([]interface{}) {
+		if v != nil && v.(string) != "" {
+			instanceTypes = append(instanceTypes, v.(string))
+		}
+	}
+	return chargeType, nil, instanceTypes
+}
+
+// nodePoolChargeType returns the charge type planned of the node pool, which is computed but known
+// to be the default if not configured.
+func nodePoolChargeType(d *schema.ResourceDiff) (string, bool) {
+	if d.NewValueKnown("auto_scaling_config.0.instance_charge_type") {
+		return d.Get("auto_scaling_config.0.instance_charge_type").(string), true
+	}
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return "", false
+	}
+	configs := config.GetAttr("auto_scaling_config")
+	if configs.IsNull() || !configs.IsKnown() || configs.LengthInt() == 0 {
+		return "", false
+	}
+	if !configs.AsValueSlice()[0].GetAttr("instance_charge_type").IsNull() {
+		return "", false
+	}
+	return "", true
+}
+
 // this function composes every single parameter to an as scale parameter with json string format
 func composeParameterToAsScalingGroupParaSerial(d *schema.ResourceData) (string, error) {
 	var (
@@ -17,9 +17,14 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// kubernetesScaleWorkerIdFormat is the id of the workers, whose instance ids are joined by commas,
+// while the import id takes only one instance.
+var kubernetesScaleWorkerIdFormat = compositeid.New(compositeid.String("cluster_id"), compositeid.String("instance_ids"))
+
 var importFlag1 = false
 
 func customScaleWorkerResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -262,19 +267,17 @@ func resourceTencentCloudKubernetesScaleWorkerDeletePostRequest0(ctx context.Con
 func resourceTencentCloudKubernetesScaleWorkerReadPostFillRequest0(ctx context.Context, req *tke.DescribeClustersRequest) error {
 	d := tccommon.ResourceDataFromContext(ctx)
 	ctxData := tccommon.DataFromContext(ctx)
-	items := strings.Split(d.Id(), tccommon.FILED_SP)
-
 	instanceMap := make(map[string]bool)
 	oldWorkerInstancesList := d.Get("worker_instances_list").([]interface{})
 	clusterId := ""
 	if importFlag1 {
-		clusterId = items[0]
-		if len(items[1:]) >= 2 {
-			return fmt.Errorf("only one additional configuration of virtual machines is now supported now, " +
-				"so should be 1")
+		id, err := kubernetesScaleWorkerIdFormat.Parse(d.Id())
+		if err != nil {
+			return err
 		}
+		clusterId = id.Get("cluster_id")
 		infoMap := map[string]interface{}{
-			"instance_id": items[1],
+			"instance_id": id.Get("instance_ids"),
 		}
 		oldWorkerInstancesList = append(oldWorkerInstancesList, infoMap)
 	} else {
@@ -432,7 +435,7 @@ func resourceTencentCloudKubernetesScaleWorkerCreateOnStart(ctx context.Context)
 	}
 
 	//修改id设置,不符合id规则
-	id := clusterId + tccommon.FILED_SP + strings.Join(instanceIds, tccommon.COMMA_SP)
+	id := kubernetesScaleWorkerIdFormat.Join(clusterId, strings.Join(instanceIds, tccommon.COMMA_SP))
 	d.SetId(id)
 
 	//wait for LANIP
@@ -534,8 +537,7 @@ func resourceTencentCloudKubernetesScaleWorkerDeleteOnExit(ctx context.Context)
 	meta := tccommon.ProviderMetaFromContext(ctx)
 	service := TkeService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	clusterId := idSplit[0]
+	clusterId := compositeid.Split(d.Id())[0]
 	workerInstancesList := d.Get("worker_instances_list").([]interface{})
 
 	instanceMap := make(map[string]bool)
@@ -651,11 +653,11 @@ func resourceTencentCloudKubernetesScaleWorkerReadPostFillRequest1(ctx context.C
 	meta := tccommon.ProviderMetaFromContext(ctx)
 	logId := tccommon.GetLogId(ctx)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := kubernetesScaleWorkerIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	clusterId := idSplit[0]
+	clusterId := id.Get("cluster_id")
 
 	request := tke.NewDescribeClusterInstancesRequest()
 	request.ClusterId = helper.String(clusterId)
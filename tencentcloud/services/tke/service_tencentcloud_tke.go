@@ -23,6 +23,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -3233,6 +3234,29 @@ func (me *TkeService) DescribeKubernetesClusterById(ctx context.Context, cluster
 	return
 }
 
+// WaitForClusterRunning waits for the cluster of clusterId to be running, such as after it is
+// scaled or upgraded, until ctx is done.
+func (me *TkeService) WaitForClusterRunning(ctx context.Context, clusterId string) error {
+	_, err := (&waiter.Waiter{
+		Name:   "tke cluster " + clusterId,
+		Target: []string{"Running"},
+		Fetch: func(ctx context.Context) (*waiter.Status, error) {
+			cluster, err := me.DescribeKubernetesClusterById(ctx, clusterId)
+			if err != nil {
+				return nil, err
+			}
+			if cluster == nil {
+				return nil, fmt.Errorf("tke cluster %s is not exist", clusterId)
+			}
+			return &waiter.Status{State: helper.PString(cluster.ClusterStatus)}, nil
+		},
+		Retryable: func(err error) bool {
+			return tccommon.RetryError(err, tccommon.InternalError).Retryable
+		},
+	}).Wait(ctx)
+	return err
+}
+
 func (me *TkeService) DescribeKubernetesClusterById1(ctx context.Context, clusterId string) (ret *tke.DescribeClusterInstancesResponseParams, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 
@@ -24,6 +24,9 @@ func ResourceTencentCloudMonitorTmpExporterIntegration() *schema.Resource {
 		Create:             resourceTencentCloudMonitorTmpExporterIntegrationCreate,
 		Update:             resourceTencentCloudMonitorTmpExporterIntegrationUpdate,
 		Delete:             resourceTencentCloudMonitorTmpExporterIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -194,6 +197,14 @@ func resourceTencentCloudMonitorTmpExporterIntegrationRead(d *schema.ResourceDat
 		return nil
 	}
 
+	ids := strings.Split(tmpExporterIntegrationId, tccommon.FILED_SP)
+	if len(ids) == 5 {
+		kubeType, _ := strconv.Atoi(ids[2])
+		_ = d.Set("instance_id", ids[1])
+		_ = d.Set("kube_type", kubeType)
+		_ = d.Set("cluster_id", ids[3])
+	}
+
 	if tmpExporterIntegration.Kind != nil {
 		_ = d.Set("kind", tmpExporterIntegration.Kind)
 	}
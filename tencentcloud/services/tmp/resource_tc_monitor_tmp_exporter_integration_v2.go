@@ -16,14 +16,32 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
 )
 
+// MonitorTmpExporterIntegrationMove moves tencentcloud_monitor_tmp_exporter_integration to
+// tencentcloud_monitor_tmp_exporter_integration_v2, both of which have the same id.
+var MonitorTmpExporterIntegrationMove = stateupgrade.Move{
+	From: "tencentcloud_monitor_tmp_exporter_integration",
+	To:   "tencentcloud_monitor_tmp_exporter_integration_v2",
+	ImportIds: func(attributes map[string]interface{}) ([]string, error) {
+		id, err := stateupgrade.String(attributes, "id")
+		if err != nil {
+			return nil, err
+		}
+		return []string{id}, nil
+	},
+}
+
 func ResourceTencentCloudMonitorTmpExporterIntegrationV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMonitorTmpExporterIntegrationV2Create,
 		Read:   resourceTencentCloudMonitorTmpExporterIntegrationV2Read,
 		Update: resourceTencentCloudMonitorTmpExporterIntegrationV2Update,
 		Delete: resourceTencentCloudMonitorTmpExporterIntegrationV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMonitorTmpExporterIntegrationV2Import,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -221,6 +239,28 @@ func resourceTencentCloudMonitorTmpExporterIntegrationV2Read(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudMonitorTmpExporterIntegrationV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids := strings.Split(d.Id(), tccommon.FILED_SP)
+	switch len(ids) {
+	case 5:
+		kubeType, err := strconv.Atoi(ids[2])
+		if err != nil {
+			return nil, fmt.Errorf("id is broken, kube_type %s is not an integer, id is %s", ids[2], d.Id())
+		}
+		_ = d.Set("instance_id", ids[1])
+		_ = d.Set("kube_type", kubeType)
+		_ = d.Set("cluster_id", ids[3])
+		_ = d.Set("kind", ids[4])
+	case 3:
+		_ = d.Set("instance_id", ids[1])
+		_ = d.Set("kind", ids[2])
+	default:
+		return nil, fmt.Errorf("id is broken, it should be name#instance_id#kube_type#cluster_id#kind or name#instance_id#kind, id is %s", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMonitorTmpExporterIntegrationV2Update(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_exporter_integration_v2.update")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -0,0 +1,42 @@
+package tmp_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudMonitorExporterIntegrationV2_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheckCommon(t, tcacctest.ACCOUNT_TYPE_COMMON) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testExporterIntegrationV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_monitor_tmp_exporter_integration_v2.basic", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_exporter_integration_v2.basic", "instance_id", tcacctest.DefaultPrometheusId),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_exporter_integration_v2.basic", "kind", "qcloud-exporter"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_exporter_integration_v2.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the content read back is the config normalized by the service, such as its status.
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+var testExporterIntegrationV2_basic = fmt.Sprintf(`
+resource "tencentcloud_monitor_tmp_exporter_integration_v2" "basic" {
+  instance_id = "%s"
+  kind        = "qcloud-exporter"
+  content     = "{\"name\":\"tf-test-v2\",\"kind\":\"qcloud-exporter\",\"spec\":{\"scrapeSpec\":{\"interval\":\"1m\",\"timeout\":\"1m\"},\"instanceSpec\":{\"region\":\"Guangzhou\",\"role\":\"CM_QCSLinkedRoleInTMP\",\"useRole\":true,\"authProvider\":{\"method\":1,\"presetRole\":\"CM_QCSLinkedRoleInTMP\"},\"rateLimit\":1000,\"delaySeconds\":0,\"rangeSeconds\":0,\"reload_interval_minutes\":10},\"exporterSpec\":{\"cvm\":false,\"cbs\":true,\"imageRegistry\":\"ccr.ccs.tencentyun.com\",\"cpu\":\"0.25\",\"memory\":\"0.5Gi\"}},\"status\":{}}"
+}`, tcacctest.DefaultPrometheusId)
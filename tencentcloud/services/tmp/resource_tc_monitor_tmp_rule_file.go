@@ -0,0 +1,752 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
+	"gopkg.in/yaml.v2"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/promql"
+)
+
+const (
+	tmpRuleTypeRecord = "record"
+	tmpRuleTypeAlert  = "alert"
+
+	// the recording rules of a group are kept in one TMP recording rule, alerting rules are kept one by one.
+	tmpRuleFileEntryRecordingGroup = "recording_group"
+	tmpRuleFileEntryAlertingRule   = "alerting_rule"
+)
+
+func ResourceTencentCloudMonitorTmpRuleFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMonitorTmpRuleFileCreate,
+		Read:   resourceTencentCloudMonitorTmpRuleFileRead,
+		Update: resourceTencentCloudMonitorTmpRuleFileUpdate,
+		Delete: resourceTencentCloudMonitorTmpRuleFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMonitorTmpRuleFileImport,
+		},
+		CustomizeDiff: resourceTencentCloudMonitorTmpRuleFileCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance id.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the rule file, it identifies the rule file in the instance.",
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTmpRuleFile,
+				Description:  "Prometheus rule file in YAML, containing `groups` of recording and alerting rules. The file and every `expr` in it are validated at plan time.",
+			},
+			"rule_state": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: tccommon.ValidateAllowedIntValue([]int{2, 3}),
+				Description:  "State of all the rules in the file. Valid values: `2` - enabled, `3` - disabled. Default is `2`.",
+			},
+			"receivers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alarm notification template id list of the alerting rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rules of the file, the recording rules of a group are followed by its alerting rules. Each rule is diffed individually against the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group of the rule.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the rule, `record` or `alert`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the recorded metric or of the alert.",
+						},
+						"expr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PromQL expression of the rule.",
+						},
+						"duration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Duration an alert has to be pending before firing.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Labels of the rule.",
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Annotations of the alerting rule.",
+						},
+					},
+				},
+			},
+			"rule_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rules created in the instance for the file.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "`recording_group` for the recording rules of a group, `alerting_rule` for an alerting rule.",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group of the rules.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the group or of the alert.",
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule id in the instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tmpRuleFileEntry is a rule kept in the instance for a rule file.
+type tmpRuleFileEntry struct {
+	kind   string
+	group  string
+	name   string
+	ruleId string
+	// recordingGroup holds the recording rules of the group, alert the alerting rule.
+	recordingGroup *promql.RuleGroup
+	alert          *promql.Rule
+}
+
+func (e *tmpRuleFileEntry) key() string {
+	return strings.Join([]string{e.kind, e.group, e.name}, tccommon.FILED_SP)
+}
+
+// rules flattens the entry into the `rules` attribute.
+func (e *tmpRuleFileEntry) rules() []interface{} {
+	if e.alert != nil {
+		return []interface{}{tmpRuleToMap(e.group, *e.alert)}
+	}
+	result := make([]interface{}, 0, len(e.recordingGroup.Rules))
+	for _, rule := range e.recordingGroup.Rules {
+		result = append(result, tmpRuleToMap(e.group, rule))
+	}
+	return result
+}
+
+func (e *tmpRuleFileEntry) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    e.kind,
+		"group":   e.group,
+		"name":    e.name,
+		"rule_id": e.ruleId,
+	}
+}
+
+func tmpRuleToMap(group string, rule promql.Rule) map[string]interface{} {
+	ruleType, duration := tmpRuleTypeRecord, ""
+	if rule.Alert != "" {
+		ruleType = tmpRuleTypeAlert
+		duration = normalizeTmpRuleDuration(rule.For)
+	}
+	return map[string]interface{}{
+		"group":       group,
+		"type":        ruleType,
+		"name":        rule.Name(),
+		"expr":        strings.TrimSpace(rule.Expr),
+		"duration":    duration,
+		"labels":      stringMapToInterface(rule.Labels),
+		"annotations": stringMapToInterface(rule.Annotations),
+	}
+}
+
+func normalizeTmpRuleDuration(duration string) string {
+	if duration == "" {
+		return ""
+	}
+	if d, err := promql.ParseDuration(duration); err == nil {
+		if d == 0 {
+			return ""
+		}
+		return promql.FormatDuration(d)
+	}
+	return duration
+}
+
+func stringMapToInterface(m map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func validateTmpRuleFile(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseTmpRuleFile(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s is not a valid rule file: %v", k, err))
+	}
+	return
+}
+
+// parseTmpRuleFile parses and validates content, and splits it into the entries kept in the instance.
+func parseTmpRuleFile(content string) ([]*tmpRuleFileEntry, error) {
+	ruleFile, errs := promql.ParseRuleFile([]byte(content))
+
+	var entries []*tmpRuleFileEntry
+	if len(errs) == 0 {
+		alerts := make(map[string]bool)
+		for i := range ruleFile.Groups {
+			group := ruleFile.Groups[i]
+			recordingGroup := &promql.RuleGroup{Name: group.Name, Interval: group.Interval, Limit: group.Limit}
+			var alertEntries []*tmpRuleFileEntry
+
+			for j := range group.Rules {
+				rule := group.Rules[j]
+				if rule.Record != "" {
+					recordingGroup.Rules = append(recordingGroup.Rules, rule)
+					continue
+				}
+
+				if rule.KeepFiringFor != "" {
+					errs = append(errs, fmt.Errorf("group %q, rule %d, %q: field 'keep_firing_for' is not supported", group.Name, j+1, rule.Alert))
+				}
+				key := group.Name + tccommon.FILED_SP + rule.Alert
+				if alerts[key] {
+					errs = append(errs, fmt.Errorf("group %q, rule %d, %q: alert is defined more than once in the group", group.Name, j+1, rule.Alert))
+				}
+				alerts[key] = true
+				alertEntries = append(alertEntries, &tmpRuleFileEntry{kind: tmpRuleFileEntryAlertingRule, group: group.Name, name: rule.Alert, alert: &rule})
+			}
+
+			if len(recordingGroup.Rules) > 0 {
+				entries = append(entries, &tmpRuleFileEntry{kind: tmpRuleFileEntryRecordingGroup, group: group.Name, name: group.Name, recordingGroup: recordingGroup})
+			}
+			entries = append(entries, alertEntries...)
+		}
+		if len(entries) == 0 {
+			errs = append(errs, fmt.Errorf("at least one rule is required"))
+		}
+	}
+
+	if len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return nil, fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	return entries, nil
+}
+
+func tmpRuleFileRules(entries []*tmpRuleFileEntry) []interface{} {
+	rules := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		rules = append(rules, entry.rules()...)
+	}
+	return rules
+}
+
+func tmpRuleFileKeys(entries []*tmpRuleFileEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.key())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func tmpRuleFileEntriesFromState(raw interface{}) []*tmpRuleFileEntry {
+	var entries []*tmpRuleFileEntry
+	for _, item := range raw.([]interface{}) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entries = append(entries, &tmpRuleFileEntry{
+			kind:   m["type"].(string),
+			group:  m["group"].(string),
+			name:   m["name"].(string),
+			ruleId: m["rule_id"].(string),
+		})
+	}
+	return entries
+}
+
+func resourceTencentCloudMonitorTmpRuleFileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content") {
+		if err := d.SetNewComputed("rules"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("rule_ids")
+	}
+
+	entries, err := parseTmpRuleFile(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetNew("rules", tmpRuleFileRules(entries)); err != nil {
+		return err
+	}
+
+	current := tmpRuleFileEntriesFromState(d.Get("rule_ids"))
+	adoptTmpRuleFileEntries(entries, current)
+	if d.Id() == "" || !reflect.DeepEqual(tmpRuleFileKeys(entries), tmpRuleFileKeys(current)) {
+		return d.SetNewComputed("rule_ids")
+	}
+	return nil
+}
+
+func resourceTencentCloudMonitorTmpRuleFileCreate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_rule_file.create")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	d.SetId(strings.Join([]string{instanceId, name}, tccommon.FILED_SP))
+
+	if err := resourceTencentCloudMonitorTmpRuleFileReconcile(d, meta); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudMonitorTmpRuleFileRead(d, meta)
+}
+
+// describeTmpRuleFileRules returns the recording and alerting rules of the instance which are not deleted, by rule id.
+func describeTmpRuleFileRules(ctx context.Context, service *MonitorService, instanceId string) (map[string]*monitor.RecordingRuleSet, map[string]*monitor.PrometheusRuleSet, error) {
+	var (
+		recordingRules []*monitor.RecordingRuleSet
+		alertRules     []*monitor.PrometheusRuleSet
+	)
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var e error
+		if recordingRules, e = service.DescribeMonitorTmpRecordingRules(ctx, instanceId); e != nil {
+			return tccommon.RetryError(e)
+		}
+		if alertRules, e = service.DescribeMonitorTmpAlertRules(ctx, instanceId); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// rules in state 1 are deleted.
+	recordingRuleMap := make(map[string]*monitor.RecordingRuleSet, len(recordingRules))
+	for _, rule := range recordingRules {
+		if helper.PInt64(rule.RuleState) != 1 {
+			recordingRuleMap[helper.PString(rule.RuleId)] = rule
+		}
+	}
+	alertRuleMap := make(map[string]*monitor.PrometheusRuleSet, len(alertRules))
+	for _, rule := range alertRules {
+		if helper.PInt64(rule.RuleState) != 1 {
+			alertRuleMap[helper.PString(rule.RuleId)] = rule
+		}
+	}
+	return recordingRuleMap, alertRuleMap, nil
+}
+
+// adoptTmpRuleFileEntries sets the group of the imported alerting rules in current, as the alerting rules of the
+// instance are not grouped, to the group of the only alerting rule of the same name in desired.
+func adoptTmpRuleFileEntries(desired, current []*tmpRuleFileEntry) {
+	groups := make(map[string][]string)
+	for _, entry := range desired {
+		if entry.kind == tmpRuleFileEntryAlertingRule {
+			groups[entry.name] = append(groups[entry.name], entry.group)
+		}
+	}
+	for _, entry := range current {
+		if entry.kind == tmpRuleFileEntryAlertingRule && entry.group == "" && len(groups[entry.name]) == 1 {
+			entry.group = groups[entry.name][0]
+		}
+	}
+}
+
+func resourceTencentCloudMonitorTmpRuleFileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+	)
+
+	ids := strings.Split(d.Id(), tccommon.FILED_SP)
+	if len(ids) < 3 {
+		return nil, fmt.Errorf("import id should be `<instance_id>#<name>#<rule_id>[#<rule_id>...]`, got %s", d.Id())
+	}
+	instanceId, name := ids[0], ids[1]
+
+	recordingRuleMap, alertRuleMap, err := describeTmpRuleFileRules(ctx, &service, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the group of an alerting rule is not kept in the instance, it is set from the content at the next apply.
+	ruleIds := make([]interface{}, 0, len(ids)-2)
+	for _, ruleId := range ids[2:] {
+		var entry *tmpRuleFileEntry
+		if rule, ok := recordingRuleMap[ruleId]; ok {
+			entry = &tmpRuleFileEntry{kind: tmpRuleFileEntryRecordingGroup, group: helper.PString(rule.Name), name: helper.PString(rule.Name), ruleId: ruleId}
+		} else if rule, ok := alertRuleMap[ruleId]; ok {
+			entry = &tmpRuleFileEntry{kind: tmpRuleFileEntryAlertingRule, name: helper.PString(rule.RuleName), ruleId: ruleId}
+		} else {
+			return nil, fmt.Errorf("rule %s is not found in instance %s", ruleId, instanceId)
+		}
+		ruleIds = append(ruleIds, entry.toMap())
+	}
+
+	_ = d.Set("instance_id", instanceId)
+	_ = d.Set("name", name)
+	_ = d.Set("rule_ids", ruleIds)
+	d.SetId(strings.Join([]string{instanceId, name}, tccommon.FILED_SP))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTencentCloudMonitorTmpRuleFileRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_rule_file.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+	)
+
+	ids := strings.Split(d.Id(), tccommon.FILED_SP)
+	if len(ids) != 2 {
+		return fmt.Errorf("id is broken, id is %s", d.Id())
+	}
+	instanceId := ids[0]
+
+	recordingRuleMap, alertRuleMap, err := describeTmpRuleFileRules(ctx, &service, instanceId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		entries   []*tmpRuleFileEntry
+		ruleState *int64
+		receivers []*string
+	)
+	for _, entry := range tmpRuleFileEntriesFromState(d.Get("rule_ids")) {
+		switch entry.kind {
+		case tmpRuleFileEntryRecordingGroup:
+			remote, ok := recordingRuleMap[entry.ruleId]
+			if !ok {
+				log.Printf("[WARN]%s recording rules of group %q [%s] not found in %s, they will be recreated.\n", logId, entry.group, entry.ruleId, instanceId)
+				continue
+			}
+			group, err := tccommon.Base64ToString(helper.PString(remote.Group))
+			if err != nil {
+				return fmt.Errorf("`RecordingRuleSet.Group` %s does not be decoded to yaml", helper.PString(remote.Group))
+			}
+			entry.recordingGroup = &promql.RuleGroup{}
+			if err := yaml.Unmarshal([]byte(group), entry.recordingGroup); err != nil {
+				return fmt.Errorf("recording rules of group %q [%s] are not valid yaml: %v", entry.group, entry.ruleId, err)
+			}
+			if ruleState == nil {
+				ruleState = remote.RuleState
+			}
+		case tmpRuleFileEntryAlertingRule:
+			remote, ok := alertRuleMap[entry.ruleId]
+			if !ok {
+				log.Printf("[WARN]%s alerting rule %q [%s] not found in %s, it will be recreated.\n", logId, entry.name, entry.ruleId, instanceId)
+				continue
+			}
+			entry.name = helper.PString(remote.RuleName)
+			entry.alert = &promql.Rule{
+				Alert:       entry.name,
+				Expr:        helper.PString(remote.Expr),
+				For:         helper.PString(remote.Duration),
+				Labels:      tmpRuleKVsToMap(remote.Labels),
+				Annotations: tmpRuleKVsToMap(remote.Annotations),
+			}
+			if ruleState == nil {
+				ruleState = remote.RuleState
+			}
+			if receivers == nil {
+				receivers = remote.Receivers
+			}
+		default:
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 && len(d.Get("rule_ids").([]interface{})) > 0 {
+		log.Printf("[WARN]%s resource `monitor_tmp_rule_file` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("instance_id", instanceId)
+	_ = d.Set("name", ids[1])
+	if ruleState != nil {
+		_ = d.Set("rule_state", ruleState)
+	}
+	if receivers != nil {
+		_ = d.Set("receivers", helper.StringsInterfaces(receivers))
+	}
+	_ = d.Set("rules", tmpRuleFileRules(entries))
+
+	ruleIds := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		ruleIds = append(ruleIds, entry.toMap())
+	}
+	_ = d.Set("rule_ids", ruleIds)
+
+	return nil
+}
+
+func resourceTencentCloudMonitorTmpRuleFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_rule_file.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	if err := resourceTencentCloudMonitorTmpRuleFileReconcile(d, meta); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudMonitorTmpRuleFileRead(d, meta)
+}
+
+// resourceTencentCloudMonitorTmpRuleFileReconcile creates, updates and deletes the rules in the instance
+// so that they match the content. The rules reconciled so far are kept in `rule_ids` even if it fails.
+func resourceTencentCloudMonitorTmpRuleFileReconcile(d *schema.ResourceData, meta interface{}) (errRet error) {
+	var (
+		logId      = tccommon.GetLogId(tccommon.ContextNil)
+		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service    = NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+		instanceId = d.Get("instance_id").(string)
+		ruleState  = int64(d.Get("rule_state").(int))
+		receivers  []*string
+	)
+
+	for _, receiver := range d.Get("receivers").(*schema.Set).List() {
+		receivers = append(receivers, helper.String(receiver.(string)))
+	}
+
+	desired, err := parseTmpRuleFile(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	oldRuleIds, _ := d.GetChange("rule_ids")
+	oldEntries := tmpRuleFileEntriesFromState(oldRuleIds)
+	adoptTmpRuleFileEntries(desired, oldEntries)
+	current := make(map[string]*tmpRuleFileEntry)
+	for _, entry := range oldEntries {
+		current[entry.key()] = entry
+	}
+
+	// the rules of every entry before the change, to update only the entries which changed.
+	oldRules := make(map[string][]interface{})
+	oldRulesRaw, _ := d.GetChange("rules")
+	for _, item := range oldRulesRaw.([]interface{}) {
+		m := item.(map[string]interface{})
+		kind, name := tmpRuleFileEntryRecordingGroup, m["group"].(string)
+		if m["type"].(string) == tmpRuleTypeAlert {
+			kind, name = tmpRuleFileEntryAlertingRule, m["name"].(string)
+		}
+		key := strings.Join([]string{kind, m["group"].(string), name}, tccommon.FILED_SP)
+		oldRules[key] = append(oldRules[key], m)
+	}
+	stateChanged := d.HasChange("rule_state") || d.HasChange("receivers")
+
+	reconciled := make([]interface{}, 0, len(desired))
+	defer func() {
+		// keep track of the rules which are not deleted yet.
+		kept := reconciled
+		if errRet != nil {
+			for _, entry := range current {
+				kept = append(kept, entry.toMap())
+			}
+		}
+		_ = d.Set("rule_ids", kept)
+	}()
+
+	for _, entry := range desired {
+		existing, ok := current[entry.key()]
+		if ok {
+			entry.ruleId = existing.ruleId
+			delete(current, entry.key())
+		}
+
+		if ok && !stateChanged && reflect.DeepEqual(oldRules[entry.key()], entry.rules()) {
+			reconciled = append(reconciled, entry.toMap())
+			continue
+		}
+
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			var e error
+			switch {
+			case entry.kind == tmpRuleFileEntryRecordingGroup:
+				group, err := yaml.Marshal(entry.recordingGroup)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+				if ok {
+					e = service.UpdateMonitorTmpRecordingRule(ctx, instanceId, entry.ruleId, entry.name, string(group), ruleState)
+				} else {
+					entry.ruleId, e = service.CreateMonitorTmpRecordingRule(ctx, instanceId, entry.name, string(group), ruleState)
+				}
+			case ok:
+				rule := tmpAlertRuleSet(entry, ruleState, receivers)
+				rule.RuleId = helper.String(entry.ruleId)
+				e = service.UpdateMonitorTmpAlertRule(ctx, instanceId, rule)
+			default:
+				entry.ruleId, e = service.CreateMonitorTmpAlertRule(ctx, instanceId, tmpAlertRuleSet(entry, ruleState, receivers))
+			}
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s reconcile %s %q of monitor tmp rule file failed, reason:%+v", logId, entry.kind, entry.name, err)
+			return err
+		}
+		reconciled = append(reconciled, entry.toMap())
+	}
+
+	if err := deleteTmpRuleFileEntries(ctx, &service, instanceId, current); err != nil {
+		return err
+	}
+	current = nil
+
+	return nil
+}
+
+func deleteTmpRuleFileEntries(ctx context.Context, service *MonitorService, instanceId string, entries map[string]*tmpRuleFileEntry) error {
+	logId := tccommon.GetLogId(ctx)
+
+	var recordingRuleIds, alertRuleIds []string
+	for _, entry := range entries {
+		if entry.kind == tmpRuleFileEntryRecordingGroup {
+			recordingRuleIds = append(recordingRuleIds, entry.ruleId)
+		} else {
+			alertRuleIds = append(alertRuleIds, entry.ruleId)
+		}
+	}
+	sort.Strings(recordingRuleIds)
+	sort.Strings(alertRuleIds)
+
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		if len(recordingRuleIds) > 0 {
+			if e := service.DeleteMonitorTmpRecordingRules(ctx, instanceId, recordingRuleIds); e != nil {
+				return tccommon.RetryError(e)
+			}
+			recordingRuleIds = nil
+		}
+		if len(alertRuleIds) > 0 {
+			if e := service.DeleteMonitorTmpAlertRules(ctx, instanceId, alertRuleIds); e != nil {
+				return tccommon.RetryError(e)
+			}
+			alertRuleIds = nil
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete monitor tmp rules failed, reason:%+v", logId, err)
+		return err
+	}
+	return nil
+}
+
+func tmpAlertRuleSet(entry *tmpRuleFileEntry, ruleState int64, receivers []*string) *monitor.PrometheusRuleSet {
+	rule := &monitor.PrometheusRuleSet{
+		RuleName:    helper.String(entry.alert.Alert),
+		Expr:        helper.String(strings.TrimSpace(entry.alert.Expr)),
+		RuleState:   helper.Int64(ruleState),
+		Receivers:   receivers,
+		Labels:      tmpRuleMapToKVs(entry.alert.Labels),
+		Annotations: tmpRuleMapToKVs(entry.alert.Annotations),
+	}
+	if entry.alert.For != "" {
+		rule.Duration = helper.String(entry.alert.For)
+	}
+	return rule
+}
+
+func tmpRuleMapToKVs(m map[string]string) []*monitor.PrometheusRuleKV {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	kvs := make([]*monitor.PrometheusRuleKV, 0, len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, &monitor.PrometheusRuleKV{Key: helper.String(k), Value: helper.String(m[k])})
+	}
+	return kvs
+}
+
+func tmpRuleKVsToMap(kvs []*monitor.PrometheusRuleKV) map[string]string {
+	m := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		if kv.Key != nil {
+			m[*kv.Key] = helper.PString(kv.Value)
+		}
+	}
+	return m
+}
+
+func resourceTencentCloudMonitorTmpRuleFileDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_rule_file.delete")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+	)
+
+	entries := make(map[string]*tmpRuleFileEntry)
+	for _, entry := range tmpRuleFileEntriesFromState(d.Get("rule_ids")) {
+		entries[entry.key()] = entry
+	}
+
+	return deleteTmpRuleFileEntries(ctx, &service, d.Get("instance_id").(string), entries)
+}
@@ -0,0 +1,116 @@
+package tmp_test
+
+import (
+	"regexp"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudMonitorTmpRuleFileResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitorTmpRuleFileInvalid,
+				ExpectError: regexp.MustCompile(`expected type range vector in call to function "rate"`),
+			},
+			{
+				Config: testAccMonitorTmpRuleFile,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_monitor_tmp_rule_file.rule_file", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.0.type", "record"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.1.type", "alert"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.1.duration", "10m"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rule_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccMonitorTmpRuleFileUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rule_state", "3"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.#", "3"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rules.2.name", "NodeDown"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_rule_file.rule_file", "rule_ids.#", "3"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_monitor_tmp_rule_file.rule_file",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+const testAccMonitorTmpRuleFileVar = `
+variable "prometheus_id" {
+  default = "` + tcacctest.DefaultPrometheusId + `"
+}
+`
+
+const testAccMonitorTmpRuleFileInvalid = testAccMonitorTmpRuleFileVar + `
+resource "tencentcloud_monitor_tmp_rule_file" "rule_file" {
+  instance_id = var.prometheus_id
+  name        = "tf-rule-file"
+  content     = <<EOF
+groups:
+  - name: tf-api
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total))
+EOF
+}
+`
+
+const testAccMonitorTmpRuleFile = testAccMonitorTmpRuleFileVar + `
+resource "tencentcloud_monitor_tmp_rule_file" "rule_file" {
+  instance_id = var.prometheus_id
+  name        = "tf-rule-file"
+  content     = <<EOF
+groups:
+  - name: tf-api
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighRequestRate
+        expr: job:http_requests:rate5m > 1000
+        for: 600s
+        labels:
+          severity: warning
+EOF
+}
+`
+
+const testAccMonitorTmpRuleFileUpdate = testAccMonitorTmpRuleFileVar + `
+resource "tencentcloud_monitor_tmp_rule_file" "rule_file" {
+  instance_id = var.prometheus_id
+  name        = "tf-rule-file"
+  rule_state  = 3
+  content     = <<EOF
+groups:
+  - name: tf-api
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighRequestRate
+        expr: job:http_requests:rate5m > 2000
+        for: 10m
+        labels:
+          severity: warning
+  - name: tf-node
+    rules:
+      - alert: NodeDown
+        expr: up{job="node"} == 0
+        for: 5m
+EOF
+}
+`
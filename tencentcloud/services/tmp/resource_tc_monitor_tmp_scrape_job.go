@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svcmonitor "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/monitor"
@@ -13,9 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var tmpScrapeJobIdFormat = compositeid.New(
+	compositeid.String("job_id"),
+	compositeid.String("instance_id"),
+	compositeid.String("agent_id"),
+)
+
 func ResourceTencentCloudMonitorTmpScrapeJob() *schema.Resource {
 	return &schema.Resource{
 		Read:   resourceTencentCloudMonitorTmpScrapeJobRead,
@@ -94,7 +100,7 @@ func resourceTencentCloudMonitorTmpScrapeJobCreate(d *schema.ResourceData, meta
 
 	tmpScrapeJobId := *response.Response.JobId
 
-	d.SetId(strings.Join([]string{tmpScrapeJobId, instanceId, agentId}, tccommon.FILED_SP))
+	d.SetId(tmpScrapeJobIdFormat.Join(tmpScrapeJobId, instanceId, agentId))
 
 	return resourceTencentCloudMonitorTmpScrapeJobRead(d, meta)
 }
@@ -109,6 +115,10 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 	service := svcmonitor.NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 
 	tmpScrapeJobId := d.Id()
+	id, err := tmpScrapeJobIdFormat.Parse(tmpScrapeJobId)
+	if err != nil {
+		return err
+	}
 
 	tmpScrapeJob, err := service.DescribeMonitorTmpScrapeJob(ctx, tmpScrapeJobId)
 
@@ -122,7 +132,7 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 		return nil
 	}
 
-	_ = d.Set("instance_id", strings.Split(tmpScrapeJobId, tccommon.FILED_SP)[1])
+	_ = d.Set("instance_id", id.Get("instance_id"))
 	if tmpScrapeJob.AgentId != nil {
 		_ = d.Set("agent_id", tmpScrapeJob.AgentId)
 	}
@@ -142,11 +152,14 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewUpdatePrometheusScrapeJobRequest()
 
-	ids := strings.Split(d.Id(), tccommon.FILED_SP)
+	id, err := tmpScrapeJobIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
-	request.JobId = &ids[0]
-	request.InstanceId = &ids[1]
-	request.AgentId = &ids[2]
+	request.JobId = helper.String(id.Get("job_id"))
+	request.InstanceId = helper.String(id.Get("instance_id"))
+	request.AgentId = helper.String(id.Get("agent_id"))
 
 	if d.HasChange("instance_id") {
 		return fmt.Errorf("`instance_id` do not support change now.")
@@ -162,7 +175,7 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMonitorClient().UpdatePrometheusScrapeJob(request)
 		if e != nil {
 			return tccommon.RetryError(e)
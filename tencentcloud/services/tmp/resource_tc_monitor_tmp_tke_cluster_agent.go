@@ -22,6 +22,9 @@ func ResourceTencentCloudMonitorTmpTkeClusterAgent() *schema.Resource {
 		Create: resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
 		Update: resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
 		Delete: resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -332,6 +335,8 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentRead(d *schema.ResourceData, m
 		return nil
 	}
 
+	_ = d.Set("instance_id", instanceId)
+
 	var agents []map[string]interface{}
 	agent := make(map[string]interface{})
 	if v, ok := d.GetOk("agents"); ok && len(v.([]interface{})) > 0 {
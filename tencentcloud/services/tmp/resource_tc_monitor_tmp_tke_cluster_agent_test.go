@@ -66,6 +66,11 @@ func TestAccTencentCloudMonitorClusterAgent_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_cluster_agent.basic", "agents.0.open_default_record", "true"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_cluster_agent.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
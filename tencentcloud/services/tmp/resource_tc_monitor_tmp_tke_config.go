@@ -21,6 +21,9 @@ func ResourceTencentCloudMonitorTmpTkeConfig() *schema.Resource {
 		Read:   resourceTencentCloudTkeTmpConfigRead,
 		Update: resourceTencentCloudTkeTmpConfigUpdate,
 		Delete: resourceTencentCloudTkeTmpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -144,6 +147,14 @@ func resourceTencentCloudTkeTmpConfigRead(d *schema.ResourceData, meta interface
 		return nil
 	}
 
+	ids, err := service.ParseConfigId(configId)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("instance_id", ids.InstanceId)
+	_ = d.Set("cluster_type", ids.ClusterType)
+	_ = d.Set("cluster_id", ids.ClusterId)
+
 	if e := d.Set("config", params.Config); e != nil {
 		log.Printf("[CRITAL]%s provider set config fail, reason:%s\n", logId, e.Error())
 		return e
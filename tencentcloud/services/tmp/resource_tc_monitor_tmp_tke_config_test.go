@@ -91,6 +91,12 @@ func TestAccTencentCloudMonitorTmpTkeConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_config.basic", "pod_monitors.0.config", "apiVersion: monitoring.coreos.com/v1\nkind: PodMonitor\nmetadata:\n  name: "+pod_monitors_name+"\n  namespace: kube-system\nspec:\n  podMetricsEndpoints:\n    - interval: 20s\n      port: metric-port\n      path: /metrics\n      relabelings:\n        - action: replace\n          sourceLabels:\n            - instance\n          regex: (.*)\n          targetLabel: instance\n          replacement: xxxxxx\n  namespaceSelector:\n    matchNames:\n      - test\n  selector:\n    matchLabels:\n      k8s-app: test"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_tmp_tke_config.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pod_monitors", "raw_jobs", "service_monitors"},
+			},
 		},
 	})
 }
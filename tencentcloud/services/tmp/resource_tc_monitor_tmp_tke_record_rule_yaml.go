@@ -2,9 +2,7 @@ package tmp
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svcmonitor "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/monitor"
@@ -13,18 +11,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// tmpTkeRecordRuleYamlIdFormat is the id of a record rule, which is also its import id.
+var tmpTkeRecordRuleYamlIdFormat = compositeid.New(compositeid.String("instance_id"), compositeid.String("name"))
+
 func ResourceTencentCloudMonitorTmpTkeRecordRuleYaml() *schema.Resource {
 	return &schema.Resource{
 		Read:   resourceTencentCloudTkeTmpRecordRuleYamlRead,
 		Create: resourceTencentCloudTkeTmpRecordRuleYamlCreate,
 		Update: resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
 		Delete: resourceTencentCloudTkeTmpRecordRuleYamlDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: tmpTkeRecordRuleYamlIdFormat.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -107,7 +109,7 @@ func resourceTencentCloudTkeTmpRecordRuleYamlCreate(d *schema.ResourceData, meta
 	}
 
 	instanceId := *request.InstanceId
-	d.SetId(strings.Join([]string{instanceId, tmpRecordRuleName}, tccommon.FILED_SP))
+	d.SetId(tmpTkeRecordRuleYamlIdFormat.Join(instanceId, tmpRecordRuleName))
 	return resourceTencentCloudTkeTmpRecordRuleYamlRead(d, meta)
 }
 
@@ -118,13 +120,13 @@ func resourceTencentCloudTkeTmpRecordRuleYamlRead(d *schema.ResourceData, meta i
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
-	ids := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	id, err := tmpTkeRecordRuleYamlIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	instanceId := ids[0]
-	name := ids[1]
+	instanceId := id.Get("instance_id")
+	name := id.Get("name")
 
 	recordRuleService := svcmonitor.NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 	request, err := recordRuleService.DescribePrometheusRecordRuleByName(ctx, instanceId, name)
@@ -169,13 +171,13 @@ func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewModifyPrometheusRecordRuleYamlRequest()
 
-	ids := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	id, err := tmpTkeRecordRuleYamlIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
-	request.InstanceId = &ids[0]
-	request.Name = &ids[1]
+	request.InstanceId = helper.String(id.Get("instance_id"))
+	request.Name = helper.String(id.Get("name"))
 
 	if d.HasChange("content") {
 		if v, ok := d.GetOk("content"); ok {
@@ -210,13 +212,13 @@ func resourceTencentCloudTkeTmpRecordRuleYamlDelete(d *schema.ResourceData, meta
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
-	ids := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	id, err := tmpTkeRecordRuleYamlIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
 	service := svcmonitor.NewMonitorService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
-	if err := service.DeletePrometheusRecordRuleYaml(ctx, ids[0], ids[1]); err != nil {
+	if err := service.DeletePrometheusRecordRuleYaml(ctx, id.Get("instance_id"), id.Get("name")); err != nil {
 		return err
 	}
 
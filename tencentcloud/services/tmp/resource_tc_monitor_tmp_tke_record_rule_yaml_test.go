@@ -75,11 +75,11 @@ func TestAccTencentCloudMonitorRecordRuleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_record_rule_yaml.basic", "content", "apiVersion: monitoring.coreos.com/v1\nkind: PrometheusRule\nmetadata:\n  name: example-record-test\nspec:\n  groups:\n    - name: kube-apiserver.rules\n      rules:\n        - expr: sum(metrics_test)\n          labels:\n            verb: read\n          record: 'apiserver_request:burnrate1d'\n    - name: kube-apiserver.rules2\n      rules:\n        - expr: sum(metrics_test2)\n          labels:\n            verb: read\n          record: 'apiserver_request:burnrate1d2'"),
 				),
 			},
-			//{
-			//	ResourceName:      "tencentcloud_monitor_tmp_tke_record_rule_yaml.foo",
-			//	ImportState:       true,
-			//	ImportStateVerify: true,
-			//},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_record_rule_yaml.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
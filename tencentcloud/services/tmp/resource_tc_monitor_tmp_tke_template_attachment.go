@@ -21,6 +21,9 @@ func ResourceTencentCloudMonitorTmpTkeTemplateAttachment() *schema.Resource {
 		Read:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
 		Create: resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
 		Delete: resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"template_id": {
 				Type:        schema.TypeString,
@@ -205,6 +208,7 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(d *schema.ResourceD
 			})
 		}
 	}
+	_ = d.Set("template_id", templateId)
 	_ = d.Set("targets", tempTargets)
 
 	return nil
@@ -45,3 +45,253 @@ func (me *MonitorService) DescribeMonitorTmpMultipleWritesListById(ctx context.C
 	ret = response.Response
 	return
 }
+
+func (me *MonitorService) DescribeMonitorTmpRecordingRules(ctx context.Context, instanceId string) (ret []*monitorv20180724.RecordingRuleSet, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewDescribeRecordingRulesRequest()
+	request.InstanceId = helper.String(instanceId)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset int64 = 0
+		limit  int64 = 100
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseMonitorV20180724Client().DescribeRecordingRules(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.RecordingRuleSet) < 1 {
+			break
+		}
+		ret = append(ret, response.Response.RecordingRuleSet...)
+		if len(response.Response.RecordingRuleSet) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
+
+func (me *MonitorService) CreateMonitorTmpRecordingRule(ctx context.Context, instanceId, name, group string, ruleState int64) (ruleId string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewCreateRecordingRuleRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.Name = helper.String(name)
+	request.Group = helper.String(tccommon.StringToBase64(group))
+	request.RuleState = helper.Int64(ruleState)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().CreateRecordingRule(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	ruleId = helper.PString(response.Response.RuleId)
+	return
+}
+
+func (me *MonitorService) UpdateMonitorTmpRecordingRule(ctx context.Context, instanceId, ruleId, name, group string, ruleState int64) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewUpdateRecordingRuleRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.RuleId = helper.String(ruleId)
+	request.Name = helper.String(name)
+	request.Group = helper.String(tccommon.StringToBase64(group))
+	request.RuleState = helper.Int64(ruleState)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().UpdateRecordingRule(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *MonitorService) DeleteMonitorTmpRecordingRules(ctx context.Context, instanceId string, ruleIds []string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewDeleteRecordingRulesRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.RuleIds = helper.Strings(ruleIds)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().DeleteRecordingRules(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *MonitorService) DescribeMonitorTmpAlertRules(ctx context.Context, instanceId string) (ret []*monitorv20180724.PrometheusRuleSet, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewDescribeAlertRulesRequest()
+	request.InstanceId = helper.String(instanceId)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset int64 = 0
+		limit  int64 = 100
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseMonitorV20180724Client().DescribeAlertRules(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.AlertRuleSet) < 1 {
+			break
+		}
+		ret = append(ret, response.Response.AlertRuleSet...)
+		if len(response.Response.AlertRuleSet) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
+
+func (me *MonitorService) CreateMonitorTmpAlertRule(ctx context.Context, instanceId string, rule *monitorv20180724.PrometheusRuleSet) (ruleId string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewCreateAlertRuleRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.RuleName = rule.RuleName
+	request.Expr = rule.Expr
+	request.Duration = rule.Duration
+	request.RuleState = rule.RuleState
+	request.Receivers = rule.Receivers
+	request.Labels = rule.Labels
+	request.Annotations = rule.Annotations
+	request.Type = rule.Type
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().CreateAlertRule(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	ruleId = helper.PString(response.Response.RuleId)
+	return
+}
+
+func (me *MonitorService) UpdateMonitorTmpAlertRule(ctx context.Context, instanceId string, rule *monitorv20180724.PrometheusRuleSet) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewUpdateAlertRuleRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.RuleId = rule.RuleId
+	request.RuleName = rule.RuleName
+	request.Expr = rule.Expr
+	request.Duration = rule.Duration
+	request.RuleState = rule.RuleState
+	request.Receivers = rule.Receivers
+	request.Labels = rule.Labels
+	request.Annotations = rule.Annotations
+	request.Type = rule.Type
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().UpdateAlertRule(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *MonitorService) DeleteMonitorTmpAlertRules(ctx context.Context, instanceId string, ruleIds []string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := monitorv20180724.NewDeleteAlertRulesRequest()
+	request.InstanceId = helper.String(instanceId)
+	request.RuleIds = helper.Strings(ruleIds)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMonitorV20180724Client().DeleteAlertRules(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
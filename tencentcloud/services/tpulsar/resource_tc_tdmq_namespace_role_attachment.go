@@ -13,15 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// tdmqNamespaceRoleAttachmentImportIdFormat is the import id of an attachment, it is the id of the
+// resource followed by the cluster id, which is not a part of the id.
+var tdmqNamespaceRoleAttachmentImportIdFormat = compositeid.New(compositeid.String("environ_id"), compositeid.String("role_name"), compositeid.String("cluster_id"))
+
 func ResourceTencentCloudTdmqNamespaceRoleAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTdmqNamespaceRoleAttachmentCreate,
 		Read:   resourceTencentCloudTdmqNamespaceRoleAttachmentRead,
 		Update: resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate,
 		Delete: resourceTencentCloudTdmqNamespaceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudTdmqNamespaceRoleAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"environ_id": {
@@ -105,6 +113,16 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentCreate(d *schema.ResourceDat
 	return resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d, meta)
 }
 
+func resourceTencentCloudTdmqNamespaceRoleAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := tdmqNamespaceRoleAttachmentImportIdFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("cluster_id", id.Get("cluster_id"))
+	d.SetId(id.Get("environ_id") + tccommon.FILED_SP + id.Get("role_name"))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_tdmq_namespace_role_attachment.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -34,6 +34,12 @@ func TestAccTencentCloudTdmqNamespceRoleAttachmentResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(terraformId, "cluster_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tdmq_namespace_role_attachment.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_tdmq_namespace_role_attachment.example", "environ_id", "role_name", "cluster_id"),
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,12 +15,18 @@ import (
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 )
 
+// tdmqRoleImportIdFormat is the import id of a role, the id of the resource is the role name.
+var tdmqRoleImportIdFormat = compositeid.New(compositeid.String("cluster_id"), compositeid.String("id"))
+
 func ResourceTencentCloudTdmqRole() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTdmqRoleCreate,
 		Read:   resourceTencentCloudTdmqRoleRead,
 		Update: resourceTencentCloudTdmqRoleUpdate,
 		Delete: resourceTencentCloudTdmqRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: tdmqRoleImportIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -32,6 +32,12 @@ func TestAccTencentCloudTdmqRoleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(terraformId, "remark", "remark update."),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tdmq_role.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_tdmq_role.example", "cluster_id", "id"),
+			},
 		},
 	})
 }
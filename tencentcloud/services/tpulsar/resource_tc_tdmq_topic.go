@@ -2,6 +2,7 @@ package tpulsar
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
@@ -13,12 +14,18 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 )
 
+// tdmqTopicImportIdFormat is the import id of a topic, the id of the resource is the topic name.
+var tdmqTopicImportIdFormat = compositeid.New(compositeid.String("cluster_id"), compositeid.String("environ_id"), compositeid.String("id"))
+
 func ResourceTencentCloudTdmqTopic() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTdmqTopicCreate,
 		Read:   resourceTencentCloudTdmqTopicRead,
 		Update: resourceTencentCloudTdmqTopicUpdate,
 		Delete: resourceTencentCloudTdmqTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: tdmqTopicImportIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"environ_id": {
@@ -159,6 +166,7 @@ func resourceTencentCloudTdmqTopicRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
+		_ = d.Set("topic_name", topicName)
 		_ = d.Set("partitions", info.Partitions)
 		_ = d.Set("topic_type", info.TopicType)
 		_ = d.Set("pulsar_topic_type", info.PulsarTopicType)
@@ -38,6 +38,12 @@ func TestAccTencentCloudTdmqTopicResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(terraformId, "remark", "remark update."),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tdmq_topic.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: tcacctest.AccImportStateIdFunc("tencentcloud_tdmq_topic.example", "cluster_id", "environ_id", "id"),
+			},
 		},
 	})
 }
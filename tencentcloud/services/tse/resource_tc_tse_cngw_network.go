@@ -21,6 +21,9 @@ func ResourceTencentCloudTseCngwNetwork() *schema.Resource {
 		Read:   resourceTencentCloudTseCngwNetworkRead,
 		Update: resourceTencentCloudTseCngwNetworkUpdate,
 		Delete: resourceTencentCloudTseCngwNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -19,6 +19,11 @@ func TestAccTencentCloudNeedFixTseCngwNetworkResource_basic(t *testing.T) {
 				Config: testAccTseCngwNetwork,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_tse_cngw_network.cngw_network", "id")),
 			},
+			{
+				ResourceName:      "tencentcloud_tse_cngw_network.cngw_network",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
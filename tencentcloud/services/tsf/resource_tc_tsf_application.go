@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -20,9 +21,9 @@ func ResourceTencentCloudTsfApplication() *schema.Resource {
 		Read:   resourceTencentCloudTsfApplicationRead,
 		Update: resourceTencentCloudTsfApplicationUpdate,
 		Delete: resourceTencentCloudTsfApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Required:    true,
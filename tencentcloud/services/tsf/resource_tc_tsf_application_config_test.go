@@ -34,11 +34,11 @@ func TestAccTencentCloudTsfApplicationConfigResource_basic(t *testing.T) {
 					// resource.TestCheckResourceAttr("tencentcloud_tsf_application_config.application_config", "encode_with_base64", "false"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_application_config.application_config",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_application_config.application_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
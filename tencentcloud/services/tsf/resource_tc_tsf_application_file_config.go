@@ -18,6 +18,9 @@ func ResourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 		Create: resourceTencentCloudTsfApplicationFileConfigCreate,
 		Read:   resourceTencentCloudTsfApplicationFileConfigRead,
 		Delete: resourceTencentCloudTsfApplicationFileConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"config_name": {
@@ -38,6 +38,11 @@ func TestAccTencentCloudTsfApplicationFileConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_application_file_config.application_file_config", "config_version_desc", "1.0"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tsf_application_file_config.application_file_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
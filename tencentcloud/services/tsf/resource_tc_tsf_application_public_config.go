@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -18,9 +19,9 @@ func ResourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 		Create: resourceTencentCloudTsfApplicationPublicConfigCreate,
 		Read:   resourceTencentCloudTsfApplicationPublicConfigRead,
 		Delete: resourceTencentCloudTsfApplicationPublicConfigDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"config_name": {
 				Required:    true,
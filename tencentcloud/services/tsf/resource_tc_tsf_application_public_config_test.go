@@ -35,11 +35,11 @@ func TestAccTencentCloudTsfApplicationPublicConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_application_public_config.application_public_config", "encode_with_base64", "true"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_application_public_config.application_public_config",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_application_public_config.application_public_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
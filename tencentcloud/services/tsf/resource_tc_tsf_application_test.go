@@ -43,11 +43,11 @@ func TestAccTencentCloudTsfApplicationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_application.application", "service_config_list.0.ports.0.target_port", "8080"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_application.application",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_application.application",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -22,9 +23,9 @@ func ResourceTencentCloudTsfCluster() *schema.Resource {
 		Read:   resourceTencentCloudTsfClusterRead,
 		Update: resourceTencentCloudTsfClusterUpdate,
 		Delete: resourceTencentCloudTsfClusterDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Computed:    true,
@@ -38,11 +38,11 @@ func TestAccTencentCloudTsfClusterResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_cluster.cluster", "tags.createdBy", "terraform"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_cluster.cluster",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_cluster.cluster",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
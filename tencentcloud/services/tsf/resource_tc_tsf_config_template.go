@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -20,9 +21,9 @@ func ResourceTencentCloudTsfConfigTemplate() *schema.Resource {
 		Read:   resourceTencentCloudTsfConfigTemplateRead,
 		Update: resourceTencentCloudTsfConfigTemplateUpdate,
 		Delete: resourceTencentCloudTsfConfigTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: compositeid.Single.ImportState,
+		},
 		Schema: map[string]*schema.Schema{
 			"config_template_name": {
 				Required:    true,
@@ -34,11 +34,11 @@ func TestAccTencentCloudTsfConfigTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_config_template.config_template", "config_template_desc", "terraform-test"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_config_template.config_template",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_config_template.config_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccTsfConfigTemplateUpdate,
 				Check: resource.ComposeTestCheckFunc(
@@ -37,11 +37,11 @@ func TestAccTencentCloudTsfLaneRuleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_lane_rule.lane_rule", "rule_tag_list.0.tag_value", "222"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_lane_rule.lane_rule",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_lane_rule.lane_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
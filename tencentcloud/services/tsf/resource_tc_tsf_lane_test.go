@@ -34,11 +34,11 @@ func TestAccTencentCloudTsfLaneResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_lane.lane", "lane_group_list.0.entrance", "true"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_lane.lane",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_lane.lane",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
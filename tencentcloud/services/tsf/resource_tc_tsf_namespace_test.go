@@ -33,11 +33,11 @@ func TestAccTencentCloudTsfNamespaceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_namespace.namespace", "is_ha_enable", "0"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_namespace.namespace",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_namespace.namespace",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,9 +21,9 @@ func ResourceTencentCloudTsfTask() *schema.Resource {
 		Read:   resourceTencentCloudTsfTaskRead,
 		Update: resourceTencentCloudTsfTaskUpdate,
 		Delete: resourceTencentCloudTsfTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"task_id": {
 				Computed:    true,
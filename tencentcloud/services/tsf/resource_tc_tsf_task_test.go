@@ -44,11 +44,11 @@ func TestAccTencentCloudTsfTaskResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_task.task", "task_argument", "a=c"),
 				),
 			},
-			// {
-			// 	ResourceName:      "tencentcloud_tsf_task.task",
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// },
+			{
+				ResourceName:      "tencentcloud_tsf_task.task",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
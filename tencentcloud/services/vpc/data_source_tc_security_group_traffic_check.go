@@ -0,0 +1,324 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sgrule"
+)
+
+func DataSourceTencentCloudSecurityGroupTrafficCheck() *schema.Resource {
+	ruleElem := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tccommon.ValidateAllowedStringValueIgnoreCase([]string{"ACCEPT", "DROP"}),
+				Description:  "Rule policy. Valid values: `ACCEPT` and `DROP`.",
+			},
+			"cidr_block": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An IP address, an IP range or a CIDR segment.",
+			},
+			"ipv6_cidr_block": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An IPv6 address, an IPv6 range or a CIDR segment.",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Type of IP protocol. Valid values: `TCP`, `UDP`, `ICMP`, `ICMPv6`, `GRE` and `ALL`. Default is `ALL`.",
+			},
+			"port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Range of the port, e.g. `80`, `80,90`, `80-90` or `ALL`. Default is `ALL`.",
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudSecurityGroupTrafficCheckRead,
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ingress", "egress"},
+				ExactlyOneOf:  []string{"security_group_id", "ingress", "egress"},
+				Description:   "ID of the security group whose rules are checked. Conflicts with `ingress` and `egress`.",
+			},
+			"ingress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        ruleElem,
+				Description: "Ordered ingress rules checked instead of the rules of a security group.",
+			},
+			"egress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        ruleElem,
+				Description: "Ordered egress rules checked instead of the rules of a security group.",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sgrule.DirectionIngress,
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{sgrule.DirectionIngress, sgrule.DirectionEgress}),
+				Description:  "Direction of the traffic. Valid values: `ingress`, `egress`. Default is `ingress`.",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Source IP of ingress traffic, or destination IP of egress traffic.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tccommon.ValidateAllowedStringValueIgnoreCase([]string{"TCP", "UDP", "ICMP", "ICMPv6", "GRE"}),
+				Description:  "Protocol of the traffic. Valid values: `TCP`, `UDP`, `ICMP`, `ICMPv6` and `GRE`.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: tccommon.ValidateIntegerInRange(sgrule.MinPort, sgrule.MaxPort),
+				Description:  "Destination port of the traffic. Required when `protocol` is `TCP` or `UDP`.",
+			},
+			"resolve_address_templates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to query the addresses of address templates and address template groups referred by the rules of `security_group_id`. Default is `true`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed values.
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the traffic is accepted.",
+			},
+			"matched_rule_index": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Index of the rule deciding the traffic, `-1` if no rule matches and the traffic is dropped.",
+			},
+			"matched_rule": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the rule deciding the traffic.",
+			},
+			"undetermined_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Earlier rules which may match the traffic but cannot be evaluated offline, such as rules referring to security groups or service templates. The result is accurate only when it is empty.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudSecurityGroupTrafficCheckRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_security_group_traffic_check.read")()
+
+	var (
+		logId     = tccommon.GetLogId(tccommon.ContextNil)
+		ctx       = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service   = VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		direction = d.Get("direction").(string)
+		protocol  = strings.ToUpper(d.Get("protocol").(string))
+		port      = d.Get("port").(int)
+		specs     []sgrule.Spec
+	)
+
+	address, err := netip.ParseAddr(d.Get("address").(string))
+	if err != nil {
+		return fmt.Errorf("invalid address %q", d.Get("address").(string))
+	}
+
+	if (protocol == sgrule.ProtocolTCP || protocol == sgrule.ProtocolUDP) && port == 0 {
+		return fmt.Errorf("`port` is required when `protocol` is `%s`", protocol)
+	}
+
+	securityGroupId := d.Get("security_group_id").(string)
+	if securityGroupId != "" {
+		var policySet *vpc.SecurityGroupPolicySet
+		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeSecurityGroupPolicies(ctx, securityGroupId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			policySet = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if policySet == nil {
+			return fmt.Errorf("security group %s does not exist", securityGroupId)
+		}
+
+		policies := policySet.Ingress
+		if direction == sgrule.DirectionEgress {
+			policies = policySet.Egress
+		}
+		specs = securityPolicySpecsFromApi(policies)
+	} else {
+		specs = securityPolicySpecs(d.Get(direction).([]interface{}))
+	}
+
+	rules := make([]*sgrule.Rule, 0, len(specs))
+	for i, spec := range specs {
+		rule, e := sgrule.Parse(direction, i, spec)
+		if e != nil {
+			return e
+		}
+		rules = append(rules, rule)
+	}
+
+	if securityGroupId != "" && d.Get("resolve_address_templates").(bool) {
+		resolver := addressTemplateResolver(ctx, &service)
+		for _, rule := range rules {
+			if e := rule.Resolve(resolver); e != nil {
+				return e
+			}
+		}
+	}
+
+	decision := sgrule.Evaluate(rules, sgrule.Traffic{
+		Address:  address,
+		Protocol: protocol,
+		Port:     port,
+	})
+
+	matchedRuleIndex := -1
+	matchedRule := ""
+	if decision.Rule != nil {
+		matchedRuleIndex = decision.Rule.Index
+		matchedRule = decision.Rule.String()
+	}
+
+	undeterminedRules := make([]string, 0, len(decision.Undetermined))
+	for _, rule := range decision.Undetermined {
+		undeterminedRules = append(undeterminedRules, rule.String())
+	}
+
+	_ = d.Set("allowed", decision.Allowed)
+	_ = d.Set("matched_rule_index", matchedRuleIndex)
+	_ = d.Set("matched_rule", matchedRule)
+	_ = d.Set("undetermined_rules", undeterminedRules)
+
+	d.SetId(helper.DataResourceIdsHash([]string{securityGroupId, direction, address.String(), protocol, fmt.Sprint(port)}))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		result := map[string]interface{}{
+			"allowed":            decision.Allowed,
+			"matched_rule_index": matchedRuleIndex,
+			"matched_rule":       matchedRule,
+			"undetermined_rules": undeterminedRules,
+		}
+		if e := tccommon.WriteToFile(output.(string), result); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func securityPolicySpecsFromApi(policies []*vpc.SecurityGroupPolicy) []sgrule.Spec {
+	specs := make([]sgrule.Spec, 0, len(policies))
+	for _, policy := range policies {
+		spec := sgrule.Spec{}
+		if policy.Action != nil {
+			spec.Action = *policy.Action
+		}
+		if policy.Protocol != nil {
+			spec.Protocol = *policy.Protocol
+		}
+		if policy.Port != nil {
+			spec.Port = *policy.Port
+		}
+		if policy.CidrBlock != nil {
+			spec.CidrBlock = *policy.CidrBlock
+		}
+		if policy.Ipv6CidrBlock != nil {
+			spec.Ipv6CidrBlock = *policy.Ipv6CidrBlock
+		}
+		if policy.SecurityGroupId != nil {
+			spec.SourceSecurityId = *policy.SecurityGroupId
+		}
+		if policy.AddressTemplate != nil && policy.AddressTemplate.AddressId != nil {
+			spec.AddressTemplateId = *policy.AddressTemplate.AddressId
+		}
+		if policy.AddressTemplate != nil && policy.AddressTemplate.AddressGroupId != nil {
+			spec.AddressTemplateGroup = *policy.AddressTemplate.AddressGroupId
+		}
+		if policy.ServiceTemplate != nil && policy.ServiceTemplate.ServiceId != nil {
+			spec.ServiceTemplateId = *policy.ServiceTemplate.ServiceId
+		}
+		if policy.ServiceTemplate != nil && policy.ServiceTemplate.ServiceGroupId != nil {
+			spec.ServiceTemplateGroup = *policy.ServiceTemplate.ServiceGroupId
+		}
+		if policy.PolicyDescription != nil {
+			spec.Description = *policy.PolicyDescription
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// addressTemplateResolver returns the addresses of address templates and address template groups,
+// security groups are left unresolved.
+func addressTemplateResolver(ctx context.Context, service *VpcService) func(reference string) ([]string, error) {
+	var resolve func(reference string) ([]string, error)
+	resolve = func(reference string) ([]string, error) {
+		switch {
+		case strings.HasPrefix(reference, "ipmg-"):
+			group, has, err := service.DescribeAddressTemplateGroupById(ctx, reference)
+			if err != nil || !has {
+				return nil, err
+			}
+			addresses := make([]string, 0)
+			for _, templateId := range group.AddressTemplateIdSet {
+				if templateId == nil {
+					continue
+				}
+				items, err := resolve(*templateId)
+				if err != nil || items == nil {
+					return nil, err
+				}
+				addresses = append(addresses, items...)
+			}
+			return addresses, nil
+		case strings.HasPrefix(reference, "ipm-"):
+			template, has, err := service.DescribeAddressTemplateById(ctx, reference)
+			if err != nil || !has {
+				return nil, err
+			}
+			addresses := make([]string, 0, len(template.AddressSet))
+			for _, address := range template.AddressSet {
+				if address != nil {
+					addresses = append(addresses, *address)
+				}
+			}
+			return addresses, nil
+		}
+		return nil, nil
+	}
+	return resolve
+}
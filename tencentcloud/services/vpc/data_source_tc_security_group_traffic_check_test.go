@@ -0,0 +1,100 @@
+package vpc_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudSecurityGroupTrafficCheckDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroupTrafficCheckDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_security_group_traffic_check.https"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.https", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.https", "matched_rule_index", "1"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.ssh", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.ssh", "matched_rule_index", "0"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.other", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.tencentcloud_security_group_traffic_check.other", "matched_rule_index", "-1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSecurityGroupTrafficCheckDataSource = `
+locals {
+  ingress = [
+    {
+      action     = "DROP"
+      cidr_block = "10.0.1.0/24"
+      protocol   = "TCP"
+      port       = "22"
+    },
+    {
+      action     = "ACCEPT"
+      cidr_block = "10.0.0.0/16"
+      protocol   = "TCP"
+      port       = "22,80,443"
+    },
+  ]
+}
+
+data "tencentcloud_security_group_traffic_check" "https" {
+  address  = "10.0.1.10"
+  protocol = "TCP"
+  port     = 443
+
+  dynamic "ingress" {
+    for_each = local.ingress
+    content {
+      action     = ingress.value.action
+      cidr_block = ingress.value.cidr_block
+      protocol   = ingress.value.protocol
+      port       = ingress.value.port
+    }
+  }
+}
+
+data "tencentcloud_security_group_traffic_check" "ssh" {
+  address  = "10.0.1.10"
+  protocol = "TCP"
+  port     = 22
+
+  dynamic "ingress" {
+    for_each = local.ingress
+    content {
+      action     = ingress.value.action
+      cidr_block = ingress.value.cidr_block
+      protocol   = ingress.value.protocol
+      port       = ingress.value.port
+    }
+  }
+}
+
+data "tencentcloud_security_group_traffic_check" "other" {
+  address  = "192.168.0.1"
+  protocol = "UDP"
+  port     = 53
+
+  dynamic "ingress" {
+    for_each = local.ingress
+    content {
+      action     = ingress.value.action
+      cidr_block = ingress.value.cidr_block
+      protocol   = ingress.value.protocol
+      port       = ingress.value.port
+    }
+  }
+}
+`
@@ -0,0 +1,236 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/cidralloc"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudVpcCidrAllocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudVpcCidrAllocationRead,
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the VPC to allocate subnet CIDR blocks in.",
+			},
+			"requests": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Subnet CIDR blocks to allocate. Larger blocks are allocated first, blocks of the same size are allocated in order. A request is allocated the CIDR block of the existing subnet with the same name, zone and prefix length if any, such as the subnet created from its allocation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the request, which must be unique.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Availability zone of the subnet.",
+						},
+						"prefix_length": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: tccommon.ValidateIntegerInRange(16, 28),
+							Description:  "Prefix length of the subnet CIDR block. Valid values: 16 to 28.",
+						},
+					},
+				},
+			},
+			"reserved_cidrs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: tccommon.ValidateCIDRNetworkAddress},
+				Description: "CIDR blocks which must not be allocated, in addition to the CIDR blocks of existing subnets.",
+			},
+			"use_secondary_cidrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to allocate from the secondary CIDR blocks of the VPC when the primary CIDR block is full. Default is `true`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed values.
+			"allocations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Allocated CIDR blocks, in the order of `requests`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the request.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Availability zone of the subnet.",
+						},
+						"prefix_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Prefix length of the subnet CIDR block.",
+						},
+						"cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Allocated subnet CIDR block.",
+						},
+						"vpc_cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CIDR block of the VPC the subnet CIDR block is allocated from.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpcCidrAllocationRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_vpc_cidr_allocation.read")()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		vpcId   = d.Get("vpc_id").(string)
+		info    VpcBasicInfo
+		has     int
+		subnets []VpcSubnetBasicInfo
+	)
+
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var e error
+		info, has, e = service.DescribeVpc(ctx, vpcId, "", "")
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		return fmt.Errorf("vpc %s does not exist", vpcId)
+	}
+
+	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var e error
+		subnets, e = service.DescribeSubnets(ctx, "", vpcId, "", "", nil, nil, nil, "", "", "")
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	cidrs := []string{info.cidr}
+	if d.Get("use_secondary_cidrs").(bool) {
+		cidrs = append(cidrs, info.assistantCidrs...)
+	}
+	pools, err := parseCidrs(cidrs)
+	if err != nil {
+		return err
+	}
+
+	// Subnets created from earlier allocations keep their CIDR blocks, the others are used.
+	existing := make([]cidralloc.Existing, 0, len(subnets))
+	for _, subnet := range subnets {
+		prefix, err := netip.ParsePrefix(subnet.cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q of subnet %s", subnet.cidr, subnet.subnetId)
+		}
+		existing = append(existing, cidralloc.Existing{Name: subnet.name, Zone: subnet.zone, Prefix: prefix.Masked()})
+	}
+
+	// Container network CIDR blocks of the VPC cannot be used by subnets either.
+	usedCidrs := append([]string{}, info.dockerAssistantCidrs...)
+	for _, cidr := range d.Get("reserved_cidrs").([]interface{}) {
+		usedCidrs = append(usedCidrs, cidr.(string))
+	}
+	used, err := parseCidrs(usedCidrs)
+	if err != nil {
+		return err
+	}
+
+	var (
+		rawRequests = d.Get("requests").([]interface{})
+		requests    = make([]cidralloc.Request, 0, len(rawRequests))
+		names       = make(map[string]bool, len(rawRequests))
+		ids         = []string{vpcId}
+	)
+	for _, item := range rawRequests {
+		m := item.(map[string]interface{})
+		request := cidralloc.Request{
+			Name:         m["name"].(string),
+			Zone:         m["zone"].(string),
+			PrefixLength: m["prefix_length"].(int),
+		}
+		if names[request.Name] {
+			return fmt.Errorf("duplicate request name %q", request.Name)
+		}
+		names[request.Name] = true
+		requests = append(requests, request)
+		ids = append(ids, fmt.Sprintf("%s/%s/%d", request.Name, request.Zone, request.PrefixLength))
+	}
+
+	allocations, err := cidralloc.AllocateExisting(pools, used, existing, requests)
+	if err != nil {
+		return fmt.Errorf("allocate subnet CIDR blocks in vpc %s: %v", vpcId, err)
+	}
+
+	allocationList := make([]map[string]interface{}, 0, len(allocations))
+	for _, allocation := range allocations {
+		allocationList = append(allocationList, map[string]interface{}{
+			"name":           allocation.Name,
+			"zone":           allocation.Zone,
+			"prefix_length":  allocation.PrefixLength,
+			"cidr_block":     allocation.Prefix.String(),
+			"vpc_cidr_block": allocation.Pool.String(),
+		})
+	}
+	_ = d.Set("allocations", allocationList)
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), allocationList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func parseCidrs(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", cidr)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,70 @@
+package vpc_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudVpcCidrAllocationDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcCidrAllocationDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_vpc_cidr_allocation.allocation"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.#", "3"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.0.name", "app"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.0.cidr_block", "10.0.17.0/24"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.0.vpc_cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.1.cidr_block", "10.0.18.0/26"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpc_cidr_allocation.allocation", "allocations.2.cidr_block", "10.0.32.0/20"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpcCidrAllocationDataSource = tcacctest.DefaultAzVariable + `
+resource "tencentcloud_vpc" "vpc" {
+  name       = "tf-example-cidr-allocation"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+  vpc_id            = tencentcloud_vpc.vpc.id
+  name              = "tf-example-cidr-allocation"
+  availability_zone = var.default_az
+  cidr_block        = "10.0.16.0/24"
+}
+
+data "tencentcloud_vpc_cidr_allocation" "allocation" {
+  vpc_id         = tencentcloud_subnet.subnet.vpc_id
+  reserved_cidrs = ["10.0.0.0/20"]
+
+  requests {
+    name          = "app"
+    zone          = var.default_az
+    prefix_length = 24
+  }
+
+  requests {
+    name          = "db"
+    zone          = var.default_az
+    prefix_length = 26
+  }
+
+  requests {
+    name          = "k8s"
+    zone          = var.default_az
+    prefix_length = 20
+  }
+}
+`
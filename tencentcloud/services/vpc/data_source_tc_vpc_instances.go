@@ -17,7 +17,7 @@ import (
 )
 
 func DataSourceTencentCloudVpcInstances() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithOrganizationFanOut("instance_list", true, &schema.Resource{
 		Read: dataSourceTencentCloudVpcInstancesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -134,7 +134,7 @@ func DataSourceTencentCloudVpcInstances() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interface{}) error {
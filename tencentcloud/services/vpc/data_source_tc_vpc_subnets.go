@@ -17,7 +17,7 @@ import (
 )
 
 func DataSourceTencentCloudVpcSubnets() *schema.Resource {
-	return &schema.Resource{
+	return tccommon.WithResultFilter("instance_list", &schema.Resource{
 		Read: dataSourceTencentCloudVpcSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
@@ -147,7 +147,7 @@ func DataSourceTencentCloudVpcSubnets() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface{}) error {
@@ -10,9 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// natGatewaySnatIdFormat is the id of a snat, which is also its import id. The resource id is the
+// id of its subnet or its network interface.
+var natGatewaySnatIdFormat = compositeid.New(compositeid.String("nat_gateway_id"), compositeid.String("resource_id"))
+
 func ResourceTencentCloudNatGatewaySnat() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudNatGatewaySnatCreate,
@@ -20,7 +25,7 @@ func ResourceTencentCloudNatGatewaySnat() *schema.Resource {
 		Update: resourceTencentCloudNatGatewaySnatUpdate,
 		Delete: resourceTencentCloudNatGatewaySnatDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: natGatewaySnatIdFormat.ImportStatePassthrough,
 		},
 
 		Schema: NatGatewaySnatPara(),
@@ -130,7 +135,7 @@ func resourceTencentCloudNatGatewaySnatCreate(d *schema.ResourceData, meta inter
 		return errors.New("[CRITAL] create nat gateway snat failed: read result is empty")
 	}
 	rule := result[len(result)-1]
-	d.SetId(helper.IdFormat(*rule.NatGatewayId, *rule.ResourceId))
+	d.SetId(natGatewaySnatIdFormat.Join(*rule.NatGatewayId, *rule.ResourceId))
 
 	return resourceTencentCloudNatGatewaySnatRead(d, meta)
 }
@@ -145,19 +150,20 @@ func resourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta interfa
 		service = VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{resource_id}'")
+	compositeId, err := natGatewaySnatIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
+	natGatewayId, resourceId := compositeId.Get("nat_gateway_id"), compositeId.Get("resource_id")
 
-	err, snatList := service.DescribeNatGatewaySnats(ctx, compositeId[0], nil)
+	err, snatList := service.DescribeNatGatewaySnats(ctx, natGatewayId, nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s read nat gateway snat failed, reason:%s\n", logId, err.Error())
 		return err
 	}
 	var snat *vpc.SourceIpTranslationNatRule
 	for _, s := range snatList {
-		if compositeId[1] == *s.ResourceId {
+		if resourceId == *s.ResourceId {
 			snat = s
 		}
 	}
@@ -191,20 +197,20 @@ func resourceTencentCloudNatGatewaySnatUpdate(d *schema.ResourceData, meta inter
 		service = VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{snat_id}'")
+	compositeId, err := natGatewaySnatIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
 
 	// param valid
-	err := paramValid(d)
+	err = paramValid(d)
 	if err != nil {
 		return err
 	}
 
 	// update
 	snat := getSnat(d)
-	err = service.ModifyNatGatewaySnat(ctx, compositeId[0], snat)
+	err = service.ModifyNatGatewaySnat(ctx, compositeId.Get("nat_gateway_id"), snat)
 	if err != nil {
 		log.Printf("[CRITAL]%s modify nat gateway snat failed, reason:%s\n", logId, err.Error())
 		return err
@@ -52,6 +52,9 @@ func ResourceTencentCloudRouteEntry() *schema.Resource {
 		Create:             resourceTencentCloudRouteEntryCreate,
 		Read:               resourceTencentCloudRouteEntryRead,
 		Delete:             resourceTencentCloudRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -31,6 +31,11 @@ func TestAccTencentCloudVpcV2RouteEntryBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_route_entry.foo", "next_type", "eip"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_route_entry.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
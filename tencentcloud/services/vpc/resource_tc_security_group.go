@@ -2,6 +2,7 @@ package vpc
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
 
 	"context"
@@ -14,6 +15,7 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
 )
 
 func ResourceTencentCloudSecurityGroup() *schema.Resource {
@@ -53,6 +55,11 @@ func ResourceTencentCloudSecurityGroup() *schema.Resource {
 				Description: "Tags of the security group.",
 			},
 		},
+
+		CustomizeDiff: tccommon.QuotaCheckDiff(func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+			vpcService := VpcService{client: client}
+			return "security_group", "security groups", vpcService.DescribeSecurityGroupQuotaUsage
+		}),
 	}
 }
 
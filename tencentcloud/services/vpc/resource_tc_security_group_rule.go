@@ -23,6 +23,9 @@ func ResourceTencentCloudSecurityGroupRule() *schema.Resource {
 		Create: resourceTencentCloudSecurityGroupRuleCreate,
 		Read:   resourceTencentCloudSecurityGroupRuleRead,
 		Delete: resourceTencentCloudSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -364,6 +367,9 @@ func resourceTencentCloudSecurityGroupRuleRead(d *schema.ResourceData, m interfa
 
 		if policy.Protocol != nil {
 			inputProtocol := d.Get("ip_protocol").(string)
+			if inputProtocol == "" {
+				inputProtocol = strings.ToUpper(*policy.Protocol)
+			}
 			if inputProtocol == "" {
 				inputProtocol = "ALL"
 			}
@@ -373,7 +379,11 @@ func resourceTencentCloudSecurityGroupRuleRead(d *schema.ResourceData, m interfa
 			_ = d.Set("port_range", *policy.Port)
 		}
 
-		_ = d.Set("policy", d.Get("policy").(string))
+		if inputPolicy := d.Get("policy").(string); inputPolicy != "" {
+			_ = d.Set("policy", inputPolicy)
+		} else if policy.Action != nil {
+			_ = d.Set("policy", *policy.Action)
+		}
 
 		if policy.PolicyDescription != nil {
 			_ = d.Set("description", *policy.PolicyDescription)
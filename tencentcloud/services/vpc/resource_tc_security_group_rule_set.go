@@ -9,13 +9,28 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
+	"github.com/hashicorp/go-cty/cty"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sgrule"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_IGNORE = "ignore"
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN   = "warn"
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_ERROR  = "error"
+)
+
+var SECURITY_GROUP_RULE_ANALYSIS_LEVEL = []string{
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_IGNORE,
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+	SECURITY_GROUP_RULE_ANALYSIS_LEVEL_ERROR,
+}
+
 func ResourceTencentCloudSecurityGroupRuleSet() *schema.Resource {
 	ruleElem := map[string]*schema.Schema{
 		"action": {
@@ -83,10 +98,10 @@ func ResourceTencentCloudSecurityGroupRuleSet() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		Create: resourceTencentCloudSecurityGroupRuleSetCreate,
-		Read:   resourceTencentCloudSecurityGroupRuleSetRead,
-		Update: resourceTencentCloudSecurityGroupRuleSetUpdate,
-		Delete: resourceTencentCloudSecurityGroupRuleSetDelete,
+		CreateContext: securityGroupRuleSetWithFindings(resourceTencentCloudSecurityGroupRuleSetCreate),
+		ReadContext:   securityGroupRuleSetWithFindings(resourceTencentCloudSecurityGroupRuleSetRead),
+		UpdateContext: securityGroupRuleSetWithFindings(resourceTencentCloudSecurityGroupRuleSetUpdate),
+		Delete:        resourceTencentCloudSecurityGroupRuleSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -109,12 +124,89 @@ func ResourceTencentCloudSecurityGroupRuleSet() *schema.Resource {
 				Description: "List of egress rule. NOTE: this block is ordered, the first rule has the highest priority.",
 				Elem:        &schema.Resource{Schema: ruleElem},
 			},
+			"rule_analysis": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Policies of the plan-time rule analysis. Findings are exported in `rule_findings`, and findings at level `error` fail the plan. All findings are at level `warn` if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shadowed_rule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(SECURITY_GROUP_RULE_ANALYSIS_LEVEL),
+							Description:  "Level of rules which never take effect or are redundant because an earlier rule matches all their traffic. Valid values: `ignore`, `warn`, `error`. Default is `warn`.",
+						},
+						"duplicate_rule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(SECURITY_GROUP_RULE_ANALYSIS_LEVEL),
+							Description:  "Level of rules which duplicate an earlier rule. Valid values: `ignore`, `warn`, `error`. Default is `warn`.",
+						},
+						"world_open_rule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(SECURITY_GROUP_RULE_ANALYSIS_LEVEL),
+							Description:  "Level of ingress rules accepting traffic from `0.0.0.0/0` or `::/0` to `sensitive_ports`. Valid values: `ignore`, `warn`, `error`. Default is `warn`.",
+						},
+						"sensitive_ports": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: tccommon.ValidateIntegerInRange(sgrule.MinPort, sgrule.MaxPort)},
+							Description: "Ports which should not be open to the world. Default is `[22, 3389]`.",
+						},
+					},
+				},
+			},
 			"version": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Security policies version, auto increment for every update.",
 			},
+			"rule_findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Findings of the rule analysis, also reported as warnings on refresh and apply. Address templates are resolved, rules referring to security groups or service templates are not analyzed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the finding, `shadowed`, `duplicate`, `world_open`, or `unanalyzed` for rules which cannot be parsed.",
+						},
+						"level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Level of the finding, `warn` or `error`.",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Direction of the rule, `ingress` or `egress`.",
+						},
+						"rule_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Index of the rule in the `ingress` or `egress` block.",
+						},
+						"related_rule_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Index of the earlier rule shadowed or duplicated by the rule, `-1` if none.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the finding.",
+						},
+					},
+				},
+			},
 		},
+		CustomizeDiff: resourceTencentCloudSecurityGroupRuleSetCustomizeDiff,
 	}
 }
 
@@ -200,9 +292,190 @@ func resourceTencentCloudSecurityGroupRuleSetRead(d *schema.ResourceData, m inte
 		_ = d.Set("egress", marshalSecurityPolicy(result.Egress))
 	}
 
+	findings, _ := analyzeSecurityGroupRuleSet(
+		ctx,
+		securityPolicySpecs(d.Get("ingress").([]interface{})),
+		securityPolicySpecs(d.Get("egress").([]interface{})),
+		d.Get("rule_analysis").([]interface{}),
+		addressTemplateResolver(ctx, &service),
+	)
+	_ = d.Set("rule_findings", findings)
+
 	return nil
 }
 
+func resourceTencentCloudSecurityGroupRuleSetCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	// analyze rules as they are declared, optional computed `protocol` and `port` are unknown in the plan otherwise
+	specs := make(map[string][]sgrule.Spec)
+	for _, direction := range []string{sgrule.DirectionIngress, sgrule.DirectionEgress} {
+		rules := config.GetAttr(direction)
+		if !rules.IsWhollyKnown() {
+			return diff.SetNewComputed("rule_findings")
+		}
+		specs[direction] = securityPolicySpecsFromConfig(rules)
+	}
+
+	var resolve func(reference string) ([]string, error)
+	if m != nil {
+		service := VpcService{client: m.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		resolve = addressTemplateResolver(ctx, &service)
+	}
+	findings, errs := analyzeSecurityGroupRuleSet(ctx, specs[sgrule.DirectionIngress], specs[sgrule.DirectionEgress], diff.Get("rule_analysis").([]interface{}), resolve)
+	if len(errs) > 0 {
+		return fmt.Errorf("security group rule analysis failed:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return diff.SetNew("rule_findings", findings)
+}
+
+// analyzeSecurityGroupRuleSet returns the findings of the rules and the messages of findings at level `error`.
+// Address templates referred to by the rules are resolved by resolve if not nil, the rules which cannot be
+// parsed or resolved are reported or left out of the analysis instead of failing it.
+func analyzeSecurityGroupRuleSet(ctx context.Context, ingress, egress []sgrule.Spec, analysis []interface{}, resolve func(reference string) ([]string, error)) (findings []map[string]interface{}, errs []string) {
+	logId := tccommon.GetLogId(ctx)
+	levels := map[string]string{
+		sgrule.FindingShadowed:   SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+		sgrule.FindingDuplicate:  SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+		sgrule.FindingWorldOpen:  SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+		sgrule.FindingUnanalyzed: SECURITY_GROUP_RULE_ANALYSIS_LEVEL_WARN,
+	}
+	sensitivePorts := sgrule.DefaultSensitivePorts
+
+	if len(analysis) > 0 && analysis[0] != nil {
+		item := analysis[0].(map[string]interface{})
+		levels[sgrule.FindingShadowed] = item["shadowed_rule"].(string)
+		levels[sgrule.FindingDuplicate] = item["duplicate_rule"].(string)
+		levels[sgrule.FindingWorldOpen] = item["world_open_rule"].(string)
+		if ports := item["sensitive_ports"].([]interface{}); len(ports) > 0 {
+			sensitivePorts = make([]int, 0, len(ports))
+			for _, port := range ports {
+				sensitivePorts = append(sensitivePorts, port.(int))
+			}
+		}
+	}
+
+	findings = make([]map[string]interface{}, 0)
+	for _, direction := range []string{sgrule.DirectionIngress, sgrule.DirectionEgress} {
+		specs := ingress
+		if direction == sgrule.DirectionEgress {
+			specs = egress
+		}
+		rules, unanalyzed := sgrule.ParseAll(direction, specs)
+		for _, rule := range rules {
+			if e := rule.Resolve(resolve); e != nil {
+				log.Printf("[WARN]%s skip resolving %s of security group rule, reason: %s", logId, rule.Reference, e.Error())
+			}
+		}
+
+		for _, finding := range append(unanalyzed, sgrule.Analyze(rules, sensitivePorts)...) {
+			level := levels[finding.Type]
+			if level == SECURITY_GROUP_RULE_ANALYSIS_LEVEL_IGNORE {
+				continue
+			}
+			if level == SECURITY_GROUP_RULE_ANALYSIS_LEVEL_ERROR {
+				errs = append(errs, finding.Message)
+			}
+			findings = append(findings, map[string]interface{}{
+				"type":               finding.Type,
+				"level":              level,
+				"direction":          finding.Direction,
+				"rule_index":         finding.Index,
+				"related_rule_index": finding.Related,
+				"message":            finding.Message,
+			})
+		}
+	}
+	return
+}
+
+// securityGroupRuleSetWithFindings wraps f to report the findings of the rule analysis set by it as warnings.
+func securityGroupRuleSetWithFindings(f func(d *schema.ResourceData, m interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := f(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+
+		var diags diag.Diagnostics
+		for _, v := range d.Get("rule_findings").([]interface{}) {
+			finding, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Security group rule analysis: %s rule", finding["type"]),
+				Detail:   finding["message"].(string),
+			})
+		}
+		return diags
+	}
+}
+
+func securityPolicySpecs(policies []interface{}) []sgrule.Spec {
+	specs := make([]sgrule.Spec, 0, len(policies))
+	for _, v := range policies {
+		policy := v.(map[string]interface{})
+		get := func(key string) string {
+			if value, ok := policy[key].(string); ok {
+				return value
+			}
+			return ""
+		}
+		specs = append(specs, sgrule.Spec{
+			Action:               get("action"),
+			Protocol:             get("protocol"),
+			Port:                 get("port"),
+			CidrBlock:            get("cidr_block"),
+			Ipv6CidrBlock:        get("ipv6_cidr_block"),
+			SourceSecurityId:     get("source_security_id"),
+			AddressTemplateId:    get("address_template_id"),
+			AddressTemplateGroup: get("address_template_group"),
+			ServiceTemplateId:    get("service_template_id"),
+			ServiceTemplateGroup: get("service_template_group"),
+			Description:          get("description"),
+		})
+	}
+	return specs
+}
+
+func securityPolicySpecsFromConfig(policies cty.Value) []sgrule.Spec {
+	specs := make([]sgrule.Spec, 0)
+	if policies.IsNull() {
+		return specs
+	}
+
+	get := func(policy cty.Value, key string) string {
+		v := policy.GetAttr(key)
+		if v.IsNull() {
+			return ""
+		}
+		return v.AsString()
+	}
+
+	for it := policies.ElementIterator(); it.Next(); {
+		_, policy := it.Element()
+		specs = append(specs, sgrule.Spec{
+			Action:               get(policy, "action"),
+			Protocol:             get(policy, "protocol"),
+			Port:                 get(policy, "port"),
+			CidrBlock:            get(policy, "cidr_block"),
+			Ipv6CidrBlock:        get(policy, "ipv6_cidr_block"),
+			SourceSecurityId:     get(policy, "source_security_id"),
+			AddressTemplateId:    get(policy, "address_template_id"),
+			AddressTemplateGroup: get(policy, "address_template_group"),
+			ServiceTemplateId:    get(policy, "service_template_id"),
+			ServiceTemplateGroup: get(policy, "service_template_group"),
+			Description:          get(policy, "description"),
+		})
+	}
+	return specs
+}
+
 func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m interface{}) error {
 	defer tccommon.LogElapsed("tencentcloud_security_group_rule_set.update")()
 
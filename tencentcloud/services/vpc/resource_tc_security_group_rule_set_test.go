@@ -7,6 +7,7 @@ import (
 
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -37,6 +38,10 @@ func TestAccTencentCloudSecurityGroupRuleSetResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "ingress.4.action", "DROP"),
 					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "egress.#", "3"),
 					resource.TestCheckResourceAttrSet("tencentcloud_security_group_rule_set.base", "version"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "rule_findings.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "rule_findings.0.type", "duplicate"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "rule_findings.0.rule_index", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group_rule_set.base", "rule_findings.0.related_rule_index", "1"),
 				),
 			},
 			{
@@ -84,6 +89,21 @@ func TestAccTencentCloudSecurityGroupRuleSetResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudSecurityGroupRuleSetResource_analysis(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecurityGroupRuleSetResource_analysis,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`opens sensitive port 22 to the world`),
+			},
+		},
+	})
+}
+
 func testAccCheckSecurityGroupRuleSetResource_basicExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := tccommon.GetLogId(tccommon.ContextNil)
@@ -318,3 +338,20 @@ resource "tencentcloud_security_group_rule_set" "base" {
   }
 }
 `
+
+const testAccSecurityGroupRuleSetResource_analysis = `
+resource "tencentcloud_security_group_rule_set" "analysis" {
+  security_group_id = "sg-xxxxxxxx"
+
+  ingress {
+    action     = "ACCEPT"
+    cidr_block = "0.0.0.0/0"
+    protocol   = "TCP"
+    port       = "22"
+  }
+
+  rule_analysis {
+    world_open_rule = "error"
+  }
+}
+`
@@ -35,6 +35,12 @@ func TestAccTencentCloudSecurityGroupRuleResource_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr("tencentcloud_security_group_rule.http-in", "source_sgid"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_security_group_rule.http-in",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_index"},
+			},
 		},
 	})
 }
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
 
 	"context"
@@ -13,6 +14,7 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
 )
 
 func ResourceTencentCloudVpcSubnet() *schema.Resource {
@@ -92,6 +94,17 @@ func ResourceTencentCloudVpcSubnet() *schema.Resource {
 				Description: "Creation time of subnet resource.",
 			},
 		},
+
+		CustomizeDiff: tccommon.QuotaCheckDiff(func(ctx context.Context, d *schema.ResourceDiff, client *connectivity.TencentCloudClient) (string, string, quota.FetchFunc) {
+			vpcId := d.Get("vpc_id").(string)
+			if !d.NewValueKnown("vpc_id") || vpcId == "" {
+				return "", "", nil
+			}
+			vpcService := VpcService{client: client}
+			return "subnet:" + vpcId, "subnets of " + vpcId, func(ctx context.Context) (quota.Usage, error) {
+				return vpcService.DescribeSubnetQuotaUsage(ctx, vpcId)
+			}
+		}),
 	}
 }
 
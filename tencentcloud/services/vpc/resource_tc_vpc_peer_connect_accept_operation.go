@@ -9,10 +9,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudVpcPeerConnectAcceptOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudVpcPeerConnectAcceptOperationCreate,
 		Read:   resourceTencentCloudVpcPeerConnectAcceptOperationRead,
 		Delete: resourceTencentCloudVpcPeerConnectAcceptOperationDelete,
@@ -24,7 +25,7 @@ func ResourceTencentCloudVpcPeerConnectAcceptOperation() *schema.Resource {
 				Description: "Peer connection unique ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudVpcPeerConnectAcceptOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -48,6 +49,7 @@ func resourceTencentCloudVpcPeerConnectAcceptOperationCreate(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
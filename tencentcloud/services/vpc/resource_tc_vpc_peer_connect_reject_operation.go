@@ -9,10 +9,11 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/operation"
 )
 
 func ResourceTencentCloudVpcPeerConnectRejectOperation() *schema.Resource {
-	return &schema.Resource{
+	return operation.Resource(&schema.Resource{
 		Create: resourceTencentCloudVpcPeerConnectRejectOperationCreate,
 		Read:   resourceTencentCloudVpcPeerConnectRejectOperationRead,
 		Delete: resourceTencentCloudVpcPeerConnectRejectOperationDelete,
@@ -24,7 +25,7 @@ func ResourceTencentCloudVpcPeerConnectRejectOperation() *schema.Resource {
 				Description: "Peer connection unique ID.",
 			},
 		},
-	}
+	})
 }
 
 func resourceTencentCloudVpcPeerConnectRejectOperationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -48,6 +49,7 @@ func resourceTencentCloudVpcPeerConnectRejectOperationCreate(d *schema.ResourceD
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			operation.SetResponse(d, result)
 		}
 		return nil
 	})
@@ -24,6 +24,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/quota"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -56,6 +57,10 @@ func (info VpcBasicInfo) CreateTime() string {
 	return info.createTime
 }
 
+func (info VpcBasicInfo) Tags() []*vpc.Tag {
+	return info.tags
+}
+
 // subnet basic information
 type VpcSubnetBasicInfo struct {
 	vpcId            string
@@ -138,6 +143,10 @@ func (info VpcRouteTableBasicInfo) Name() string {
 	return info.name
 }
 
+func (info VpcRouteTableBasicInfo) IsDefault() bool {
+	return info.isDefault
+}
+
 func (info VpcRouteTableBasicInfo) CreateTime() string {
 	return info.createTime
 }
@@ -8607,3 +8616,99 @@ func (me *VpcService) DescribeCvmInstanceByFilter(ctx context.Context, instances
 	}
 	return
 }
+
+// DescribeEipQuotaUsage returns the usage of the quota of the EIPs in the region.
+func (me *VpcService) DescribeEipQuotaUsage(ctx context.Context) (usage quota.Usage, errRet error) {
+	quotas, err := me.DescribeEipAddressQuota(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, item := range quotas {
+		if item.QuotaId != nil && *item.QuotaId == "TOTAL_EIP_QUOTA" && item.QuotaCurrent != nil && item.QuotaLimit != nil {
+			return quota.Usage{Used: *item.QuotaCurrent, Limit: *item.QuotaLimit}, nil
+		}
+	}
+
+	errRet = fmt.Errorf("no TOTAL_EIP_QUOTA in the quotas of the EIPs")
+	return
+}
+
+// DescribeSecurityGroupQuotaUsage returns the usage of the quota of the security groups in the region.
+func (me *VpcService) DescribeSecurityGroupQuotaUsage(ctx context.Context) (usage quota.Usage, errRet error) {
+	var (
+		logId         = tccommon.GetLogId(ctx)
+		limitsRequest = vpc.NewDescribeSecurityGroupLimitsRequest()
+		request       = vpc.NewDescribeSecurityGroupsRequest()
+	)
+
+	ratelimit.Check(limitsRequest.GetAction())
+	limitsResponse, err := me.client.UseVpcClient().DescribeSecurityGroupLimits(limitsRequest)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, limitsRequest.GetAction(), limitsRequest.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if limitsResponse == nil || limitsResponse.Response == nil || limitsResponse.Response.SecurityGroupLimitSet == nil || limitsResponse.Response.SecurityGroupLimitSet.SecurityGroupLimit == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	request.Limit = helper.String("1")
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response == nil || response.Response == nil || response.Response.TotalCount == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	usage.Used = int64(*response.Response.TotalCount)
+	usage.Limit = int64(*limitsResponse.Response.SecurityGroupLimitSet.SecurityGroupLimit)
+	return
+}
+
+// DescribeSubnetQuotaUsage returns the usage of the quota of the subnets in the VPC of vpcId.
+func (me *VpcService) DescribeSubnetQuotaUsage(ctx context.Context, vpcId string) (usage quota.Usage, errRet error) {
+	var (
+		logId         = tccommon.GetLogId(ctx)
+		limitsRequest = vpc.NewDescribeVpcLimitsRequest()
+		request       = vpc.NewDescribeSubnetsRequest()
+	)
+
+	limitsRequest.LimitTypes = []*string{helper.String("vpc-max-subnets")}
+	ratelimit.Check(limitsRequest.GetAction())
+	limitsResponse, err := me.client.UseVpcClient().DescribeVpcLimits(limitsRequest)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, limitsRequest.GetAction(), limitsRequest.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if limitsResponse == nil || limitsResponse.Response == nil || len(limitsResponse.Response.VpcLimitSet) == 0 || limitsResponse.Response.VpcLimitSet[0].LimitValue == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	request.Filters = []*vpc.Filter{{Name: helper.String("vpc-id"), Values: []*string{helper.String(vpcId)}}}
+	request.Limit = helper.String("1")
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseVpcClient().DescribeSubnets(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response == nil || response.Response == nil || response.Response.TotalCount == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	usage.Used = int64(*response.Response.TotalCount)
+	usage.Limit = int64(*limitsResponse.Response.VpcLimitSet[0].LimitValue)
+	return
+}
@@ -5,16 +5,19 @@ import (
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
 	"context"
-	"errors"
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// vpnGatewayRouteIdFormat is the id of a route, which is also its import id.
+var vpnGatewayRouteIdFormat = compositeid.New(compositeid.String("vpn_gateway_id"), compositeid.String("route_id"))
+
 func ResourceTencentCloudVpnGatewayRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudVpnGatewayRouteCreate,
@@ -22,7 +25,7 @@ func ResourceTencentCloudVpnGatewayRoute() *schema.Resource {
 		Update: resourceTencentCloudVpnGatewayRouteUpdate,
 		Delete: resourceTencentCloudVpnGatewayRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: vpnGatewayRouteIdFormat.ImportStatePassthrough,
 		},
 
 		Schema: VpnGatewayRoutePara(),
@@ -118,7 +121,7 @@ func resourceTencentCloudVpnGatewayRouteCreate(d *schema.ResourceData, meta inte
 	if len(routeList) == 0 {
 		return fmt.Errorf("VPN gateway route id is nil")
 	}
-	d.SetId(helper.IdFormat(vpnGatewayId, *(routeList[0].RouteId)))
+	d.SetId(vpnGatewayRouteIdFormat.Join(vpnGatewayId, *(routeList[0].RouteId)))
 
 	//setRouteInfo(d, vpnGatewayId, route)
 	return resourceTencentCloudVpnGatewayRouteRead(d, meta)
@@ -134,19 +137,20 @@ func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interf
 		service = svcvpc.NewVpcService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
+	vpnGatewayId, routeId := compositeId.Get("vpn_gateway_id"), compositeId.Get("route_id")
 
-	err, routeList := service.DescribeVpnGatewayRoutes(ctx, compositeId[0], nil)
+	err, routeList := service.DescribeVpnGatewayRoutes(ctx, vpnGatewayId, nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s read VPN gateway routes failed, reason:%s\n", logId, err.Error())
 		return err
 	}
 	var route *vpc.VpnGatewayRoute
 	for _, r := range routeList {
-		if compositeId[1] == *r.RouteId {
+		if routeId == *r.RouteId {
 			route = r
 		}
 	}
@@ -155,7 +159,7 @@ func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	setRouteInfo(d, compositeId[0], route)
+	setRouteInfo(d, vpnGatewayId, route)
 	return nil
 }
 
@@ -168,10 +172,11 @@ func resourceTencentCloudVpnGatewayRouteUpdate(d *schema.ResourceData, meta inte
 		service = svcvpc.NewVpcService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
+	vpnGatewayId, routeId := compositeId.Get("vpn_gateway_id"), compositeId.Get("route_id")
 
 	if !d.HasChange("status") {
 		return nil
@@ -179,13 +184,13 @@ func resourceTencentCloudVpnGatewayRouteUpdate(d *schema.ResourceData, meta inte
 	status := d.Get("status").(string)
 
 	// update
-	err, route := service.ModifyVpnGatewayRoute(ctx, compositeId[0], compositeId[1], status)
+	err, route := service.ModifyVpnGatewayRoute(ctx, vpnGatewayId, routeId, status)
 	if err != nil {
 		log.Printf("[CRITAL]%s modify VPN gateway route failed, reason:%s\n", logId, err.Error())
 		return err
 	}
 
-	setRouteInfo(d, compositeId[0], route)
+	setRouteInfo(d, vpnGatewayId, route)
 	return nil
 }
 
@@ -198,12 +203,13 @@ func resourceTencentCloudVpnGatewayRouteDelete(d *schema.ResourceData, meta inte
 		service = svcvpc.NewVpcService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteIdFormat.Parse(id)
+	if err != nil {
+		return err
 	}
+	vpnGatewayId, routeId := compositeId.Get("vpn_gateway_id"), compositeId.Get("route_id")
 
-	err := service.DeleteVpnGatewayRoutes(ctx, compositeId[0], []*string{&compositeId[1]})
+	err = service.DeleteVpnGatewayRoutes(ctx, vpnGatewayId, []*string{&routeId})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN gateway routes failed, reason:%s\n", logId, err.Error())
 		return err
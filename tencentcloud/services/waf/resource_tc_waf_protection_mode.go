@@ -2,10 +2,8 @@ package waf
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -13,15 +11,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/compositeid"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// wafProtectionModeIdFormat is the id of the protection mode of a domain, which is also its import id.
+var wafProtectionModeIdFormat = compositeid.New(compositeid.String("domain"), compositeid.String("edition"))
+
 func ResourceTencentCloudWafProtectionMode() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudWafProtectionModeCreate,
 		Read:   resourceTencentCloudWafProtectionModeRead,
 		Update: resourceTencentCloudWafProtectionModeUpdate,
 		Delete: resourceTencentCloudWafProtectionModeDelete,
+		Importer: &schema.ResourceImporter{
+			State: wafProtectionModeIdFormat.ImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -68,7 +73,7 @@ func resourceTencentCloudWafProtectionModeCreate(d *schema.ResourceData, meta in
 		edition = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{domain, edition}, tccommon.FILED_SP))
+	d.SetId(wafProtectionModeIdFormat.Join(domain, edition))
 
 	return resourceTencentCloudWafProtectionModeUpdate(d, meta)
 }
@@ -83,12 +88,12 @@ func resourceTencentCloudWafProtectionModeRead(d *schema.ResourceData, meta inte
 		service = WafService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := wafProtectionModeIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	domain := idSplit[0]
-	edition := idSplit[1]
+	domain := id.Get("domain")
+	edition := id.Get("edition")
 
 	protectionInfo, err := service.DescribeSpartaProtectionInfoById(ctx, domain, edition)
 	if err != nil {
@@ -121,12 +126,12 @@ func resourceTencentCloudWafProtectionModeUpdate(d *schema.ResourceData, meta in
 		request = waf.NewModifySpartaProtectionModeRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), tccommon.FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	id, err := wafProtectionModeIdFormat.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	domain := idSplit[0]
-	edition := idSplit[1]
+	domain := id.Get("domain")
+	edition := id.Get("edition")
 
 	request.Domain = &domain
 	request.Edition = &edition
@@ -139,7 +144,7 @@ func resourceTencentCloudWafProtectionModeUpdate(d *schema.ResourceData, meta in
 		request.Type = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseWafClient().ModifySpartaProtectionMode(request)
 		if e != nil {
 			return tccommon.RetryError(e)
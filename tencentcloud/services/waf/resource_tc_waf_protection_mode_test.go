@@ -27,6 +27,11 @@ func TestAccTencentCloudWafProtectionModeResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_waf_protection_mode.example", "type"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_waf_protection_mode.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
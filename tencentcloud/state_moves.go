@@ -0,0 +1,26 @@
+package tencentcloud
+
+import (
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/stateupgrade"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/ccn"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cdb"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/teo"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tmp"
+)
+
+// StateMove is the move of the states of a resource to the one which has replaced it.
+type StateMove = stateupgrade.Move
+
+// StateMoves returns the moves of the states of the resources which have been replaced by other ones.
+//
+// `tencentcloud_clb_customized_config` is not replaced by `tencentcloud_clb_customized_config_v2`,
+// as the former manages the configs of type `CLB` while the latter manages the ones of type
+// `SERVER` and `LOCATION`, so there is no move of it.
+func StateMoves() []StateMove {
+	return []StateMove{
+		ccn.CcnAttachmentMove,
+		cdb.MysqlBackupPolicyMove,
+		teo.TeoL7AccRuleMove,
+		tmp.MonitorTmpExporterIntegrationMove,
+	}
+}
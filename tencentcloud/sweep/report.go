@@ -0,0 +1,98 @@
+package sweep
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Status is the result of sweeping a resource.
+type Status string
+
+const (
+	StatusKept    Status = "kept"
+	StatusDryRun  Status = "dry_run"
+	StatusDeleted Status = "deleted"
+	StatusFailed  Status = "failed"
+)
+
+// Record is a resource found in a run.
+type Record struct {
+	Region      string            `json:"region"`
+	Type        string            `json:"type"`
+	Id          string            `json:"id"`
+	Name        string            `json:"name"`
+	CreateTime  string            `json:"create_time,omitempty"`
+	AgeDays     string            `json:"age_days,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Status      Status            `json:"status"`
+	Reason      string            `json:"reason,omitempty"`
+	PrincipalId string            `json:"principal_id,omitempty"`
+	UserName    string            `json:"user_name,omitempty"`
+}
+
+// Report is the records of one or more runs.
+type Report struct {
+	Records []Record `json:"records"`
+}
+
+// Merge appends the records of other.
+func (r *Report) Merge(other *Report) {
+	if other != nil {
+		r.Records = append(r.Records, other.Records...)
+	}
+}
+
+// Count returns the number of records of each status.
+func (r *Report) Count() map[Status]int {
+	count := make(map[Status]int)
+	for _, record := range r.Records {
+		count[record.Status]++
+	}
+	return count
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+var csvHeader = []string{"region", "type", "id", "name", "create_time", "age_days", "tags", "status", "reason", "principal_id", "user_name"}
+
+// WriteCSV writes the report as CSV with a header, tags are written as `key=value` joined by `;`.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, record := range r.Records {
+		tags := make([]string, 0, len(record.Tags))
+		for k, v := range record.Tags {
+			tags = append(tags, k+"="+v)
+		}
+		sort.Strings(tags)
+
+		row := []string{
+			record.Region,
+			record.Type,
+			record.Id,
+			record.Name,
+			record.CreateTime,
+			record.AgeDays,
+			strings.Join(tags, ";"),
+			string(record.Status),
+			record.Reason,
+			record.PrincipalId,
+			record.UserName,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
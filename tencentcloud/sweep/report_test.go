@@ -0,0 +1,38 @@
+package sweep
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testReport() *Report {
+	report := &Report{Records: []Record{
+		{Region: "ap-guangzhou", Type: "tencentcloud_vpc", Id: "vpc-1", Name: "tf-vpc", CreateTime: "2024-01-02T03:04:05Z", AgeDays: "1.50", Tags: map[string]string{"team": "a", "env": "test"}, Status: StatusDeleted, PrincipalId: "100001", UserName: "ci"},
+	}}
+	report.Merge(&Report{Records: []Record{
+		{Region: "ap-guangzhou", Type: "tencentcloud_subnet", Id: "subnet-1", Name: "keep-subnet", Status: StatusKept, Reason: "name is kept"},
+	}})
+	report.Merge(nil)
+	return report
+}
+
+func TestReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, testReport().WriteCSV(&buf))
+	assert.Equal(t, `region,type,id,name,create_time,age_days,tags,status,reason,principal_id,user_name
+ap-guangzhou,tencentcloud_vpc,vpc-1,tf-vpc,2024-01-02T03:04:05Z,1.50,env=test;team=a,deleted,,100001,ci
+ap-guangzhou,tencentcloud_subnet,subnet-1,keep-subnet,,,,kept,name is kept,,
+`, buf.String())
+}
+
+func TestReportJSON(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, testReport().WriteJSON(&buf))
+	assert.JSONEq(t, `{"records": [
+		{"region": "ap-guangzhou", "type": "tencentcloud_vpc", "id": "vpc-1", "name": "tf-vpc", "create_time": "2024-01-02T03:04:05Z", "age_days": "1.50", "tags": {"env": "test", "team": "a"}, "status": "deleted", "principal_id": "100001", "user_name": "ci"},
+		{"region": "ap-guangzhou", "type": "tencentcloud_subnet", "id": "subnet-1", "name": "keep-subnet", "status": "kept", "reason": "name is kept"}
+	]}`, buf.String())
+	assert.Equal(t, map[Status]int{StatusDeleted: 1, StatusKept: 1}, testReport().Count())
+}
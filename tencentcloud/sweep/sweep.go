@@ -0,0 +1,316 @@
+// Package sweep deletes the cloud resources leaked by tests or left behind by hand.
+//
+// A Sweeper lists and deletes the resources of a type, and is registered with the types which
+// have to be swept before it, such as the CLBs and the instances in a subnet before the subnet.
+// Run selects the resources by tags, name and age, deletes them or only reports them in a dry run,
+// and returns a Report which can be written as JSON or CSV.
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// Resource is a cloud resource found by a Sweeper.
+type Resource struct {
+	Id   string
+	Name string
+	// CreateTime is zero when the creation time is unknown.
+	CreateTime time.Time
+	Tags       map[string]string
+}
+
+// Sweeper lists and deletes the resources of Type.
+type Sweeper struct {
+	// Type is the type of the resources, such as `tencentcloud_vpc`.
+	Type string
+	// Dependencies are the types to sweep before this one, as their resources stop the ones of
+	// this type from being deleted.
+	Dependencies []string
+	// CreateAction is the cloud audit action creating the resources, such as `CreateVpc`, by which
+	// the creators of the resources are reported. It can be left empty.
+	CreateAction string
+
+	List   func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*Resource, error)
+	Delete func(ctx context.Context, client *connectivity.TencentCloudClient, r *Resource) error
+}
+
+// Registry holds the sweepers by type.
+type Registry struct {
+	sweepers map[string]*Sweeper
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{sweepers: make(map[string]*Sweeper)}
+}
+
+// Register adds s to the registry, it panics when a sweeper of the type has been registered.
+func (reg *Registry) Register(s *Sweeper) {
+	if s.Type == "" || s.List == nil || s.Delete == nil {
+		panic(fmt.Sprintf("sweep: sweeper %q must have a type, a lister and a deleter", s.Type))
+	}
+	if _, ok := reg.sweepers[s.Type]; ok {
+		panic(fmt.Sprintf("sweep: sweeper %s is registered twice", s.Type))
+	}
+	reg.sweepers[s.Type] = s
+}
+
+// Types returns the registered types in order.
+func (reg *Registry) Types() []string {
+	types := make([]string, 0, len(reg.sweepers))
+	for t := range reg.sweepers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Resolve returns the sweepers of types and of their dependencies, ordered so that every sweeper
+// comes after its dependencies. All the registered sweepers are returned when types is empty.
+func (reg *Registry) Resolve(types ...string) ([]*Sweeper, error) {
+	if len(types) == 0 {
+		types = reg.Types()
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	var (
+		state   = make(map[string]int)
+		ordered = make([]*Sweeper, 0, len(types))
+		visit   func(t string, path []string) error
+	)
+	visit = func(t string, path []string) error {
+		switch state[t] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("sweepers depend on each other: %v", append(path, t))
+		}
+		s, ok := reg.sweepers[t]
+		if !ok {
+			if len(path) > 0 {
+				return fmt.Errorf("sweeper %s, which %s depends on, is not registered", t, path[len(path)-1])
+			}
+			return fmt.Errorf("sweeper %s is not registered", t)
+		}
+
+		state[t] = visiting
+		deps := append([]string(nil), s.Dependencies...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep, append(path, t)); err != nil {
+				return err
+			}
+		}
+		state[t] = visited
+		ordered = append(ordered, s)
+		return nil
+	}
+
+	sorted := append([]string(nil), types...)
+	sort.Strings(sorted)
+	for _, t := range sorted {
+		if err := visit(t, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register adds s to the default registry.
+func Register(s *Sweeper) {
+	defaultRegistry.Register(s)
+}
+
+// Types returns the types of the default registry.
+func Types() []string {
+	return defaultRegistry.Types()
+}
+
+// Resolve resolves types with the default registry.
+func Resolve(types ...string) ([]*Sweeper, error) {
+	return defaultRegistry.Resolve(types...)
+}
+
+// Filter selects the resources to delete.
+type Filter struct {
+	// Tags are the tags the resources must all have.
+	Tags map[string]string
+	// NameRegex is the expression the names of the resources must match.
+	NameRegex *regexp.Regexp
+	// OlderThan is the minimal age of the resources, the ones of unknown age are kept when set.
+	OlderThan time.Duration
+}
+
+// Match reports whether r is to be deleted at now, or returns the reason to keep it. The
+// resources named with the prefixes `keep` and `Default` are always kept.
+func (f *Filter) Match(r *Resource, now time.Time) (bool, string) {
+	if tccommon.CheckResourceNameKeep(r.Name) == tccommon.KeepResource {
+		return false, "name is kept"
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(r.Name) {
+		return false, "name does not match"
+	}
+	for k, v := range f.Tags {
+		if value, ok := r.Tags[k]; !ok || value != v {
+			return false, fmt.Sprintf("tag %s=%s is missing", k, v)
+		}
+	}
+	if f.OlderThan > 0 {
+		if r.CreateTime.IsZero() {
+			return false, "age is unknown"
+		}
+		if now.Sub(r.CreateTime) < f.OlderThan {
+			return false, fmt.Sprintf("created within %s", f.OlderThan)
+		}
+	}
+	return true, ""
+}
+
+// Options controls a run.
+type Options struct {
+	Region string
+	Filter Filter
+	// DryRun reports the resources to delete without deleting them.
+	DryRun bool
+	// Concurrency is the number of resources of a type deleted at the same time, 1 by default.
+	Concurrency int
+	// Rate is the maximal number of deletions per second, unlimited when not positive. Deletions
+	// failing with RequestLimitExceeded are retried anyway.
+	Rate float64
+	// Creators reports the creators of the resources, by searching the cloud audit logs.
+	Creators bool
+}
+
+// Run sweeps the resources of sweepers in order, which is the one returned by Resolve. The sweep
+// goes on when a resource fails to be deleted, and the failure is reported in its record, an error
+// is returned only when the resources of a type cannot be listed.
+func Run(ctx context.Context, client *connectivity.TencentCloudClient, sweepers []*Sweeper, opts Options) (*Report, error) {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	var throttle <-chan time.Time
+	if opts.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.Rate))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	report := &Report{}
+	now := time.Now()
+	for _, s := range sweepers {
+		var resources []*Resource
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			var e error
+			resources, e = s.List(ctx, client)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return report, fmt.Errorf("list %s failed: %v", s.Type, err)
+		}
+
+		records := make([]*Record, len(resources))
+		for i, r := range resources {
+			records[i] = newRecord(opts.Region, s.Type, r, now)
+			if ok, reason := opts.Filter.Match(r, now); !ok {
+				records[i].Status, records[i].Reason = StatusKept, reason
+			} else if opts.DryRun {
+				records[i].Status = StatusDryRun
+			}
+		}
+		if opts.Creators && s.CreateAction != "" && client != nil {
+			fillCreators(client, s.CreateAction, resources, records)
+		}
+
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, opts.Concurrency)
+		)
+		for i, r := range resources {
+			if records[i].Status != "" {
+				continue
+			}
+			if throttle != nil {
+				select {
+				case <-throttle:
+				case <-ctx.Done():
+					wg.Wait()
+					return report, ctx.Err()
+				}
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(r *Resource, record *Record) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+					if e := s.Delete(ctx, client, r); e != nil {
+						return tccommon.RetryError(e)
+					}
+					return nil
+				})
+				if err != nil {
+					record.Status, record.Reason = StatusFailed, err.Error()
+					return
+				}
+				record.Status = StatusDeleted
+			}(r, records[i])
+		}
+		wg.Wait()
+
+		for _, record := range records {
+			report.Records = append(report.Records, *record)
+		}
+	}
+	return report, nil
+}
+
+func newRecord(region, resourceType string, r *Resource, now time.Time) *Record {
+	record := &Record{
+		Region: region,
+		Type:   resourceType,
+		Id:     r.Id,
+		Name:   r.Name,
+		Tags:   r.Tags,
+	}
+	if !r.CreateTime.IsZero() {
+		record.CreateTime = r.CreateTime.Format(time.RFC3339)
+		record.AgeDays = strconv.FormatFloat(now.Sub(r.CreateTime).Hours()/24, 'f', 2, 64)
+	}
+	return record
+}
+
+func fillCreators(client *connectivity.TencentCloudClient, action string, resources []*Resource, records []*Record) {
+	instances := make([]*tccommon.ResourceInstance, 0, len(resources))
+	for _, r := range resources {
+		instances = append(instances, &tccommon.ResourceInstance{Id: r.Id, Name: r.Name})
+	}
+	creators := tccommon.GetResourceCreatorAccountInfo(client, action, instances)
+	for _, record := range records {
+		if info := creators[record.Id]; info != nil {
+			record.PrincipalId = info.PrincipalId
+			record.UserName = info.UserName
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+func fakeSweeper(t string, deps []string, resources []*Resource, deleted *sync.Map) *Sweeper {
+	return &Sweeper{
+		Type:         t,
+		Dependencies: deps,
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*Resource, error) {
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *Resource) error {
+			if r.Id == "fail" {
+				return fmt.Errorf("resource in use")
+			}
+			deleted.Store(r.Id, t)
+			return nil
+		},
+	}
+}
+
+func types(sweepers []*Sweeper) []string {
+	result := make([]string, 0, len(sweepers))
+	for _, s := range sweepers {
+		result = append(result, s.Type)
+	}
+	return result
+}
+
+func TestResolve(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register(fakeSweeper("vpc", []string{"subnet", "security_group"}, nil, nil))
+	reg.Register(fakeSweeper("subnet", []string{"clb", "instance"}, nil, nil))
+	reg.Register(fakeSweeper("security_group", []string{"instance"}, nil, nil))
+	reg.Register(fakeSweeper("clb", nil, nil, nil))
+	reg.Register(fakeSweeper("instance", nil, nil, nil))
+	reg.Register(fakeSweeper("cos", nil, nil, nil))
+
+	cases := []struct {
+		types []string
+		want  []string
+	}{
+		{nil, []string{"clb", "cos", "instance", "security_group", "subnet", "vpc"}},
+		{[]string{"vpc"}, []string{"instance", "security_group", "clb", "subnet", "vpc"}},
+		{[]string{"subnet", "cos"}, []string{"cos", "clb", "instance", "subnet"}},
+		{[]string{"clb"}, []string{"clb"}},
+	}
+	for _, c := range cases {
+		sweepers, err := reg.Resolve(c.types...)
+		assert.NoError(t, err)
+		assert.Equal(t, c.want, types(sweepers), "%v", c.types)
+	}
+
+	_, err := reg.Resolve("eip")
+	assert.EqualError(t, err, "sweeper eip is not registered")
+
+	reg.Register(fakeSweeper("eni", []string{"cvm"}, nil, nil))
+	_, err = reg.Resolve("eni")
+	assert.EqualError(t, err, "sweeper cvm, which eni depends on, is not registered")
+
+	reg.Register(fakeSweeper("a", []string{"b"}, nil, nil))
+	reg.Register(fakeSweeper("b", []string{"a"}, nil, nil))
+	_, err = reg.Resolve("a")
+	assert.EqualError(t, err, "sweepers depend on each other: [a b a]")
+
+	assert.Panics(t, func() { reg.Register(fakeSweeper("a", nil, nil, nil)) })
+	assert.Panics(t, func() { reg.Register(&Sweeper{Type: "c"}) })
+}
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Now()
+	old := &Resource{Id: "vpc-1", Name: "tf-test-vpc", CreateTime: now.Add(-48 * time.Hour), Tags: map[string]string{"env": "test"}}
+
+	cases := []struct {
+		name   string
+		filter Filter
+		r      *Resource
+		match  bool
+		reason string
+	}{
+		{"no filter", Filter{}, old, true, ""},
+		{"keep prefix", Filter{}, &Resource{Name: "keep-vpc"}, false, "name is kept"},
+		{"default prefix", Filter{}, &Resource{Name: "Default-VPC"}, false, "name is kept"},
+		{"name matches", Filter{NameRegex: regexp.MustCompile("^tf-")}, old, true, ""},
+		{"name mismatches", Filter{NameRegex: regexp.MustCompile("^tf-")}, &Resource{Name: "web"}, false, "name does not match"},
+		{"tag matches", Filter{Tags: map[string]string{"env": "test"}}, old, true, ""},
+		{"tag value mismatches", Filter{Tags: map[string]string{"env": "prod"}}, old, false, "tag env=prod is missing"},
+		{"tag missing", Filter{Tags: map[string]string{"env": "test"}}, &Resource{Name: "tf"}, false, "tag env=test is missing"},
+		{"old enough", Filter{OlderThan: 24 * time.Hour}, old, true, ""},
+		{"too young", Filter{OlderThan: 72 * time.Hour}, old, false, "created within 72h0m0s"},
+		{"age unknown", Filter{OlderThan: time.Hour}, &Resource{Name: "tf"}, false, "age is unknown"},
+	}
+	for _, c := range cases {
+		match, reason := c.filter.Match(c.r, now)
+		assert.Equal(t, c.match, match, c.name)
+		assert.Equal(t, c.reason, reason, c.name)
+	}
+}
+
+func TestRun(t *testing.T) {
+	var deleted sync.Map
+	sweepers := []*Sweeper{
+		fakeSweeper("subnet", nil, []*Resource{
+			{Id: "subnet-1", Name: "tf-subnet"},
+			{Id: "subnet-2", Name: "keep-subnet"},
+			{Id: "fail", Name: "tf-busy"},
+		}, &deleted),
+		fakeSweeper("vpc", []string{"subnet"}, []*Resource{
+			{Id: "vpc-1", Name: "tf-vpc", CreateTime: time.Now().Add(-time.Hour)},
+		}, &deleted),
+	}
+
+	report, err := Run(context.TODO(), nil, sweepers, Options{Region: "ap-guangzhou", DryRun: true})
+	assert.NoError(t, err)
+	deleted.Range(func(key, value interface{}) bool {
+		t.Errorf("%v is deleted in a dry run", key)
+		return true
+	})
+	assert.Equal(t, map[Status]int{StatusDryRun: 3, StatusKept: 1}, report.Count())
+
+	report, err = Run(context.TODO(), nil, sweepers, Options{Region: "ap-guangzhou", Concurrency: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[Status]int{StatusDeleted: 2, StatusKept: 1, StatusFailed: 1}, report.Count())
+
+	statuses := make(map[string]Status)
+	for _, record := range report.Records {
+		assert.Equal(t, "ap-guangzhou", record.Region)
+		statuses[record.Id] = record.Status
+	}
+	assert.Equal(t, map[string]Status{"subnet-1": StatusDeleted, "subnet-2": StatusKept, "fail": StatusFailed, "vpc-1": StatusDeleted}, statuses)
+	assert.Equal(t, "vpc", report.Records[3].Type)
+	assert.Equal(t, "0.04", report.Records[3].AgeDays)
+	assert.Equal(t, "resource in use", report.Records[2].Reason)
+
+	broken := &Sweeper{
+		Type: "eip",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*Resource, error) {
+			return nil, fmt.Errorf("unauthorized")
+		},
+	}
+	_, err = Run(context.TODO(), nil, []*Sweeper{broken}, Options{})
+	assert.EqualError(t, err, "list eip failed: unauthorized")
+}
+
+func TestRunConcurrency(t *testing.T) {
+	resources := make([]*Resource, 0, 20)
+	for i := 0; i < 20; i++ {
+		resources = append(resources, &Resource{Id: fmt.Sprintf("ins-%d", i), Name: "tf"})
+	}
+
+	var running, maxRunning int32
+	var ids sync.Map
+	s := &Sweeper{
+		Type: "instance",
+		List: func(ctx context.Context, client *connectivity.TencentCloudClient) ([]*Resource, error) {
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *connectivity.TencentCloudClient, r *Resource) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			ids.Store(r.Id, true)
+			return nil
+		},
+	}
+
+	start := time.Now()
+	report, err := Run(context.TODO(), nil, []*Sweeper{s}, Options{Concurrency: 3, Rate: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, report.Count()[StatusDeleted])
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(3))
+	assert.GreaterOrEqual(t, time.Since(start), 19*time.Millisecond)
+
+	deletedIds := make([]string, 0)
+	ids.Range(func(key, value interface{}) bool {
+		deletedIds = append(deletedIds, key.(string))
+		return true
+	})
+	sort.Strings(deletedIds)
+	assert.Len(t, deletedIds, 20)
+}